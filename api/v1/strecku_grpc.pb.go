@@ -23,16 +23,53 @@ type StreckUClient interface {
 	// ListUsers lists users.
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
 	// CreateUser creates a new user.
+	//
+	// (-- api-linter: core::0133::http-uri-parent=disabled
+	//     aip.dev/not-precedent: Users are top-level resources. --)
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
 	// UpdateUser updates a single user.
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
 	// DeleteUser deletes a user.
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ChangePassword changes the password of a user, given the current password.
+	// Outstanding password reset tokens of the user are revoked.
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// RequestPasswordReset emails a single-use token for ResetPassword to the
+	// user with the given email address. The token expires after an hour. To not
+	// reveal which email addresses belong to users, it succeeds even if no user
+	// has the email address.
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ResetPassword sets the password of a user using a token sent by
+	// RequestPasswordReset. Since the token was sent to the email address of the
+	// user, the email address is marked as verified.
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// SendVerificationEmail emails a single-use token for VerifyEmailAddress to
+	// the email address of a user. Such an email is also sent when a user is
+	// created, and when the email address of a user is updated. The token
+	// expires after a day.
+	SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// VerifyEmailAddress marks the email address of a user as verified using a
+	// token sent by SendVerificationEmail. The token is only valid while the
+	// user has the email address it was sent to.
+	VerifyEmailAddress(ctx context.Context, in *VerifyEmailAddressRequest, opts ...grpc.CallOption) (*User, error)
+	// GetCard gets a single card. Users can only get their own cards.
+	GetCard(ctx context.Context, in *GetCardRequest, opts ...grpc.CallOption) (*Card, error)
+	// ListCards lists the cards of a user. Users can only list their own cards.
+	ListCards(ctx context.Context, in *ListCardsRequest, opts ...grpc.CallOption) (*ListCardsResponse, error)
+	// CreateCard registers a card for a user. Users can only register cards for
+	// themselves, and each card can only be registered by one user.
+	CreateCard(ctx context.Context, in *CreateCardRequest, opts ...grpc.CallOption) (*Card, error)
+	// DeleteCard deletes a card. Users can only delete their own cards.
+	DeleteCard(ctx context.Context, in *DeleteCardRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// GetStore gets a single store.
 	GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*Store, error)
-	// ListStores lists stores.
+	// ListStores lists stores. Callers authenticated as a user are only shown
+	// public stores and the stores that they are members of.
 	ListStores(ctx context.Context, in *ListStoresRequest, opts ...grpc.CallOption) (*ListStoresResponse, error)
 	// CreateStore creates a new store.
+	//
+	// (-- api-linter: core::0133::http-uri-parent=disabled
+	//     aip.dev/not-precedent: Stores are top-level resources. --)
 	CreateStore(ctx context.Context, in *CreateStoreRequest, opts ...grpc.CallOption) (*Store, error)
 	// UpdateStore updates a single store.
 	UpdateStore(ctx context.Context, in *UpdateStoreRequest, opts ...grpc.CallOption) (*Store, error)
@@ -48,6 +85,95 @@ type StreckUClient interface {
 	UpdateMembership(ctx context.Context, in *UpdateMembershipRequest, opts ...grpc.CallOption) (*Membership, error)
 	// DeleteMembership deletes a membership.
 	DeleteMembership(ctx context.Context, in *DeleteMembershipRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ChangeMembershipPin sets, changes or removes the PIN of a membership.
+	// Users can only change the PINs of their own memberships.
+	//
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//     aip.dev/not-precedent: The resource is already named by the URI, so
+	//     the suffix is only the verb. --)
+	ChangeMembershipPin(ctx context.Context, in *ChangeMembershipPinRequest, opts ...grpc.CallOption) (*Membership, error)
+	// IdentifyCard identifies a member of a store by a card of the user and the
+	// PIN of the membership, and returns a short-lived token that allows
+	// purchases to be made for the member. It can only be called by devices of
+	// the store with the cards.identify scope. Failed attempts are rate limited
+	// like attempts to authenticate with a password.
+	IdentifyCard(ctx context.Context, in *IdentifyCardRequest, opts ...grpc.CallOption) (*IdentifyCardResponse, error)
+	// GetInvitation gets a single invitation.
+	GetInvitation(ctx context.Context, in *GetInvitationRequest, opts ...grpc.CallOption) (*Invitation, error)
+	// ListInvitations lists invitations. Only administrators of the store may
+	// list its invitations.
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error)
+	// CreateInvitation invites an email address to become a member of a store.
+	// The invitation is sent to the email address. Only administrators of the
+	// store may invite to it.
+	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*Invitation, error)
+	// DeleteInvitation deletes an invitation. Only administrators of the store
+	// may delete its invitations.
+	DeleteInvitation(ctx context.Context, in *DeleteInvitationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// AcceptInvitation accepts a pending invitation, creating a membership for
+	// the user with the invited email address.
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*Membership, error)
+	// DeclineInvitation declines a pending invitation.
+	DeclineInvitation(ctx context.Context, in *DeclineInvitationRequest, opts ...grpc.CallOption) (*Invitation, error)
+	// GetJoinRequest gets a single join request.
+	GetJoinRequest(ctx context.Context, in *GetJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequest, error)
+	// ListJoinRequests lists join requests.
+	ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error)
+	// CreateJoinRequest requests a membership in a store for a user. If the
+	// join policy of the store is open, the request is approved immediately.
+	// Requests cannot be made to invite-only stores.
+	CreateJoinRequest(ctx context.Context, in *CreateJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequest, error)
+	// DeleteJoinRequest deletes a join request.
+	DeleteJoinRequest(ctx context.Context, in *DeleteJoinRequestRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ApproveJoinRequest approves a pending join request, creating a membership
+	// for the user that made it. Only administrators of the store may approve
+	// join requests to it.
+	//
+	// (-- api-linter: core::0136::http-name-variable=disabled
+	//     aip.dev/not-precedent: Collection IDs are lowercase, since resource
+	//     names are parsed by package resourcename. --)
+	ApproveJoinRequest(ctx context.Context, in *ApproveJoinRequestRequest, opts ...grpc.CallOption) (*Membership, error)
+	// RejectJoinRequest rejects a pending join request. Only administrators of
+	// the store may reject join requests to it.
+	//
+	// (-- api-linter: core::0136::http-name-variable=disabled
+	//     aip.dev/not-precedent: Collection IDs are lowercase, since resource
+	//     names are parsed by package resourcename. --)
+	RejectJoinRequest(ctx context.Context, in *RejectJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequest, error)
+	// GetDevice gets a single device.
+	GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*Device, error)
+	// ListDevices lists devices.
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	// CreateDevice creates a new device. The device cannot authenticate until
+	// an API key has been created for it. Only administrators of the store may
+	// create devices.
+	CreateDevice(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*Device, error)
+	// UpdateDevice updates a single device. Only administrators of the store
+	// may update devices.
+	UpdateDevice(ctx context.Context, in *UpdateDeviceRequest, opts ...grpc.CallOption) (*Device, error)
+	// DeleteDevice deletes a device, revoking all of its API keys. Only
+	// administrators of the store may delete devices.
+	DeleteDevice(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetApiKey gets a single API key. The secret of the key is not returned.
+	GetApiKey(ctx context.Context, in *GetApiKeyRequest, opts ...grpc.CallOption) (*ApiKey, error)
+	// ListApiKeys lists the API keys of a device. The secrets of the keys are
+	// not returned. Only administrators of the store may list API keys.
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	// CreateApiKey creates a new API key for a device. The secret of the key is
+	// only returned in the response, and cannot be retrieved later. Only
+	// administrators of the store may create API keys.
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*ApiKey, error)
+	// DeleteApiKey deletes an API key, revoking it immediately. Only
+	// administrators of the store may delete API keys.
+	DeleteApiKey(ctx context.Context, in *DeleteApiKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// RotateApiKey replaces the secret of an API key with a new one, which is
+	// only returned in the response. The old secret stops working immediately.
+	// Only administrators of the store may rotate API keys.
+	//
+	// (-- api-linter: core::0136::http-name-variable=disabled
+	//     aip.dev/not-precedent: Collection IDs are lowercase, since resource
+	//     names are parsed by package resourcename. --)
+	RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*ApiKey, error)
 	// GetProduct gets a single product.
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*Product, error)
 	// ListProducts lists products.
@@ -58,16 +184,52 @@ type StreckUClient interface {
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*Product, error)
 	// DeleteProduct deletes a product.
 	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetPricingRule gets a single pricing rule.
+	GetPricingRule(ctx context.Context, in *GetPricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error)
+	// ListPricingRules lists the pricing rules of a store.
+	ListPricingRules(ctx context.Context, in *ListPricingRulesRequest, opts ...grpc.CallOption) (*ListPricingRulesResponse, error)
+	// CreatePricingRule creates a new pricing rule.
+	CreatePricingRule(ctx context.Context, in *CreatePricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error)
+	// UpdatePricingRule updates a single pricing rule. Purchases that have
+	// already been made are not affected.
+	UpdatePricingRule(ctx context.Context, in *UpdatePricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error)
+	// DeletePricingRule deletes a pricing rule. Purchases that have already been
+	// made are not affected.
+	DeletePricingRule(ctx context.Context, in *DeletePricingRuleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// GetPurchase gets a single purchase.
 	GetPurchase(ctx context.Context, in *GetPurchaseRequest, opts ...grpc.CallOption) (*Purchase, error)
 	// ListPurchases lists purchases.
 	ListPurchases(ctx context.Context, in *ListPurchasesRequest, opts ...grpc.CallOption) (*ListPurchasesResponse, error)
-	// CreatePurchase creates a new purchase.
+	// CreatePurchase creates a new purchase. For each charge line whose product
+	// has a deposit, a deposit line is added directly after it. Refund lines must
+	// refer to a charge line of an earlier active purchase by the same user, and
+	// the product of that line is copied to the refund line.
+	//
+	// When the caller is a device, every charge line must have a product, and
+	// its price is set to the full price of the product, or the discount price
+	// if the user's membership has a discount. Devices cannot create refunds.
 	CreatePurchase(ctx context.Context, in *CreatePurchaseRequest, opts ...grpc.CallOption) (*Purchase, error)
-	// UpdatePurchase updates a single purchase.
+	// UpdatePurchase updates a single purchase. If the lines are changed, their
+	// deposit lines are ignored and added again as by CreatePurchase. Pricing
+	// rules are applied to the lines that are not in the stored purchase, as of
+	// its create time, while the other lines are kept as they are.
 	UpdatePurchase(ctx context.Context, in *UpdatePurchaseRequest, opts ...grpc.CallOption) (*Purchase, error)
 	// DeletePurchase deletes a purchase.
 	DeletePurchase(ctx context.Context, in *DeletePurchaseRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// UndoPurchase voids a purchase, so that it no longer counts towards the
+	// balance of the user. The purchase is kept, but its state is VOIDED. Only
+	// the user who made the purchase, or the device it was made from, can undo
+	// it, and only within the purchase undo window of the store.
+	//
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//     aip.dev/not-precedent: The resource is already named by the URI, so
+	//     the suffix is only the verb. --)
+	UndoPurchase(ctx context.Context, in *UndoPurchaseRequest, opts ...grpc.CallOption) (*Purchase, error)
+	// WatchPurchases streams an event whenever a purchase in a store is created,
+	// updated or deleted. Events are numbered, and a stream that ends can be
+	// resumed from the sequence number of the last event it received. Streams
+	// that fall too far behind are ended with RESOURCE_EXHAUSTED.
+	WatchPurchases(ctx context.Context, in *WatchPurchasesRequest, opts ...grpc.CallOption) (StreckU_WatchPurchasesClient, error)
 	// GetPayment gets a single payment.
 	GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*Payment, error)
 	// ListPayments lists payments.
@@ -78,6 +240,41 @@ type StreckUClient interface {
 	UpdatePayment(ctx context.Context, in *UpdatePaymentRequest, opts ...grpc.CallOption) (*Payment, error)
 	// DeletePayment deletes a payment.
 	DeletePayment(ctx context.Context, in *DeletePaymentRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// WatchPayments streams an event whenever a payment in a store is created,
+	// updated or deleted. Events are numbered, and a stream that ends can be
+	// resumed from the sequence number of the last event it received. Streams
+	// that fall too far behind are ended with RESOURCE_EXHAUSTED.
+	WatchPayments(ctx context.Context, in *WatchPaymentsRequest, opts ...grpc.CallOption) (StreckU_WatchPaymentsClient, error)
+	// GenerateStatement generates a statement of the purchases and payments
+	// made by a member of a store during a period of time.
+	GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (*Statement, error)
+	// GetStoreStatistics gets statistics of the purchases made in a store during
+	// a period of time, such as the most bought products and the members who
+	// bought the most.
+	//
+	// (-- api-linter: core::0140::abbreviations=disabled
+	//     aip.dev/not-precedent: "Statistics" reads better to members. --)
+	GetStoreStatistics(ctx context.Context, in *GetStoreStatisticsRequest, opts ...grpc.CallOption) (*StoreStatistics, error)
+	// ExportStore exports the purchases and payments of a store as CSV. The CSV
+	// is split into chunks which are streamed in order.
+	ExportStore(ctx context.Context, in *ExportStoreRequest, opts ...grpc.CallOption) (StreckU_ExportStoreClient, error)
+	// ImportStore creates products and memberships in a store from CSV files.
+	// Nothing is imported if any row is invalid. If creating a row fails anyway,
+	// the rows created so far are deleted again, so that the import is either
+	// applied in full or not at all.
+	ImportStore(ctx context.Context, in *ImportStoreRequest, opts ...grpc.CallOption) (*ImportStoreResponse, error)
+	// GenerateSwishPayment generates a Swish payment request for settling the
+	// outstanding balance of a member of a store. The store must have a Swish
+	// number and the balance of the member must be negative.
+	GenerateSwishPayment(ctx context.Context, in *GenerateSwishPaymentRequest, opts ...grpc.CallOption) (*SwishPayment, error)
+	// ListLockouts lists the accounts and peers that are locked out because of
+	// too many failed attempts to authenticate. Only administrators of the
+	// server may list lockouts.
+	ListLockouts(ctx context.Context, in *ListLockoutsRequest, opts ...grpc.CallOption) (*ListLockoutsResponse, error)
+	// DeleteLockout clears a lockout before it expires, allowing the account or
+	// peer to authenticate again. Only administrators of the server may delete
+	// lockouts.
+	DeleteLockout(ctx context.Context, in *DeleteLockoutRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type streckUClient struct {
@@ -133,6 +330,87 @@ func (c *streckUClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, o
 	return out, nil
 }
 
+func (c *streckUClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/SendVerificationEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) VerifyEmailAddress(ctx context.Context, in *VerifyEmailAddressRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/VerifyEmailAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) GetCard(ctx context.Context, in *GetCardRequest, opts ...grpc.CallOption) (*Card, error) {
+	out := new(Card)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GetCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) ListCards(ctx context.Context, in *ListCardsRequest, opts ...grpc.CallOption) (*ListCardsResponse, error) {
+	out := new(ListCardsResponse)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ListCards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) CreateCard(ctx context.Context, in *CreateCardRequest, opts ...grpc.CallOption) (*Card, error) {
+	out := new(Card)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/CreateCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) DeleteCard(ctx context.Context, in *DeleteCardRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/DeleteCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *streckUClient) GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*Store, error) {
 	out := new(Store)
 	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GetStore", in, out, opts...)
@@ -223,6 +501,222 @@ func (c *streckUClient) DeleteMembership(ctx context.Context, in *DeleteMembersh
 	return out, nil
 }
 
+func (c *streckUClient) ChangeMembershipPin(ctx context.Context, in *ChangeMembershipPinRequest, opts ...grpc.CallOption) (*Membership, error) {
+	out := new(Membership)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ChangeMembershipPin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) IdentifyCard(ctx context.Context, in *IdentifyCardRequest, opts ...grpc.CallOption) (*IdentifyCardResponse, error) {
+	out := new(IdentifyCardResponse)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/IdentifyCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) GetInvitation(ctx context.Context, in *GetInvitationRequest, opts ...grpc.CallOption) (*Invitation, error) {
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GetInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error) {
+	out := new(ListInvitationsResponse)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ListInvitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*Invitation, error) {
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/CreateInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) DeleteInvitation(ctx context.Context, in *DeleteInvitationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/DeleteInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*Membership, error) {
+	out := new(Membership)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/AcceptInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) DeclineInvitation(ctx context.Context, in *DeclineInvitationRequest, opts ...grpc.CallOption) (*Invitation, error) {
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/DeclineInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) GetJoinRequest(ctx context.Context, in *GetJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequest, error) {
+	out := new(JoinRequest)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GetJoinRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error) {
+	out := new(ListJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ListJoinRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) CreateJoinRequest(ctx context.Context, in *CreateJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequest, error) {
+	out := new(JoinRequest)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/CreateJoinRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) DeleteJoinRequest(ctx context.Context, in *DeleteJoinRequestRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/DeleteJoinRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) ApproveJoinRequest(ctx context.Context, in *ApproveJoinRequestRequest, opts ...grpc.CallOption) (*Membership, error) {
+	out := new(Membership)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ApproveJoinRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) RejectJoinRequest(ctx context.Context, in *RejectJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequest, error) {
+	out := new(JoinRequest)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/RejectJoinRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*Device, error) {
+	out := new(Device)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GetDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ListDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) CreateDevice(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*Device, error) {
+	out := new(Device)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/CreateDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) UpdateDevice(ctx context.Context, in *UpdateDeviceRequest, opts ...grpc.CallOption) (*Device, error) {
+	out := new(Device)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/UpdateDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) DeleteDevice(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/DeleteDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) GetApiKey(ctx context.Context, in *GetApiKeyRequest, opts ...grpc.CallOption) (*ApiKey, error) {
+	out := new(ApiKey)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GetApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ListApiKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*ApiKey, error) {
+	out := new(ApiKey)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/CreateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) DeleteApiKey(ctx context.Context, in *DeleteApiKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/DeleteApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*ApiKey, error) {
+	out := new(ApiKey)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/RotateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *streckUClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*Product, error) {
 	out := new(Product)
 	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GetProduct", in, out, opts...)
@@ -268,115 +762,356 @@ func (c *streckUClient) DeleteProduct(ctx context.Context, in *DeleteProductRequ
 	return out, nil
 }
 
-func (c *streckUClient) GetPurchase(ctx context.Context, in *GetPurchaseRequest, opts ...grpc.CallOption) (*Purchase, error) {
-	out := new(Purchase)
-	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GetPurchase", in, out, opts...)
+func (c *streckUClient) GetPricingRule(ctx context.Context, in *GetPricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error) {
+	out := new(PricingRule)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GetPricingRule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *streckUClient) ListPurchases(ctx context.Context, in *ListPurchasesRequest, opts ...grpc.CallOption) (*ListPurchasesResponse, error) {
-	out := new(ListPurchasesResponse)
-	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ListPurchases", in, out, opts...)
+func (c *streckUClient) ListPricingRules(ctx context.Context, in *ListPricingRulesRequest, opts ...grpc.CallOption) (*ListPricingRulesResponse, error) {
+	out := new(ListPricingRulesResponse)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ListPricingRules", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *streckUClient) CreatePurchase(ctx context.Context, in *CreatePurchaseRequest, opts ...grpc.CallOption) (*Purchase, error) {
-	out := new(Purchase)
-	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/CreatePurchase", in, out, opts...)
+func (c *streckUClient) CreatePricingRule(ctx context.Context, in *CreatePricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error) {
+	out := new(PricingRule)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/CreatePricingRule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *streckUClient) UpdatePurchase(ctx context.Context, in *UpdatePurchaseRequest, opts ...grpc.CallOption) (*Purchase, error) {
-	out := new(Purchase)
-	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/UpdatePurchase", in, out, opts...)
+func (c *streckUClient) UpdatePricingRule(ctx context.Context, in *UpdatePricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error) {
+	out := new(PricingRule)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/UpdatePricingRule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *streckUClient) DeletePurchase(ctx context.Context, in *DeletePurchaseRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (c *streckUClient) DeletePricingRule(ctx context.Context, in *DeletePricingRuleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/DeletePurchase", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/DeletePricingRule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *streckUClient) GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*Payment, error) {
-	out := new(Payment)
-	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GetPayment", in, out, opts...)
+func (c *streckUClient) GetPurchase(ctx context.Context, in *GetPurchaseRequest, opts ...grpc.CallOption) (*Purchase, error) {
+	out := new(Purchase)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GetPurchase", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *streckUClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
-	out := new(ListPaymentsResponse)
-	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ListPayments", in, out, opts...)
+func (c *streckUClient) ListPurchases(ctx context.Context, in *ListPurchasesRequest, opts ...grpc.CallOption) (*ListPurchasesResponse, error) {
+	out := new(ListPurchasesResponse)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ListPurchases", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *streckUClient) CreatePayment(ctx context.Context, in *CreatePaymentRequest, opts ...grpc.CallOption) (*Payment, error) {
-	out := new(Payment)
-	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/CreatePayment", in, out, opts...)
+func (c *streckUClient) CreatePurchase(ctx context.Context, in *CreatePurchaseRequest, opts ...grpc.CallOption) (*Purchase, error) {
+	out := new(Purchase)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/CreatePurchase", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *streckUClient) UpdatePayment(ctx context.Context, in *UpdatePaymentRequest, opts ...grpc.CallOption) (*Payment, error) {
-	out := new(Payment)
-	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/UpdatePayment", in, out, opts...)
+func (c *streckUClient) UpdatePurchase(ctx context.Context, in *UpdatePurchaseRequest, opts ...grpc.CallOption) (*Purchase, error) {
+	out := new(Purchase)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/UpdatePurchase", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *streckUClient) DeletePayment(ctx context.Context, in *DeletePaymentRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (c *streckUClient) DeletePurchase(ctx context.Context, in *DeletePurchaseRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/DeletePayment", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/DeletePurchase", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// StreckUServer is the server API for StreckU service.
-// All implementations must embed UnimplementedStreckUServer
-// for forward compatibility
+func (c *streckUClient) UndoPurchase(ctx context.Context, in *UndoPurchaseRequest, opts ...grpc.CallOption) (*Purchase, error) {
+	out := new(Purchase)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/UndoPurchase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) WatchPurchases(ctx context.Context, in *WatchPurchasesRequest, opts ...grpc.CallOption) (StreckU_WatchPurchasesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StreckU_serviceDesc.Streams[0], "/saser.strecku.v1.StreckU/WatchPurchases", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streckUWatchPurchasesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StreckU_WatchPurchasesClient interface {
+	Recv() (*WatchPurchasesResponse, error)
+	grpc.ClientStream
+}
+
+type streckUWatchPurchasesClient struct {
+	grpc.ClientStream
+}
+
+func (x *streckUWatchPurchasesClient) Recv() (*WatchPurchasesResponse, error) {
+	m := new(WatchPurchasesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streckUClient) GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*Payment, error) {
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GetPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
+	out := new(ListPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ListPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) CreatePayment(ctx context.Context, in *CreatePaymentRequest, opts ...grpc.CallOption) (*Payment, error) {
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/CreatePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) UpdatePayment(ctx context.Context, in *UpdatePaymentRequest, opts ...grpc.CallOption) (*Payment, error) {
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/UpdatePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) DeletePayment(ctx context.Context, in *DeletePaymentRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/DeletePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) WatchPayments(ctx context.Context, in *WatchPaymentsRequest, opts ...grpc.CallOption) (StreckU_WatchPaymentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StreckU_serviceDesc.Streams[1], "/saser.strecku.v1.StreckU/WatchPayments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streckUWatchPaymentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StreckU_WatchPaymentsClient interface {
+	Recv() (*WatchPaymentsResponse, error)
+	grpc.ClientStream
+}
+
+type streckUWatchPaymentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *streckUWatchPaymentsClient) Recv() (*WatchPaymentsResponse, error) {
+	m := new(WatchPaymentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streckUClient) GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (*Statement, error) {
+	out := new(Statement)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GenerateStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) GetStoreStatistics(ctx context.Context, in *GetStoreStatisticsRequest, opts ...grpc.CallOption) (*StoreStatistics, error) {
+	out := new(StoreStatistics)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GetStoreStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) ExportStore(ctx context.Context, in *ExportStoreRequest, opts ...grpc.CallOption) (StreckU_ExportStoreClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StreckU_serviceDesc.Streams[2], "/saser.strecku.v1.StreckU/ExportStore", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streckUExportStoreClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StreckU_ExportStoreClient interface {
+	Recv() (*ExportStoreResponse, error)
+	grpc.ClientStream
+}
+
+type streckUExportStoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *streckUExportStoreClient) Recv() (*ExportStoreResponse, error) {
+	m := new(ExportStoreResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streckUClient) ImportStore(ctx context.Context, in *ImportStoreRequest, opts ...grpc.CallOption) (*ImportStoreResponse, error) {
+	out := new(ImportStoreResponse)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ImportStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) GenerateSwishPayment(ctx context.Context, in *GenerateSwishPaymentRequest, opts ...grpc.CallOption) (*SwishPayment, error) {
+	out := new(SwishPayment)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/GenerateSwishPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) ListLockouts(ctx context.Context, in *ListLockoutsRequest, opts ...grpc.CallOption) (*ListLockoutsResponse, error) {
+	out := new(ListLockoutsResponse)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/ListLockouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streckUClient) DeleteLockout(ctx context.Context, in *DeleteLockoutRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/saser.strecku.v1.StreckU/DeleteLockout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StreckUServer is the server API for StreckU service.
+// All implementations must embed UnimplementedStreckUServer
+// for forward compatibility
 type StreckUServer interface {
 	// GetUser gets a single user.
 	GetUser(context.Context, *GetUserRequest) (*User, error)
 	// ListUsers lists users.
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
 	// CreateUser creates a new user.
+	//
+	// (-- api-linter: core::0133::http-uri-parent=disabled
+	//     aip.dev/not-precedent: Users are top-level resources. --)
 	CreateUser(context.Context, *CreateUserRequest) (*User, error)
 	// UpdateUser updates a single user.
 	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
 	// DeleteUser deletes a user.
 	DeleteUser(context.Context, *DeleteUserRequest) (*empty.Empty, error)
+	// ChangePassword changes the password of a user, given the current password.
+	// Outstanding password reset tokens of the user are revoked.
+	ChangePassword(context.Context, *ChangePasswordRequest) (*empty.Empty, error)
+	// RequestPasswordReset emails a single-use token for ResetPassword to the
+	// user with the given email address. The token expires after an hour. To not
+	// reveal which email addresses belong to users, it succeeds even if no user
+	// has the email address.
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*empty.Empty, error)
+	// ResetPassword sets the password of a user using a token sent by
+	// RequestPasswordReset. Since the token was sent to the email address of the
+	// user, the email address is marked as verified.
+	ResetPassword(context.Context, *ResetPasswordRequest) (*empty.Empty, error)
+	// SendVerificationEmail emails a single-use token for VerifyEmailAddress to
+	// the email address of a user. Such an email is also sent when a user is
+	// created, and when the email address of a user is updated. The token
+	// expires after a day.
+	SendVerificationEmail(context.Context, *SendVerificationEmailRequest) (*empty.Empty, error)
+	// VerifyEmailAddress marks the email address of a user as verified using a
+	// token sent by SendVerificationEmail. The token is only valid while the
+	// user has the email address it was sent to.
+	VerifyEmailAddress(context.Context, *VerifyEmailAddressRequest) (*User, error)
+	// GetCard gets a single card. Users can only get their own cards.
+	GetCard(context.Context, *GetCardRequest) (*Card, error)
+	// ListCards lists the cards of a user. Users can only list their own cards.
+	ListCards(context.Context, *ListCardsRequest) (*ListCardsResponse, error)
+	// CreateCard registers a card for a user. Users can only register cards for
+	// themselves, and each card can only be registered by one user.
+	CreateCard(context.Context, *CreateCardRequest) (*Card, error)
+	// DeleteCard deletes a card. Users can only delete their own cards.
+	DeleteCard(context.Context, *DeleteCardRequest) (*empty.Empty, error)
 	// GetStore gets a single store.
 	GetStore(context.Context, *GetStoreRequest) (*Store, error)
-	// ListStores lists stores.
+	// ListStores lists stores. Callers authenticated as a user are only shown
+	// public stores and the stores that they are members of.
 	ListStores(context.Context, *ListStoresRequest) (*ListStoresResponse, error)
 	// CreateStore creates a new store.
+	//
+	// (-- api-linter: core::0133::http-uri-parent=disabled
+	//     aip.dev/not-precedent: Stores are top-level resources. --)
 	CreateStore(context.Context, *CreateStoreRequest) (*Store, error)
 	// UpdateStore updates a single store.
 	UpdateStore(context.Context, *UpdateStoreRequest) (*Store, error)
@@ -392,6 +1127,95 @@ type StreckUServer interface {
 	UpdateMembership(context.Context, *UpdateMembershipRequest) (*Membership, error)
 	// DeleteMembership deletes a membership.
 	DeleteMembership(context.Context, *DeleteMembershipRequest) (*empty.Empty, error)
+	// ChangeMembershipPin sets, changes or removes the PIN of a membership.
+	// Users can only change the PINs of their own memberships.
+	//
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//     aip.dev/not-precedent: The resource is already named by the URI, so
+	//     the suffix is only the verb. --)
+	ChangeMembershipPin(context.Context, *ChangeMembershipPinRequest) (*Membership, error)
+	// IdentifyCard identifies a member of a store by a card of the user and the
+	// PIN of the membership, and returns a short-lived token that allows
+	// purchases to be made for the member. It can only be called by devices of
+	// the store with the cards.identify scope. Failed attempts are rate limited
+	// like attempts to authenticate with a password.
+	IdentifyCard(context.Context, *IdentifyCardRequest) (*IdentifyCardResponse, error)
+	// GetInvitation gets a single invitation.
+	GetInvitation(context.Context, *GetInvitationRequest) (*Invitation, error)
+	// ListInvitations lists invitations. Only administrators of the store may
+	// list its invitations.
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
+	// CreateInvitation invites an email address to become a member of a store.
+	// The invitation is sent to the email address. Only administrators of the
+	// store may invite to it.
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*Invitation, error)
+	// DeleteInvitation deletes an invitation. Only administrators of the store
+	// may delete its invitations.
+	DeleteInvitation(context.Context, *DeleteInvitationRequest) (*empty.Empty, error)
+	// AcceptInvitation accepts a pending invitation, creating a membership for
+	// the user with the invited email address.
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*Membership, error)
+	// DeclineInvitation declines a pending invitation.
+	DeclineInvitation(context.Context, *DeclineInvitationRequest) (*Invitation, error)
+	// GetJoinRequest gets a single join request.
+	GetJoinRequest(context.Context, *GetJoinRequestRequest) (*JoinRequest, error)
+	// ListJoinRequests lists join requests.
+	ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error)
+	// CreateJoinRequest requests a membership in a store for a user. If the
+	// join policy of the store is open, the request is approved immediately.
+	// Requests cannot be made to invite-only stores.
+	CreateJoinRequest(context.Context, *CreateJoinRequestRequest) (*JoinRequest, error)
+	// DeleteJoinRequest deletes a join request.
+	DeleteJoinRequest(context.Context, *DeleteJoinRequestRequest) (*empty.Empty, error)
+	// ApproveJoinRequest approves a pending join request, creating a membership
+	// for the user that made it. Only administrators of the store may approve
+	// join requests to it.
+	//
+	// (-- api-linter: core::0136::http-name-variable=disabled
+	//     aip.dev/not-precedent: Collection IDs are lowercase, since resource
+	//     names are parsed by package resourcename. --)
+	ApproveJoinRequest(context.Context, *ApproveJoinRequestRequest) (*Membership, error)
+	// RejectJoinRequest rejects a pending join request. Only administrators of
+	// the store may reject join requests to it.
+	//
+	// (-- api-linter: core::0136::http-name-variable=disabled
+	//     aip.dev/not-precedent: Collection IDs are lowercase, since resource
+	//     names are parsed by package resourcename. --)
+	RejectJoinRequest(context.Context, *RejectJoinRequestRequest) (*JoinRequest, error)
+	// GetDevice gets a single device.
+	GetDevice(context.Context, *GetDeviceRequest) (*Device, error)
+	// ListDevices lists devices.
+	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	// CreateDevice creates a new device. The device cannot authenticate until
+	// an API key has been created for it. Only administrators of the store may
+	// create devices.
+	CreateDevice(context.Context, *CreateDeviceRequest) (*Device, error)
+	// UpdateDevice updates a single device. Only administrators of the store
+	// may update devices.
+	UpdateDevice(context.Context, *UpdateDeviceRequest) (*Device, error)
+	// DeleteDevice deletes a device, revoking all of its API keys. Only
+	// administrators of the store may delete devices.
+	DeleteDevice(context.Context, *DeleteDeviceRequest) (*empty.Empty, error)
+	// GetApiKey gets a single API key. The secret of the key is not returned.
+	GetApiKey(context.Context, *GetApiKeyRequest) (*ApiKey, error)
+	// ListApiKeys lists the API keys of a device. The secrets of the keys are
+	// not returned. Only administrators of the store may list API keys.
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	// CreateApiKey creates a new API key for a device. The secret of the key is
+	// only returned in the response, and cannot be retrieved later. Only
+	// administrators of the store may create API keys.
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*ApiKey, error)
+	// DeleteApiKey deletes an API key, revoking it immediately. Only
+	// administrators of the store may delete API keys.
+	DeleteApiKey(context.Context, *DeleteApiKeyRequest) (*empty.Empty, error)
+	// RotateApiKey replaces the secret of an API key with a new one, which is
+	// only returned in the response. The old secret stops working immediately.
+	// Only administrators of the store may rotate API keys.
+	//
+	// (-- api-linter: core::0136::http-name-variable=disabled
+	//     aip.dev/not-precedent: Collection IDs are lowercase, since resource
+	//     names are parsed by package resourcename. --)
+	RotateApiKey(context.Context, *RotateApiKeyRequest) (*ApiKey, error)
 	// GetProduct gets a single product.
 	GetProduct(context.Context, *GetProductRequest) (*Product, error)
 	// ListProducts lists products.
@@ -402,16 +1226,52 @@ type StreckUServer interface {
 	UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error)
 	// DeleteProduct deletes a product.
 	DeleteProduct(context.Context, *DeleteProductRequest) (*empty.Empty, error)
+	// GetPricingRule gets a single pricing rule.
+	GetPricingRule(context.Context, *GetPricingRuleRequest) (*PricingRule, error)
+	// ListPricingRules lists the pricing rules of a store.
+	ListPricingRules(context.Context, *ListPricingRulesRequest) (*ListPricingRulesResponse, error)
+	// CreatePricingRule creates a new pricing rule.
+	CreatePricingRule(context.Context, *CreatePricingRuleRequest) (*PricingRule, error)
+	// UpdatePricingRule updates a single pricing rule. Purchases that have
+	// already been made are not affected.
+	UpdatePricingRule(context.Context, *UpdatePricingRuleRequest) (*PricingRule, error)
+	// DeletePricingRule deletes a pricing rule. Purchases that have already been
+	// made are not affected.
+	DeletePricingRule(context.Context, *DeletePricingRuleRequest) (*empty.Empty, error)
 	// GetPurchase gets a single purchase.
 	GetPurchase(context.Context, *GetPurchaseRequest) (*Purchase, error)
 	// ListPurchases lists purchases.
 	ListPurchases(context.Context, *ListPurchasesRequest) (*ListPurchasesResponse, error)
-	// CreatePurchase creates a new purchase.
+	// CreatePurchase creates a new purchase. For each charge line whose product
+	// has a deposit, a deposit line is added directly after it. Refund lines must
+	// refer to a charge line of an earlier active purchase by the same user, and
+	// the product of that line is copied to the refund line.
+	//
+	// When the caller is a device, every charge line must have a product, and
+	// its price is set to the full price of the product, or the discount price
+	// if the user's membership has a discount. Devices cannot create refunds.
 	CreatePurchase(context.Context, *CreatePurchaseRequest) (*Purchase, error)
-	// UpdatePurchase updates a single purchase.
+	// UpdatePurchase updates a single purchase. If the lines are changed, their
+	// deposit lines are ignored and added again as by CreatePurchase. Pricing
+	// rules are applied to the lines that are not in the stored purchase, as of
+	// its create time, while the other lines are kept as they are.
 	UpdatePurchase(context.Context, *UpdatePurchaseRequest) (*Purchase, error)
 	// DeletePurchase deletes a purchase.
 	DeletePurchase(context.Context, *DeletePurchaseRequest) (*empty.Empty, error)
+	// UndoPurchase voids a purchase, so that it no longer counts towards the
+	// balance of the user. The purchase is kept, but its state is VOIDED. Only
+	// the user who made the purchase, or the device it was made from, can undo
+	// it, and only within the purchase undo window of the store.
+	//
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//     aip.dev/not-precedent: The resource is already named by the URI, so
+	//     the suffix is only the verb. --)
+	UndoPurchase(context.Context, *UndoPurchaseRequest) (*Purchase, error)
+	// WatchPurchases streams an event whenever a purchase in a store is created,
+	// updated or deleted. Events are numbered, and a stream that ends can be
+	// resumed from the sequence number of the last event it received. Streams
+	// that fall too far behind are ended with RESOURCE_EXHAUSTED.
+	WatchPurchases(*WatchPurchasesRequest, StreckU_WatchPurchasesServer) error
 	// GetPayment gets a single payment.
 	GetPayment(context.Context, *GetPaymentRequest) (*Payment, error)
 	// ListPayments lists payments.
@@ -422,6 +1282,41 @@ type StreckUServer interface {
 	UpdatePayment(context.Context, *UpdatePaymentRequest) (*Payment, error)
 	// DeletePayment deletes a payment.
 	DeletePayment(context.Context, *DeletePaymentRequest) (*empty.Empty, error)
+	// WatchPayments streams an event whenever a payment in a store is created,
+	// updated or deleted. Events are numbered, and a stream that ends can be
+	// resumed from the sequence number of the last event it received. Streams
+	// that fall too far behind are ended with RESOURCE_EXHAUSTED.
+	WatchPayments(*WatchPaymentsRequest, StreckU_WatchPaymentsServer) error
+	// GenerateStatement generates a statement of the purchases and payments
+	// made by a member of a store during a period of time.
+	GenerateStatement(context.Context, *GenerateStatementRequest) (*Statement, error)
+	// GetStoreStatistics gets statistics of the purchases made in a store during
+	// a period of time, such as the most bought products and the members who
+	// bought the most.
+	//
+	// (-- api-linter: core::0140::abbreviations=disabled
+	//     aip.dev/not-precedent: "Statistics" reads better to members. --)
+	GetStoreStatistics(context.Context, *GetStoreStatisticsRequest) (*StoreStatistics, error)
+	// ExportStore exports the purchases and payments of a store as CSV. The CSV
+	// is split into chunks which are streamed in order.
+	ExportStore(*ExportStoreRequest, StreckU_ExportStoreServer) error
+	// ImportStore creates products and memberships in a store from CSV files.
+	// Nothing is imported if any row is invalid. If creating a row fails anyway,
+	// the rows created so far are deleted again, so that the import is either
+	// applied in full or not at all.
+	ImportStore(context.Context, *ImportStoreRequest) (*ImportStoreResponse, error)
+	// GenerateSwishPayment generates a Swish payment request for settling the
+	// outstanding balance of a member of a store. The store must have a Swish
+	// number and the balance of the member must be negative.
+	GenerateSwishPayment(context.Context, *GenerateSwishPaymentRequest) (*SwishPayment, error)
+	// ListLockouts lists the accounts and peers that are locked out because of
+	// too many failed attempts to authenticate. Only administrators of the
+	// server may list lockouts.
+	ListLockouts(context.Context, *ListLockoutsRequest) (*ListLockoutsResponse, error)
+	// DeleteLockout clears a lockout before it expires, allowing the account or
+	// peer to authenticate again. Only administrators of the server may delete
+	// lockouts.
+	DeleteLockout(context.Context, *DeleteLockoutRequest) (*empty.Empty, error)
 	mustEmbedUnimplementedStreckUServer()
 }
 
@@ -444,6 +1339,33 @@ func (UnimplementedStreckUServer) UpdateUser(context.Context, *UpdateUserRequest
 func (UnimplementedStreckUServer) DeleteUser(context.Context, *DeleteUserRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedStreckUServer) ChangePassword(context.Context, *ChangePasswordRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedStreckUServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedStreckUServer) ResetPassword(context.Context, *ResetPasswordRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedStreckUServer) SendVerificationEmail(context.Context, *SendVerificationEmailRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendVerificationEmail not implemented")
+}
+func (UnimplementedStreckUServer) VerifyEmailAddress(context.Context, *VerifyEmailAddressRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmailAddress not implemented")
+}
+func (UnimplementedStreckUServer) GetCard(context.Context, *GetCardRequest) (*Card, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCard not implemented")
+}
+func (UnimplementedStreckUServer) ListCards(context.Context, *ListCardsRequest) (*ListCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCards not implemented")
+}
+func (UnimplementedStreckUServer) CreateCard(context.Context, *CreateCardRequest) (*Card, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCard not implemented")
+}
+func (UnimplementedStreckUServer) DeleteCard(context.Context, *DeleteCardRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCard not implemented")
+}
 func (UnimplementedStreckUServer) GetStore(context.Context, *GetStoreRequest) (*Store, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStore not implemented")
 }
@@ -474,6 +1396,78 @@ func (UnimplementedStreckUServer) UpdateMembership(context.Context, *UpdateMembe
 func (UnimplementedStreckUServer) DeleteMembership(context.Context, *DeleteMembershipRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMembership not implemented")
 }
+func (UnimplementedStreckUServer) ChangeMembershipPin(context.Context, *ChangeMembershipPinRequest) (*Membership, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMembershipPin not implemented")
+}
+func (UnimplementedStreckUServer) IdentifyCard(context.Context, *IdentifyCardRequest) (*IdentifyCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdentifyCard not implemented")
+}
+func (UnimplementedStreckUServer) GetInvitation(context.Context, *GetInvitationRequest) (*Invitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvitation not implemented")
+}
+func (UnimplementedStreckUServer) ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (UnimplementedStreckUServer) CreateInvitation(context.Context, *CreateInvitationRequest) (*Invitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvitation not implemented")
+}
+func (UnimplementedStreckUServer) DeleteInvitation(context.Context, *DeleteInvitationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInvitation not implemented")
+}
+func (UnimplementedStreckUServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*Membership, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (UnimplementedStreckUServer) DeclineInvitation(context.Context, *DeclineInvitationRequest) (*Invitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineInvitation not implemented")
+}
+func (UnimplementedStreckUServer) GetJoinRequest(context.Context, *GetJoinRequestRequest) (*JoinRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinRequest not implemented")
+}
+func (UnimplementedStreckUServer) ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJoinRequests not implemented")
+}
+func (UnimplementedStreckUServer) CreateJoinRequest(context.Context, *CreateJoinRequestRequest) (*JoinRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJoinRequest not implemented")
+}
+func (UnimplementedStreckUServer) DeleteJoinRequest(context.Context, *DeleteJoinRequestRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJoinRequest not implemented")
+}
+func (UnimplementedStreckUServer) ApproveJoinRequest(context.Context, *ApproveJoinRequestRequest) (*Membership, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveJoinRequest not implemented")
+}
+func (UnimplementedStreckUServer) RejectJoinRequest(context.Context, *RejectJoinRequestRequest) (*JoinRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectJoinRequest not implemented")
+}
+func (UnimplementedStreckUServer) GetDevice(context.Context, *GetDeviceRequest) (*Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDevice not implemented")
+}
+func (UnimplementedStreckUServer) ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedStreckUServer) CreateDevice(context.Context, *CreateDeviceRequest) (*Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDevice not implemented")
+}
+func (UnimplementedStreckUServer) UpdateDevice(context.Context, *UpdateDeviceRequest) (*Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDevice not implemented")
+}
+func (UnimplementedStreckUServer) DeleteDevice(context.Context, *DeleteDeviceRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDevice not implemented")
+}
+func (UnimplementedStreckUServer) GetApiKey(context.Context, *GetApiKeyRequest) (*ApiKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiKey not implemented")
+}
+func (UnimplementedStreckUServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedStreckUServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*ApiKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedStreckUServer) DeleteApiKey(context.Context, *DeleteApiKeyRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApiKey not implemented")
+}
+func (UnimplementedStreckUServer) RotateApiKey(context.Context, *RotateApiKeyRequest) (*ApiKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateApiKey not implemented")
+}
 func (UnimplementedStreckUServer) GetProduct(context.Context, *GetProductRequest) (*Product, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
 }
@@ -489,6 +1483,21 @@ func (UnimplementedStreckUServer) UpdateProduct(context.Context, *UpdateProductR
 func (UnimplementedStreckUServer) DeleteProduct(context.Context, *DeleteProductRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
 }
+func (UnimplementedStreckUServer) GetPricingRule(context.Context, *GetPricingRuleRequest) (*PricingRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPricingRule not implemented")
+}
+func (UnimplementedStreckUServer) ListPricingRules(context.Context, *ListPricingRulesRequest) (*ListPricingRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPricingRules not implemented")
+}
+func (UnimplementedStreckUServer) CreatePricingRule(context.Context, *CreatePricingRuleRequest) (*PricingRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePricingRule not implemented")
+}
+func (UnimplementedStreckUServer) UpdatePricingRule(context.Context, *UpdatePricingRuleRequest) (*PricingRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePricingRule not implemented")
+}
+func (UnimplementedStreckUServer) DeletePricingRule(context.Context, *DeletePricingRuleRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePricingRule not implemented")
+}
 func (UnimplementedStreckUServer) GetPurchase(context.Context, *GetPurchaseRequest) (*Purchase, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPurchase not implemented")
 }
@@ -504,6 +1513,12 @@ func (UnimplementedStreckUServer) UpdatePurchase(context.Context, *UpdatePurchas
 func (UnimplementedStreckUServer) DeletePurchase(context.Context, *DeletePurchaseRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePurchase not implemented")
 }
+func (UnimplementedStreckUServer) UndoPurchase(context.Context, *UndoPurchaseRequest) (*Purchase, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoPurchase not implemented")
+}
+func (UnimplementedStreckUServer) WatchPurchases(*WatchPurchasesRequest, StreckU_WatchPurchasesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPurchases not implemented")
+}
 func (UnimplementedStreckUServer) GetPayment(context.Context, *GetPaymentRequest) (*Payment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPayment not implemented")
 }
@@ -519,6 +1534,30 @@ func (UnimplementedStreckUServer) UpdatePayment(context.Context, *UpdatePaymentR
 func (UnimplementedStreckUServer) DeletePayment(context.Context, *DeletePaymentRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePayment not implemented")
 }
+func (UnimplementedStreckUServer) WatchPayments(*WatchPaymentsRequest, StreckU_WatchPaymentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPayments not implemented")
+}
+func (UnimplementedStreckUServer) GenerateStatement(context.Context, *GenerateStatementRequest) (*Statement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateStatement not implemented")
+}
+func (UnimplementedStreckUServer) GetStoreStatistics(context.Context, *GetStoreStatisticsRequest) (*StoreStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoreStatistics not implemented")
+}
+func (UnimplementedStreckUServer) ExportStore(*ExportStoreRequest, StreckU_ExportStoreServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportStore not implemented")
+}
+func (UnimplementedStreckUServer) ImportStore(context.Context, *ImportStoreRequest) (*ImportStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportStore not implemented")
+}
+func (UnimplementedStreckUServer) GenerateSwishPayment(context.Context, *GenerateSwishPaymentRequest) (*SwishPayment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateSwishPayment not implemented")
+}
+func (UnimplementedStreckUServer) ListLockouts(context.Context, *ListLockoutsRequest) (*ListLockoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLockouts not implemented")
+}
+func (UnimplementedStreckUServer) DeleteLockout(context.Context, *DeleteLockoutRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLockout not implemented")
+}
 func (UnimplementedStreckUServer) mustEmbedUnimplementedStreckUServer() {}
 
 // UnsafeStreckUServer may be embedded to opt out of forward compatibility for this service.
@@ -622,272 +1661,956 @@ func _StreckU_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_GetStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStoreRequest)
+func _StreckU_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).GetStore(ctx, in)
+		return srv.(StreckUServer).ChangePassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/GetStore",
+		FullMethod: "/saser.strecku.v1.StreckU/ChangePassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).GetStore(ctx, req.(*GetStoreRequest))
+		return srv.(StreckUServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_ListStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListStoresRequest)
+func _StreckU_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).ListStores(ctx, in)
+		return srv.(StreckUServer).RequestPasswordReset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/ListStores",
+		FullMethod: "/saser.strecku.v1.StreckU/RequestPasswordReset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).ListStores(ctx, req.(*ListStoresRequest))
+		return srv.(StreckUServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_CreateStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateStoreRequest)
+func _StreckU_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).CreateStore(ctx, in)
+		return srv.(StreckUServer).ResetPassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/CreateStore",
+		FullMethod: "/saser.strecku.v1.StreckU/ResetPassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).CreateStore(ctx, req.(*CreateStoreRequest))
+		return srv.(StreckUServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_UpdateStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateStoreRequest)
+func _StreckU_SendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerificationEmailRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).UpdateStore(ctx, in)
+		return srv.(StreckUServer).SendVerificationEmail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/UpdateStore",
+		FullMethod: "/saser.strecku.v1.StreckU/SendVerificationEmail",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).UpdateStore(ctx, req.(*UpdateStoreRequest))
+		return srv.(StreckUServer).SendVerificationEmail(ctx, req.(*SendVerificationEmailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_DeleteStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteStoreRequest)
+func _StreckU_VerifyEmailAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).DeleteStore(ctx, in)
+		return srv.(StreckUServer).VerifyEmailAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/DeleteStore",
+		FullMethod: "/saser.strecku.v1.StreckU/VerifyEmailAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).DeleteStore(ctx, req.(*DeleteStoreRequest))
+		return srv.(StreckUServer).VerifyEmailAddress(ctx, req.(*VerifyEmailAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_GetMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMembershipRequest)
+func _StreckU_GetCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).GetMembership(ctx, in)
+		return srv.(StreckUServer).GetCard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/GetMembership",
+		FullMethod: "/saser.strecku.v1.StreckU/GetCard",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).GetMembership(ctx, req.(*GetMembershipRequest))
+		return srv.(StreckUServer).GetCard(ctx, req.(*GetCardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_ListMemberships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMembershipsRequest)
+func _StreckU_ListCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).ListMemberships(ctx, in)
+		return srv.(StreckUServer).ListCards(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/ListMemberships",
+		FullMethod: "/saser.strecku.v1.StreckU/ListCards",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).ListMemberships(ctx, req.(*ListMembershipsRequest))
+		return srv.(StreckUServer).ListCards(ctx, req.(*ListCardsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_CreateMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMembershipRequest)
+func _StreckU_CreateCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).CreateMembership(ctx, in)
+		return srv.(StreckUServer).CreateCard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/CreateMembership",
+		FullMethod: "/saser.strecku.v1.StreckU/CreateCard",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).CreateMembership(ctx, req.(*CreateMembershipRequest))
+		return srv.(StreckUServer).CreateCard(ctx, req.(*CreateCardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_UpdateMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMembershipRequest)
+func _StreckU_DeleteCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).DeleteCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/DeleteCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).DeleteCard(ctx, req.(*DeleteCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_GetStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).GetStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/GetStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).GetStore(ctx, req.(*GetStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_ListStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).ListStores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/ListStores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).ListStores(ctx, req.(*ListStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_CreateStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).CreateStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/CreateStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).CreateStore(ctx, req.(*CreateStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_UpdateStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).UpdateStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/UpdateStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).UpdateStore(ctx, req.(*UpdateStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_DeleteStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).DeleteStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/DeleteStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).DeleteStore(ctx, req.(*DeleteStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_GetMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).GetMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/GetMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).GetMembership(ctx, req.(*GetMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_ListMemberships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembershipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).ListMemberships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/ListMemberships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).ListMemberships(ctx, req.(*ListMembershipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_CreateMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).CreateMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/CreateMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).CreateMembership(ctx, req.(*CreateMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_UpdateMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).UpdateMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/UpdateMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).UpdateMembership(ctx, req.(*UpdateMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_DeleteMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).DeleteMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/DeleteMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).DeleteMembership(ctx, req.(*DeleteMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_ChangeMembershipPin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMembershipPinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).ChangeMembershipPin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/ChangeMembershipPin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).ChangeMembershipPin(ctx, req.(*ChangeMembershipPinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_IdentifyCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentifyCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).IdentifyCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/IdentifyCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).IdentifyCard(ctx, req.(*IdentifyCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_GetInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).GetInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/GetInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).GetInvitation(ctx, req.(*GetInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/ListInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).ListInvitations(ctx, req.(*ListInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).CreateInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/CreateInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).CreateInvitation(ctx, req.(*CreateInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_DeleteInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).DeleteInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/DeleteInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).DeleteInvitation(ctx, req.(*DeleteInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/AcceptInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_DeclineInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).DeclineInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/DeclineInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).DeclineInvitation(ctx, req.(*DeclineInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_GetJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).GetJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/GetJoinRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).GetJoinRequest(ctx, req.(*GetJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_ListJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).ListJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/ListJoinRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).ListJoinRequests(ctx, req.(*ListJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_CreateJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).CreateJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/CreateJoinRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).CreateJoinRequest(ctx, req.(*CreateJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_DeleteJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).DeleteJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/DeleteJoinRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).DeleteJoinRequest(ctx, req.(*DeleteJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_ApproveJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).ApproveJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/ApproveJoinRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).ApproveJoinRequest(ctx, req.(*ApproveJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_RejectJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).RejectJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/RejectJoinRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).RejectJoinRequest(ctx, req.(*RejectJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_GetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).GetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/GetDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).GetDevice(ctx, req.(*GetDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/ListDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_CreateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).CreateDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/CreateDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).CreateDevice(ctx, req.(*CreateDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_UpdateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).UpdateDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/UpdateDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).UpdateDevice(ctx, req.(*UpdateDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_DeleteDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).DeleteDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/DeleteDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).DeleteDevice(ctx, req.(*DeleteDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_GetApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).GetApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/GetApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).GetApiKey(ctx, req.(*GetApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/ListApiKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/CreateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_DeleteApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).DeleteApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/DeleteApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).DeleteApiKey(ctx, req.(*DeleteApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_RotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).RotateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/RotateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).RotateApiKey(ctx, req.(*RotateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/GetProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/ListProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/CreateProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).UpdateMembership(ctx, in)
+		return srv.(StreckUServer).UpdateProduct(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/UpdateMembership",
+		FullMethod: "/saser.strecku.v1.StreckU/UpdateProduct",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).UpdateMembership(ctx, req.(*UpdateMembershipRequest))
+		return srv.(StreckUServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_DeleteMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMembershipRequest)
+func _StreckU_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).DeleteMembership(ctx, in)
+		return srv.(StreckUServer).DeleteProduct(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/DeleteMembership",
+		FullMethod: "/saser.strecku.v1.StreckU/DeleteProduct",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).DeleteMembership(ctx, req.(*DeleteMembershipRequest))
+		return srv.(StreckUServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductRequest)
+func _StreckU_GetPricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPricingRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).GetProduct(ctx, in)
+		return srv.(StreckUServer).GetPricingRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/GetProduct",
+		FullMethod: "/saser.strecku.v1.StreckU/GetPricingRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).GetProduct(ctx, req.(*GetProductRequest))
+		return srv.(StreckUServer).GetPricingRule(ctx, req.(*GetPricingRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProductsRequest)
+func _StreckU_ListPricingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPricingRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).ListProducts(ctx, in)
+		return srv.(StreckUServer).ListPricingRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/ListProducts",
+		FullMethod: "/saser.strecku.v1.StreckU/ListPricingRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).ListProducts(ctx, req.(*ListProductsRequest))
+		return srv.(StreckUServer).ListPricingRules(ctx, req.(*ListPricingRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProductRequest)
+func _StreckU_CreatePricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePricingRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).CreateProduct(ctx, in)
+		return srv.(StreckUServer).CreatePricingRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/CreateProduct",
+		FullMethod: "/saser.strecku.v1.StreckU/CreatePricingRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).CreateProduct(ctx, req.(*CreateProductRequest))
+		return srv.(StreckUServer).CreatePricingRule(ctx, req.(*CreatePricingRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductRequest)
+func _StreckU_UpdatePricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePricingRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).UpdateProduct(ctx, in)
+		return srv.(StreckUServer).UpdatePricingRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/UpdateProduct",
+		FullMethod: "/saser.strecku.v1.StreckU/UpdatePricingRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+		return srv.(StreckUServer).UpdatePricingRule(ctx, req.(*UpdatePricingRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreckU_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProductRequest)
+func _StreckU_DeletePricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePricingRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreckUServer).DeleteProduct(ctx, in)
+		return srv.(StreckUServer).DeletePricingRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/saser.strecku.v1.StreckU/DeleteProduct",
+		FullMethod: "/saser.strecku.v1.StreckU/DeletePricingRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreckUServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+		return srv.(StreckUServer).DeletePricingRule(ctx, req.(*DeletePricingRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -982,6 +2705,45 @@ func _StreckU_DeletePurchase_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StreckU_UndoPurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoPurchaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).UndoPurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/UndoPurchase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).UndoPurchase(ctx, req.(*UndoPurchaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_WatchPurchases_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPurchasesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreckUServer).WatchPurchases(m, &streckUWatchPurchasesServer{stream})
+}
+
+type StreckU_WatchPurchasesServer interface {
+	Send(*WatchPurchasesResponse) error
+	grpc.ServerStream
+}
+
+type streckUWatchPurchasesServer struct {
+	grpc.ServerStream
+}
+
+func (x *streckUWatchPurchasesServer) Send(m *WatchPurchasesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _StreckU_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPaymentRequest)
 	if err := dec(in); err != nil {
@@ -1072,6 +2834,156 @@ func _StreckU_DeletePayment_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StreckU_WatchPayments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPaymentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreckUServer).WatchPayments(m, &streckUWatchPaymentsServer{stream})
+}
+
+type StreckU_WatchPaymentsServer interface {
+	Send(*WatchPaymentsResponse) error
+	grpc.ServerStream
+}
+
+type streckUWatchPaymentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *streckUWatchPaymentsServer) Send(m *WatchPaymentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StreckU_GenerateStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).GenerateStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/GenerateStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).GenerateStatement(ctx, req.(*GenerateStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_GetStoreStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoreStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).GetStoreStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/GetStoreStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).GetStoreStatistics(ctx, req.(*GetStoreStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_ExportStore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportStoreRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreckUServer).ExportStore(m, &streckUExportStoreServer{stream})
+}
+
+type StreckU_ExportStoreServer interface {
+	Send(*ExportStoreResponse) error
+	grpc.ServerStream
+}
+
+type streckUExportStoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *streckUExportStoreServer) Send(m *ExportStoreResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StreckU_ImportStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).ImportStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/ImportStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).ImportStore(ctx, req.(*ImportStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_GenerateSwishPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateSwishPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).GenerateSwishPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/GenerateSwishPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).GenerateSwishPayment(ctx, req.(*GenerateSwishPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_ListLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLockoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).ListLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/ListLockouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).ListLockouts(ctx, req.(*ListLockoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreckU_DeleteLockout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLockoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreckUServer).DeleteLockout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saser.strecku.v1.StreckU/DeleteLockout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreckUServer).DeleteLockout(ctx, req.(*DeleteLockoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _StreckU_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "saser.strecku.v1.StreckU",
 	HandlerType: (*StreckUServer)(nil),
@@ -1096,6 +3008,42 @@ var _StreckU_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _StreckU_DeleteUser_Handler,
 		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _StreckU_ChangePassword_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _StreckU_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _StreckU_ResetPassword_Handler,
+		},
+		{
+			MethodName: "SendVerificationEmail",
+			Handler:    _StreckU_SendVerificationEmail_Handler,
+		},
+		{
+			MethodName: "VerifyEmailAddress",
+			Handler:    _StreckU_VerifyEmailAddress_Handler,
+		},
+		{
+			MethodName: "GetCard",
+			Handler:    _StreckU_GetCard_Handler,
+		},
+		{
+			MethodName: "ListCards",
+			Handler:    _StreckU_ListCards_Handler,
+		},
+		{
+			MethodName: "CreateCard",
+			Handler:    _StreckU_CreateCard_Handler,
+		},
+		{
+			MethodName: "DeleteCard",
+			Handler:    _StreckU_DeleteCard_Handler,
+		},
 		{
 			MethodName: "GetStore",
 			Handler:    _StreckU_GetStore_Handler,
@@ -1136,6 +3084,102 @@ var _StreckU_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMembership",
 			Handler:    _StreckU_DeleteMembership_Handler,
 		},
+		{
+			MethodName: "ChangeMembershipPin",
+			Handler:    _StreckU_ChangeMembershipPin_Handler,
+		},
+		{
+			MethodName: "IdentifyCard",
+			Handler:    _StreckU_IdentifyCard_Handler,
+		},
+		{
+			MethodName: "GetInvitation",
+			Handler:    _StreckU_GetInvitation_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _StreckU_ListInvitations_Handler,
+		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _StreckU_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "DeleteInvitation",
+			Handler:    _StreckU_DeleteInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _StreckU_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "DeclineInvitation",
+			Handler:    _StreckU_DeclineInvitation_Handler,
+		},
+		{
+			MethodName: "GetJoinRequest",
+			Handler:    _StreckU_GetJoinRequest_Handler,
+		},
+		{
+			MethodName: "ListJoinRequests",
+			Handler:    _StreckU_ListJoinRequests_Handler,
+		},
+		{
+			MethodName: "CreateJoinRequest",
+			Handler:    _StreckU_CreateJoinRequest_Handler,
+		},
+		{
+			MethodName: "DeleteJoinRequest",
+			Handler:    _StreckU_DeleteJoinRequest_Handler,
+		},
+		{
+			MethodName: "ApproveJoinRequest",
+			Handler:    _StreckU_ApproveJoinRequest_Handler,
+		},
+		{
+			MethodName: "RejectJoinRequest",
+			Handler:    _StreckU_RejectJoinRequest_Handler,
+		},
+		{
+			MethodName: "GetDevice",
+			Handler:    _StreckU_GetDevice_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _StreckU_ListDevices_Handler,
+		},
+		{
+			MethodName: "CreateDevice",
+			Handler:    _StreckU_CreateDevice_Handler,
+		},
+		{
+			MethodName: "UpdateDevice",
+			Handler:    _StreckU_UpdateDevice_Handler,
+		},
+		{
+			MethodName: "DeleteDevice",
+			Handler:    _StreckU_DeleteDevice_Handler,
+		},
+		{
+			MethodName: "GetApiKey",
+			Handler:    _StreckU_GetApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _StreckU_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _StreckU_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "DeleteApiKey",
+			Handler:    _StreckU_DeleteApiKey_Handler,
+		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    _StreckU_RotateApiKey_Handler,
+		},
 		{
 			MethodName: "GetProduct",
 			Handler:    _StreckU_GetProduct_Handler,
@@ -1156,6 +3200,26 @@ var _StreckU_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProduct",
 			Handler:    _StreckU_DeleteProduct_Handler,
 		},
+		{
+			MethodName: "GetPricingRule",
+			Handler:    _StreckU_GetPricingRule_Handler,
+		},
+		{
+			MethodName: "ListPricingRules",
+			Handler:    _StreckU_ListPricingRules_Handler,
+		},
+		{
+			MethodName: "CreatePricingRule",
+			Handler:    _StreckU_CreatePricingRule_Handler,
+		},
+		{
+			MethodName: "UpdatePricingRule",
+			Handler:    _StreckU_UpdatePricingRule_Handler,
+		},
+		{
+			MethodName: "DeletePricingRule",
+			Handler:    _StreckU_DeletePricingRule_Handler,
+		},
 		{
 			MethodName: "GetPurchase",
 			Handler:    _StreckU_GetPurchase_Handler,
@@ -1176,6 +3240,10 @@ var _StreckU_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePurchase",
 			Handler:    _StreckU_DeletePurchase_Handler,
 		},
+		{
+			MethodName: "UndoPurchase",
+			Handler:    _StreckU_UndoPurchase_Handler,
+		},
 		{
 			MethodName: "GetPayment",
 			Handler:    _StreckU_GetPayment_Handler,
@@ -1196,7 +3264,47 @@ var _StreckU_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePayment",
 			Handler:    _StreckU_DeletePayment_Handler,
 		},
+		{
+			MethodName: "GenerateStatement",
+			Handler:    _StreckU_GenerateStatement_Handler,
+		},
+		{
+			MethodName: "GetStoreStatistics",
+			Handler:    _StreckU_GetStoreStatistics_Handler,
+		},
+		{
+			MethodName: "ImportStore",
+			Handler:    _StreckU_ImportStore_Handler,
+		},
+		{
+			MethodName: "GenerateSwishPayment",
+			Handler:    _StreckU_GenerateSwishPayment_Handler,
+		},
+		{
+			MethodName: "ListLockouts",
+			Handler:    _StreckU_ListLockouts_Handler,
+		},
+		{
+			MethodName: "DeleteLockout",
+			Handler:    _StreckU_DeleteLockout_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPurchases",
+			Handler:       _StreckU_WatchPurchases_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchPayments",
+			Handler:       _StreckU_WatchPayments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportStore",
+			Handler:       _StreckU_ExportStore_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "saser/strecku/v1/strecku.proto",
 }
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/grpc"
+)
+
+var (
+	address         = flag.String("address", "localhost:8080", "Address of the StreckU server.")
+	store           = flag.String("store", "", "Resource name of the store, e.g. stores/{store}.")
+	productsFile    = flag.String("products", "", "CSV file with products to import. If empty, no products are imported.")
+	membershipsFile = flag.String("memberships", "", "CSV file with memberships to import. If empty, no memberships are imported.")
+	dryRun          = flag.Bool("dry-run", false, "Only validate the files and report any errors, without importing anything.")
+)
+
+func readFile(name string) ([]byte, error) {
+	if name == "" {
+		return nil, nil
+	}
+	return ioutil.ReadFile(name)
+}
+
+func imain() int {
+	flag.Parse()
+	if *store == "" {
+		log.Print("flag -store is missing")
+		return 1
+	}
+	if *productsFile == "" && *membershipsFile == "" {
+		log.Print("at least one of flags -products and -memberships must be given")
+		return 1
+	}
+	productsData, err := readFile(*productsFile)
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	membershipsData, err := readFile(*membershipsFile)
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+
+	ctx := context.Background()
+	cc, err := grpc.DialContext(ctx, *address, grpc.WithInsecure())
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	defer cc.Close()
+	client := pb.NewStreckUClient(cc)
+
+	// Always validate first, so that all row errors can be reported instead
+	// of only the first one.
+	resp, err := client.ImportStore(ctx, &pb.ImportStoreRequest{
+		Name:           *store,
+		ProductsCsv:    productsData,
+		MembershipsCsv: membershipsData,
+		ValidateOnly:   true,
+	})
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	if len(resp.RowErrors) > 0 {
+		for _, rowErr := range resp.RowErrors {
+			log.Printf("%s: record %d: %s", rowErr.File, rowErr.Record, rowErr.Message)
+		}
+		return 1
+	}
+	if *dryRun {
+		log.Printf("would import %d products and %d memberships", len(resp.Products), len(resp.Memberships))
+		return 0
+	}
+	resp, err = client.ImportStore(ctx, &pb.ImportStoreRequest{
+		Name:           *store,
+		ProductsCsv:    productsData,
+		MembershipsCsv: membershipsData,
+	})
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	for _, product := range resp.Products {
+		log.Printf("created product %s (%s)", product.Name, product.DisplayName)
+	}
+	for _, membership := range resp.Memberships {
+		log.Printf("created membership %s for %s", membership.Name, membership.User)
+	}
+	return 0
+}
+
+func main() {
+	os.Exit(imain())
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	address = flag.String("address", "localhost:8080", "Address of the StreckU server.")
+	store   = flag.String("store", "", "Resource name of the store, e.g. stores/{store}.")
+	start   = flag.String("start", "", "Inclusive start of the period, as a date (2006-01-02) or an RFC 3339 timestamp. If empty, everything up to -end is exported.")
+	end     = flag.String("end", "", "Exclusive end of the period, as a date (2006-01-02) or an RFC 3339 timestamp. If empty, everything from -start is exported.")
+	columns = flag.String("columns", "", "Comma-separated list of columns to export. If empty, all columns are exported.")
+	out     = flag.String("out", "", "File to write the CSV into. If empty, the CSV is written to standard output.")
+)
+
+func parseTime(s string) (*timestamppb.Timestamp, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		t, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return timestamppb.New(t), nil
+}
+
+func export(ctx context.Context, client pb.StreckUClient, req *pb.ExportStoreRequest, w io.Writer) error {
+	stream, err := client.ExportStore(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(resp.Data); err != nil {
+			return err
+		}
+	}
+}
+
+func imain() int {
+	flag.Parse()
+	if *store == "" {
+		log.Print("flag -store is missing")
+		return 1
+	}
+	startTime, err := parseTime(*start)
+	if err != nil {
+		log.Printf("flag -start: %v", err)
+		return 1
+	}
+	endTime, err := parseTime(*end)
+	if err != nil {
+		log.Printf("flag -end: %v", err)
+		return 1
+	}
+	var cols []string
+	if *columns != "" {
+		cols = strings.Split(*columns, ",")
+	}
+
+	ctx := context.Background()
+	cc, err := grpc.DialContext(ctx, *address, grpc.WithInsecure())
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	defer cc.Close()
+	client := pb.NewStreckUClient(cc)
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Print(err)
+			return 1
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.Print(err)
+			}
+		}()
+		w = f
+	}
+	req := &pb.ExportStoreRequest{
+		Name:      *store,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Columns:   cols,
+	}
+	if err := export(ctx, client, req, w); err != nil {
+		log.Print(err)
+		return 1
+	}
+	return 0
+}
+
+func main() {
+	os.Exit(imain())
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Saser/strecku/internal/database"
+)
+
+var (
+	dsn        = flag.String("dsn", os.Getenv("STRECKU_DATABASE_DSN"), "Connection string of the PostgreSQL database. Defaults to STRECKU_DATABASE_DSN.")
+	migrations = flag.String("migrations", "database", "Directory containing the migrations.")
+	timeout    = flag.Duration("timeout", 30*time.Second, "How long to wait for the database to become available.")
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), `Usage: %s [flags] <command>
+
+Commands:
+  up            Apply all migrations that have not been applied.
+  down [n]      Roll back the n most recently applied migrations (default 1).
+  goto <v>      Apply or roll back migrations until the schema has version v.
+  force <v>     Set the version of the schema to v without migrating, after
+                repairing a failed migration by hand.
+  version       Print the version of the schema.
+
+Flags:
+`, os.Args[0])
+	flag.PrintDefaults()
+}
+
+func run(m *database.Migrator, args []string) error {
+	switch cmd := args[0]; cmd {
+	case "up":
+		if len(args) != 1 {
+			return fmt.Errorf("%s: unexpected arguments: %q", cmd, args[1:])
+		}
+		return m.Up()
+	case "down":
+		n := 1
+		switch len(args) {
+		case 1:
+		case 2:
+			var err error
+			if n, err = strconv.Atoi(args[1]); err != nil {
+				return fmt.Errorf("%s: %w", cmd, err)
+			}
+		default:
+			return fmt.Errorf("%s: unexpected arguments: %q", cmd, args[2:])
+		}
+		return m.Down(n)
+	case "goto", "force":
+		if len(args) != 2 {
+			return fmt.Errorf("%s: want exactly one version, got %q", cmd, args[1:])
+		}
+		v, err := strconv.ParseUint(args[1], 10, 0)
+		if err != nil {
+			return fmt.Errorf("%s: %w", cmd, err)
+		}
+		if cmd == "goto" {
+			return m.Goto(uint(v))
+		}
+		return m.Force(int(v))
+	case "version":
+		if len(args) != 1 {
+			return fmt.Errorf("%s: unexpected arguments: %q", cmd, args[1:])
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+}
+
+func imain() int {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		return 2
+	}
+	if *dsn == "" {
+		log.Print("flag -dsn is missing")
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	m, err := database.NewMigrator(ctx, *dsn, *migrations)
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	defer func() {
+		if err := m.Close(); err != nil {
+			log.Print(err)
+		}
+	}()
+	if err := run(m, flag.Args()); err != nil {
+		log.Print(err)
+		return 1
+	}
+	// Every command reports the resulting version.
+	version, dirty, err := m.Version()
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	if dirty {
+		fmt.Printf("%d (dirty)\n", version)
+		return 1
+	}
+	fmt.Println(version)
+	return 0
+}
+
+func main() {
+	os.Exit(imain())
+}
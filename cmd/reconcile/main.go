@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"log"
+	"os"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/bankstatements"
+	"google.golang.org/grpc"
+)
+
+var (
+	address   = flag.String("address", "localhost:8080", "Address of the StreckU server.")
+	store     = flag.String("store", "", "Resource name of the store, e.g. stores/{store}.")
+	statement = flag.String("statement", "", "Bank statement file, in either camt.053 or BgMax format.")
+	report    = flag.String("report", "", "File to write the report of unmatched transactions into. If empty, the report is written to standard output.")
+	dryRun    = flag.Bool("dry-run", false, "Only match transactions and write the report, without creating any payments.")
+)
+
+func readStatement(name string) ([]bankstatements.Transaction, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return bankstatements.Parse(f)
+}
+
+func imain() int {
+	flag.Parse()
+	if *store == "" {
+		log.Print("flag -store is missing")
+		return 1
+	}
+	if *statement == "" {
+		log.Print("flag -statement is missing")
+		return 1
+	}
+	txs, err := readStatement(*statement)
+	if err != nil {
+		log.Printf("%s: %v", *statement, err)
+		return 1
+	}
+
+	ctx := context.Background()
+	cc, err := grpc.DialContext(ctx, *address, grpc.WithInsecure())
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	defer cc.Close()
+	client := pb.NewStreckUClient(cc)
+
+	membershipsResp, err := client.ListMemberships(ctx, &pb.ListMembershipsRequest{Parent: *store})
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	matched, unmatched := bankstatements.Match(txs, membershipsResp.Memberships)
+
+	// Transactions that have already been imported, from an earlier statement
+	// or an earlier run on this one, are recognized by their payments. See
+	// bankstatements.Imported for how transactions without an ID are handled.
+	paymentsResp, err := client.ListPayments(ctx, &pb.ListPaymentsRequest{Parent: *store})
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	imported, pending := bankstatements.Imported(matched, paymentsResp.Payments)
+	for _, m := range imported {
+		log.Printf("skipping already imported transaction: %s", m.Transaction.Description())
+	}
+
+	created := 0
+	for _, m := range pending {
+		payment := m.Payment()
+		if *dryRun {
+			log.Printf("would create payment of %d cents for %s", payment.AmountCents, m.Membership.Name)
+			continue
+		}
+		p, err := client.CreatePayment(ctx, &pb.CreatePaymentRequest{
+			Parent:  *store,
+			Payment: payment,
+		})
+		if err != nil {
+			log.Print(err)
+			return 1
+		}
+		log.Printf("created payment %s of %d cents for %s", p.Name, p.AmountCents, m.Membership.Name)
+		created++
+	}
+	log.Printf("%d transactions matched, %d payments created, %d transactions unmatched", len(matched), created, len(unmatched))
+
+	w := io.Writer(os.Stdout)
+	if *report != "" {
+		f, err := os.Create(*report)
+		if err != nil {
+			log.Print(err)
+			return 1
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.Print(err)
+			}
+		}()
+		w = f
+	}
+	if err := bankstatements.WriteReport(w, unmatched); err != nil {
+		log.Print(err)
+		return 1
+	}
+	return 0
+}
+
+func main() {
+	os.Exit(imain())
+}
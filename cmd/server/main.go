@@ -1,46 +1,409 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"database/sql"
+	"flag"
+	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
+	"time"
 
 	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/admin"
+	"github.com/Saser/strecku/internal/authn"
+	"github.com/Saser/strecku/internal/certs"
+	"github.com/Saser/strecku/internal/config"
+	"github.com/Saser/strecku/internal/database"
+	"github.com/Saser/strecku/internal/gateway"
+	"github.com/Saser/strecku/internal/healthcheck"
+	"github.com/Saser/strecku/internal/lockout"
+	"github.com/Saser/strecku/internal/logging"
+	"github.com/Saser/strecku/internal/mail"
+	"github.com/Saser/strecku/internal/metrics"
+	"github.com/Saser/strecku/internal/mux"
 	"github.com/Saser/strecku/internal/repositories"
 	"github.com/Saser/strecku/internal/service"
+	"github.com/Saser/strecku/internal/statistics"
+	"github.com/Saser/strecku/internal/tokens"
+	"github.com/Saser/strecku/resources/stores/devices"
+	"github.com/Saser/strecku/resources/stores/devices/apikeys"
+	"github.com/Saser/strecku/resources/stores/invitations"
+	"github.com/Saser/strecku/resources/stores/joinrequests"
 	"github.com/Saser/strecku/resources/stores/memberships"
 	"github.com/Saser/strecku/resources/stores/payments"
+	"github.com/Saser/strecku/resources/stores/pricingrules"
 	"github.com/Saser/strecku/resources/stores/products"
 	"github.com/Saser/strecku/resources/stores/purchases"
+	"github.com/Saser/strecku/resources/users/cards"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/cors"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/test/bufconn"
 )
 
-func main() {
-	srv := grpc.NewServer()
-	log.Print("created gRPC server")
+const (
+	// databaseOpenTimeout is how long to wait for the database to become
+	// available at startup.
+	databaseOpenTimeout = 30 * time.Second
+	// migrateTimeout is how long to wait for other servers to finish
+	// migrating the database, and to migrate it, at startup.
+	migrateTimeout = 5 * time.Minute
+	// shutdownTimeout is how long to wait for requests to complete when
+	// shutting down.
+	shutdownTimeout = 30 * time.Second
+	// gatewayBufferSize is the size of the in-process connection used by
+	// the REST gateway to call the gRPC server.
+	gatewayBufferSize = 1024 * 1024
+	// streckUService is the full name of the StreckU gRPC service.
+	streckUService = "saser.strecku.v1.StreckU"
+)
+
+func openUsers(cfg config.Database, logger *logging.Logger) (repositories.Users, *sql.DB, error) {
+	switch cfg.Backend {
+	case config.BackendPostgres:
+		ctx, cancel := context.WithTimeout(context.Background(), databaseOpenTimeout)
+		defer cancel()
+		db, err := database.Open(ctx, cfg.DSN)
+		if err != nil {
+			return nil, nil, err
+		}
+		logger.Infof("connected to PostgreSQL database")
+		if cfg.AutoMigrate {
+			ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+			defer cancel()
+			version, err := database.MigrateUp(ctx, db, cfg.DSN, cfg.MigrationsDir)
+			if err != nil {
+				db.Close()
+				return nil, nil, fmt.Errorf("migrate: %w", err)
+			}
+			logger.Infof("database schema is at version %d", version)
+		}
+		return repositories.NewPostgresUsers(db), db, nil
+	default:
+		return repositories.NewInMemoryUsers(), nil, nil
+	}
+}
+
+// openMailer returns the mailer configured by cfg, and a function that closes
+// it.
+func openMailer(cfg config.Mail) (mail.Mailer, func() error, error) {
+	switch cfg.Backend {
+	case config.MailBackendSMTP:
+		return &mail.SMTP{
+			Address:  cfg.SMTP.Address,
+			From:     cfg.From,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+		}, func() error { return nil }, nil
+	case config.MailBackendFile:
+		if cfg.File == "" {
+			return mail.NewWriter(os.Stderr, cfg.From), func() error { return nil }, nil
+		}
+		w, f, err := mail.OpenFile(cfg.File, cfg.From)
+		if err != nil {
+			return nil, nil, err
+		}
+		return w, f.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown mail backend %q", cfg.Backend)
+	}
+}
+
+// adminSettings returns the settings in cfg to show on the admin info page.
+func adminSettings(cfg *config.Config) []admin.Setting {
+	tlsCert := "disabled"
+	if cfg.TLS.Enabled() {
+		tlsCert = cfg.TLS.CertFile
+	}
+	clientAuth := "disabled"
+	if cfg.TLS.ClientCAFile != "" {
+		clientAuth = fmt.Sprintf("%s (%s)", cfg.TLS.ClientAuth, cfg.TLS.ClientCAFile)
+	}
+	return []admin.Setting{
+		{Name: "address", Value: cfg.Address},
+		{Name: "admin_address", Value: cfg.AdminAddress},
+		{Name: "log_level", Value: cfg.LogLevel},
+		{Name: "log_format", Value: cfg.LogFormat},
+		{Name: "tls.cert_file", Value: tlsCert},
+		{Name: "tls.client_auth", Value: clientAuth},
+		{Name: "cors.allowed_origins", Value: strings.Join(cfg.CORS.AllowedOrigins, ", ")},
+		{Name: "database.backend", Value: cfg.Database.Backend},
+		{Name: "database.auto_migrate", Value: strconv.FormatBool(cfg.Database.AutoMigrate)},
+		{Name: "mail.backend", Value: cfg.Mail.Backend},
+		{Name: "mail.from", Value: cfg.Mail.From},
+	}
+}
+
+func imain() int {
+	startTime := time.Now()
+	cfg, err := config.Load(os.Args[0], os.Args[1:], os.Getenv)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		log.Printf("invalid configuration: %v", err)
+		return 2
+	}
+	level, err := logging.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		log.Print(err)
+		return 2
+	}
+	format, err := logging.ParseFormat(cfg.LogFormat)
+	if err != nil {
+		log.Print(err)
+		return 2
+	}
+	logger := logging.NewWithFormat(os.Stderr, level, format)
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	)
+	m, err := metrics.New(registry)
+	if err != nil {
+		logger.Errorf("register metrics: %v", err)
+		return 1
+	}
+
+	// Only users are stored in PostgreSQL so far; the other resources are
+	// always kept in memory.
+	userRepo, db, err := openUsers(cfg.Database, logger)
+	if err != nil {
+		logger.Errorf("open %s database: %v", cfg.Database.Backend, err)
+		return 1
+	}
+	if db != nil {
+		defer func() {
+			if err := db.Close(); err != nil {
+				logger.Errorf("close database: %v", err)
+			}
+		}()
+	}
+	guard := lockout.NewGuard()
+	guard.OnEvent = func(e lockout.Event) {
+		l := logger.With("security_event", e.Type.String())
+		if e.User != "" {
+			l = l.With("user", e.User)
+		}
+		if e.Peer != "" {
+			l = l.With("peer", e.Peer)
+		}
+		switch e.Type {
+		case lockout.EventLoginSucceeded:
+			l.Infof("login succeeded")
+		case lockout.EventLoginFailed:
+			l.Warnf("login failed")
+		case lockout.EventLoginBlocked:
+			l.Warnf("login blocked because of earlier failures")
+		case lockout.EventLockedOut:
+			l.Warnf("locked out after too many failed logins")
+		case lockout.EventLockoutCleared:
+			l.Infof("lockout cleared")
+		}
+	}
+	// The guard wraps the metrics, so that only checked passwords are
+	// timed.
+	guardedUsers := guard.Users(m.Users(userRepo))
+
+	// The metrics and logging interceptors come first, so that they observe
+	// the status codes returned by the other interceptors.
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			m.UnaryServerInterceptor(),
+			logging.UnaryServerInterceptor(logger),
+		),
+		grpc.ChainStreamInterceptor(
+			m.StreamServerInterceptor(),
+			logging.StreamServerInterceptor(logger),
+		),
+	}
+	var tlsConfig *tls.Config
+	if cfg.TLS.Enabled() {
+		clientAuth := tls.NoClientCert
+		switch cfg.TLS.ClientAuth {
+		case config.ClientAuthRequest:
+			clientAuth = tls.VerifyClientCertIfGiven
+		case config.ClientAuthRequire:
+			clientAuth = tls.RequireAndVerifyClientCert
+		}
+		reloader, err := certs.NewReloader(cfg.TLS.CertFile, cfg.TLS.KeyFile, cfg.TLS.ClientCAFile, clientAuth)
+		if err != nil {
+			logger.Errorf("load TLS material: %v", err)
+			return 1
+		}
+		reloader.OnError = func(err error) {
+			logger.Warnf("reload TLS material, keeping previous: %v", err)
+		}
+		tlsConfig = reloader.TLSConfig()
+		logger.Infof("using TLS certificate %s", cfg.TLS.CertFile)
+		if cfg.TLS.ClientCAFile != "" {
+			identities := authn.CertificateIdentities(cfg.TLS.ClientIdentities)
+			opts = append(opts,
+				grpc.ChainUnaryInterceptor(identities.UnaryServerInterceptor()),
+				grpc.ChainStreamInterceptor(identities.StreamServerInterceptor()),
+			)
+			logger.Infof("verifying client certificates (%s) against %s", cfg.TLS.ClientAuth, cfg.TLS.ClientCAFile)
+		}
+	} else {
+		logger.Warnf("TLS is not configured, serving in plaintext")
+	}
+	passwords := authn.Passwords{Users: guardedUsers}
+	// The repositories of devices and API keys, and the tokens issued to
+	// kiosks by IdentifyCard, are shared by the service and the
+	// interceptors authenticating devices.
+	deviceRepo := devices.NewRepository()
+	apiKeyRepo := apikeys.NewRepository()
+	tokenStore := tokens.NewStore()
+	keys := authn.APIKeys{APIKeys: apiKeyRepo, Devices: deviceRepo, Tokens: tokenStore}
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(
+			passwords.UnaryServerInterceptor(),
+			keys.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			passwords.StreamServerInterceptor(),
+			keys.StreamServerInterceptor(),
+		),
+	)
+	srv := grpc.NewServer(opts...)
+	logger.Debugf("created gRPC server")
 
+	membershipRepo := memberships.NewRepository()
+	membershipRepo.Timer = m.RepositoryTimer("memberships")
+	productRepo := products.NewRepository()
+	productRepo.Timer = m.RepositoryTimer("products")
+	purchaseRepo := purchases.NewRepository()
+	purchaseRepo.Timer = m.RepositoryTimer("purchases")
+	paymentRepo := payments.NewRepository()
+	paymentRepo.Timer = m.RepositoryTimer("payments")
+	aggregator, err := statistics.NewAggregator(context.Background(), purchaseRepo)
+	if err != nil {
+		logger.Errorf("create statistics aggregator: %v", err)
+		return 1
+	}
+	defer aggregator.Close()
+	mailer, closeMailer, err := openMailer(cfg.Mail)
+	if err != nil {
+		logger.Errorf("open %s mailer: %v", cfg.Mail.Backend, err)
+		return 1
+	}
+	defer func() {
+		if err := closeMailer(); err != nil {
+			logger.Errorf("close mailer: %v", err)
+		}
+	}()
 	svc := service.New(
-		repositories.NewInMemoryUsers(),
-		repositories.NewInMemoryStores(),
-		memberships.NewRepository(),
-		products.NewRepository(),
-		purchases.NewRepository(),
-		payments.NewRepository(),
+		guardedUsers,
+		m.Stores(repositories.NewInMemoryStores()),
+		membershipRepo,
+		productRepo,
+		purchaseRepo,
+		paymentRepo,
+		invitations.NewRepository(),
+		joinrequests.NewRepository(),
+		deviceRepo,
+		apiKeyRepo,
+		cards.NewRepository(),
+		pricingrules.NewRepository(),
+		guard,
+		aggregator,
+		mailer,
+		tokenStore,
 	)
-	log.Print("created StreckU service")
+	svc.Administrators = cfg.TLS.AdminIdentities
+	logger.Infof("created StreckU service using %s backend", cfg.Database.Backend)
 
 	pb.RegisterStreckUServer(srv, svc)
-	log.Print("registered StreckU service on gRPC server")
+	logger.Debugf("registered StreckU service on gRPC server")
 
-	lis, err := net.Listen("tcp", ":8080")
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(srv, healthServer)
+	reflection.Register(srv)
+	logger.Debugf("registered health and reflection services on gRPC server")
+	checker := healthcheck.NewChecker(healthServer, streckUService)
+	checker.OnChange = func(r healthcheck.Result) {
+		if r.Err != nil {
+			logger.Warnf("health check %s failing: %v", r.Name, r.Err)
+			return
+		}
+		logger.Infof("health check %s passing", r.Name)
+	}
+	if db != nil {
+		checker.Add("database", db.PingContext)
+	}
+
+	// The REST gateway calls the gRPC server over an in-process connection,
+	// so that REST requests go through the same interceptors as gRPC
+	// requests.
+	gatewayLis := bufconn.Listen(gatewayBufferSize)
+	gatewayDial := func(context.Context, string) (net.Conn, error) { return gatewayLis.Dial() }
+	gatewayConn, err := grpc.DialContext(
+		context.Background(),
+		"bufconn",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(gatewayDial),
+	)
 	if err != nil {
-		log.Print(err)
-		return
+		logger.Errorf("dial gRPC server for REST gateway: %v", err)
+		return 1
+	}
+	rest, err := gateway.New(context.Background(), gatewayConn)
+	if err != nil {
+		logger.Errorf("create REST gateway: %v", err)
+		return 1
+	}
+	logger.Debugf("created REST gateway")
+	rest = cors.New(cors.Options{
+		AllowOriginFunc: cfg.CORS.AllowOrigin,
+		AllowedMethods: []string{
+			http.MethodGet,
+			http.MethodPost,
+			http.MethodPatch,
+			http.MethodDelete,
+		},
+		AllowedHeaders: []string{"Authorization", "Content-Type"},
+	}).Handler(rest)
+	handler := mux.New(srv, rest, grpcweb.WithOriginFunc(cfg.CORS.AllowOrigin))
+	if len(cfg.CORS.AllowedOrigins) > 0 {
+		logger.Infof("allowing cross-origin requests from %s", strings.Join(cfg.CORS.AllowedOrigins, ", "))
+	}
+
+	httpSrv := &http.Server{Handler: handler}
+	h2Srv := &http2.Server{}
+	if err := http2.ConfigureServer(httpSrv, h2Srv); err != nil {
+		logger.Errorf("configure HTTP/2: %v", err)
+		return 1
+	}
+	lis, err := net.Listen("tcp", cfg.Address)
+	if err != nil {
+		logger.Errorf("%v", err)
+		return 1
+	}
+	logger.Infof("listening on address %s", lis.Addr())
+	if tlsConfig != nil {
+		lis = tls.NewListener(lis, tlsConfig)
+	} else {
+		// Without TLS, gRPC clients use HTTP/2 without upgrading from
+		// HTTP/1.1 first.
+		httpSrv.Handler = h2c.NewHandler(handler, h2Srv)
 	}
-	log.Print("listening on address :8080")
 	defer func() {
 		if err := lis.Close(); err != nil {
 			// This specific error is not exported, which
@@ -50,10 +413,10 @@ func main() {
 			// https://github.com/golang/go/issues/4373
 			// for more details.
 			//
-			// Why do we ignore this error? The gRPC
+			// Why do we ignore this error? The HTTP
 			// server will use this listener to accept
-			// connections. When the gRPC server is
-			// stopped (see the call to srv.GracefulStop()
+			// connections. When the HTTP server is shut
+			// down (see the call to httpSrv.Shutdown()
 			// below), it will also close the listener. We
 			// will close it a second time in this
 			// deferred function, and doing so will cause
@@ -65,16 +428,56 @@ func main() {
 			if strings.Contains(err.Error(), "use of closed network connection") {
 				return
 			}
-			log.Print(err)
+			logger.Errorf("%v", err)
 			return
 		}
-		log.Print("closed clistener")
+		logger.Debugf("closed listener")
 	}()
 
+	var (
+		adminSrv *http.Server
+		adminLis net.Listener
+	)
+	if cfg.AdminAddress != "" {
+		adminLis, err = net.Listen("tcp", cfg.AdminAddress)
+		if err != nil {
+			logger.Errorf("%v", err)
+			return 1
+		}
+		logger.Infof("listening on admin address %s", adminLis.Addr())
+		adminMux := admin.NewServeMux(checker, admin.Info{
+			StartTime: startTime,
+			Settings:  adminSettings(cfg),
+		})
+		adminMux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+		adminSrv = &http.Server{Handler: adminMux}
+	}
+
 	var g errgroup.Group
+	checkCtx, stopChecks := context.WithCancel(context.Background())
+	defer stopChecks()
+	g.Go(func() error {
+		checker.Run(checkCtx)
+		return nil
+	})
+	g.Go(func() error {
+		return srv.Serve(gatewayLis)
+	})
+	if adminSrv != nil {
+		g.Go(func() error {
+			logger.Infof("serving admin endpoints")
+			if err := adminSrv.Serve(adminLis); err != http.ErrServerClosed {
+				return err
+			}
+			return nil
+		})
+	}
 	g.Go(func() error {
-		log.Print("serving gRPC server")
-		return srv.Serve(lis)
+		logger.Infof("serving gRPC, gRPC-Web and REST requests")
+		if err := httpSrv.Serve(lis); err != http.ErrServerClosed {
+			return err
+		}
+		return nil
 	})
 
 	sigChan := make(chan os.Signal, 1)
@@ -82,12 +485,40 @@ func main() {
 	signal.Notify(sigChan, os.Interrupt)
 
 	<-sigChan
-	log.Print("interrupt received, shutting down")
+	logger.Infof("interrupt received, shutting down")
+	// Report the server as not serving first, so that load balancers stop
+	// sending new requests while the current ones complete.
+	checker.Shutdown()
+	stopChecks()
+	// Watch streams never end by themselves.
+	svc.StopWatches()
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpSrv.Shutdown(ctx); err != nil {
+		logger.Warnf("shut down HTTP server: %v; stopping gRPC server without waiting for requests", err)
+		srv.Stop()
+	}
+	// The gRPC server cannot gracefully stop while serving requests through
+	// the HTTP server, so wait for those requests first.
+	handler.Wait()
+	if err := gatewayConn.Close(); err != nil {
+		logger.Errorf("close REST gateway connection: %v", err)
+	}
 	srv.GracefulStop()
+	if adminSrv != nil {
+		if err := adminSrv.Shutdown(ctx); err != nil {
+			logger.Warnf("shut down admin server: %v", err)
+		}
+	}
 
 	if err := g.Wait(); err != nil {
-		log.Print(err)
-		return
+		logger.Errorf("%v", err)
+		return 1
 	}
-	log.Print("goodbye")
+	logger.Infof("goodbye")
+	return 0
+}
+
+func main() {
+	os.Exit(imain())
 }
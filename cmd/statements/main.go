@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/statements"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	address = flag.String("address", "localhost:8080", "Address of the StreckU server.")
+	store   = flag.String("store", "", "Resource name of the store, e.g. stores/{store}.")
+	start   = flag.String("start", "", "Inclusive start of the period, as a date (2006-01-02) or an RFC 3339 timestamp. If empty, the period starts at the first purchase or payment.")
+	end     = flag.String("end", "", "Exclusive end of the period, as a date (2006-01-02) or an RFC 3339 timestamp. If empty, the period ends now.")
+	format  = flag.String("format", "text", "Format of the statements: one of text, html, or csv.")
+	out     = flag.String("out", "", "Directory to write statements into. It is created if it does not exist.")
+)
+
+var writers = map[string]struct {
+	ext   string
+	write func(io.Writer, *pb.Statement) error
+}{
+	"text": {ext: ".txt", write: statements.WriteText},
+	"html": {ext: ".html", write: statements.WriteHTML},
+	"csv":  {ext: ".csv", write: statements.WriteCSV},
+}
+
+func parseTime(s string) (*timestamppb.Timestamp, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		t, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return timestamppb.New(t), nil
+}
+
+func writeStatement(path string, write func(io.Writer, *pb.Statement) error, statement *pb.Statement) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cErr := f.Close(); cErr != nil && err == nil {
+			err = cErr
+		}
+	}()
+	return write(f, statement)
+}
+
+func imain() int {
+	flag.Parse()
+	if *store == "" {
+		log.Print("flag -store is missing")
+		return 1
+	}
+	if *out == "" {
+		log.Print("flag -out is missing")
+		return 1
+	}
+	w, ok := writers[*format]
+	if !ok {
+		log.Printf("flag -format has invalid value %q", *format)
+		return 1
+	}
+	startTime, err := parseTime(*start)
+	if err != nil {
+		log.Printf("flag -start: %v", err)
+		return 1
+	}
+	endTime, err := parseTime(*end)
+	if err != nil {
+		log.Printf("flag -end: %v", err)
+		return 1
+	}
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		log.Print(err)
+		return 1
+	}
+
+	ctx := context.Background()
+	cc, err := grpc.DialContext(ctx, *address, grpc.WithInsecure())
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	defer cc.Close()
+	client := pb.NewStreckUClient(cc)
+
+	resp, err := client.ListMemberships(ctx, &pb.ListMembershipsRequest{Parent: *store})
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	for _, membership := range resp.Memberships {
+		statement, err := client.GenerateStatement(ctx, &pb.GenerateStatementRequest{
+			Membership: membership.Name,
+			StartTime:  startTime,
+			EndTime:    endTime,
+		})
+		if err != nil {
+			log.Print(err)
+			return 1
+		}
+		user, err := client.GetUser(ctx, &pb.GetUserRequest{Name: membership.User})
+		if err != nil {
+			log.Print(err)
+			return 1
+		}
+		path := filepath.Join(*out, user.EmailAddress+w.ext)
+		if err := writeStatement(path, w.write, statement); err != nil {
+			log.Print(err)
+			return 1
+		}
+		log.Printf("wrote statement for %s to %s", user.EmailAddress, path)
+	}
+	return 0
+}
+
+func main() {
+	os.Exit(imain())
+}
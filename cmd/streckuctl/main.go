@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Saser/strecku/auth"
+	"github.com/Saser/strecku/internal/streckuctl"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	address   = flag.String("address", "localhost:8080", "Address of the StreckU server.")
+	plaintext = flag.Bool("plaintext", false, "Connect without TLS. Credentials are then sent in plaintext.")
+	caFile    = flag.String("ca-file", "", "PEM file of CA certificates used to verify the server. If empty, the system's CA certificates are used.")
+	certFile  = flag.String("cert-file", "", "PEM file containing a client certificate chain.")
+	keyFile   = flag.String("key-file", "", "PEM file containing the private key of the client certificate.")
+	username  = flag.String("username", "", "Email address or resource name of the user to authenticate as.")
+	password  = flag.String("password", "", "Password of the user. Defaults to STRECKU_PASSWORD.")
+	token     = flag.String("token", "", "Bearer token to authenticate with. Defaults to STRECKU_TOKEN.")
+	output    = flag.String("output", "table", "Output format: table, json or yaml.")
+	fields    = flag.String("fields", "", "Comma-separated list of the fields to print. If empty, all fields are printed.")
+	timeout   = flag.Duration("timeout", 30*time.Second, "Timeout of the command.")
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <command>\n\n%s\nFlags:\n", os.Args[0], streckuctl.Usage)
+	flag.PrintDefaults()
+}
+
+// plaintextCredentials allows credentials that require transport security to
+// be sent over a connection without TLS, which is what -plaintext asks for.
+type plaintextCredentials struct {
+	credentials.PerRPCCredentials
+}
+
+func (plaintextCredentials) RequireTransportSecurity() bool { return false }
+
+func perRPCCredentials() (credentials.PerRPCCredentials, error) {
+	if *password == "" {
+		*password = os.Getenv("STRECKU_PASSWORD")
+	}
+	if *token == "" {
+		*token = os.Getenv("STRECKU_TOKEN")
+	}
+	switch {
+	case *username != "" && *token != "":
+		return nil, errors.New("flags -username and -token are mutually exclusive")
+	case *username != "":
+		if *password == "" {
+			return nil, errors.New("flag -password or STRECKU_PASSWORD is required with -username")
+		}
+		return auth.Basic{Username: *username, Password: *password}, nil
+	case *token != "":
+		return auth.Bearer{Token: *token}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func transportCredentials() (credentials.TransportCredentials, error) {
+	config := new(tls.Config)
+	if *caFile != "" {
+		pem, err := ioutil.ReadFile(*caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s: no certificates found", *caFile)
+		}
+	}
+	if *certFile != "" || *keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(*certFile, *keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(config), nil
+}
+
+func imain() int {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		return 2
+	}
+	format, err := streckuctl.ParseFormat(*output)
+	if err != nil {
+		log.Print(err)
+		return 2
+	}
+	var fieldNames []string
+	if *fields != "" {
+		fieldNames = strings.Split(*fields, ",")
+	}
+	creds, err := perRPCCredentials()
+	if err != nil {
+		log.Print(err)
+		return 2
+	}
+
+	var opts []grpc.DialOption
+	if *plaintext {
+		opts = append(opts, grpc.WithInsecure())
+		if creds != nil {
+			creds = plaintextCredentials{creds}
+		}
+	} else {
+		tc, err := transportCredentials()
+		if err != nil {
+			log.Print(err)
+			return 1
+		}
+		opts = append(opts, grpc.WithTransportCredentials(tc))
+	}
+	if creds != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(creds))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	cc, err := grpc.DialContext(ctx, *address, opts...)
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	defer cc.Close()
+
+	p := streckuctl.NewPrinter(os.Stdout, format, fieldNames)
+	if err := streckuctl.Run(ctx, cc, os.Stdin, p, flag.Args()); err != nil {
+		log.Print(err)
+		if usageErr := new(streckuctl.UsageError); errors.As(err, &usageErr) {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+func main() {
+	os.Exit(imain())
+}
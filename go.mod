@@ -4,17 +4,27 @@ go 1.15
 
 require (
 	github.com/cenkalti/backoff/v4 v4.0.2
+	github.com/desertbit/timer v0.0.0-20180107155436-c41aec40b27f // indirect
 	github.com/golang-migrate/migrate/v4 v4.14.1
 	github.com/golang/protobuf v1.4.3
 	github.com/google/go-cmp v0.5.2
 	github.com/google/uuid v1.1.2
 	github.com/googleapis/api-linter v1.6.0
+	github.com/gorilla/websocket v1.4.2 // indirect
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.0.1
+	github.com/improbable-eng/grpc-web v0.13.0
 	github.com/jackc/pgx/v4 v4.10.1
 	github.com/ory/dockertest/v3 v3.6.3
+	github.com/prometheus/client_golang v1.8.0
+	github.com/rs/cors v1.7.0
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
 	github.com/stretchr/testify v1.6.1
+	golang.org/x/crypto v0.0.0-20200709230013-948cd5f35899
+	golang.org/x/net v0.0.0-20201029221708-28c70e62bb1d
 	golang.org/x/sync v0.0.0-20201207232520-09787c993a3a
 	google.golang.org/genproto v0.0.0-20201030142918-24207fddd1c3
 	google.golang.org/grpc v1.33.1
 	google.golang.org/grpc/cmd/protoc-gen-go-grpc v1.0.0
 	google.golang.org/protobuf v1.25.1-0.20200908202017-db5c900f0ce5
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c
 )
@@ -0,0 +1,151 @@
+package admin
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"github.com/Saser/strecku/internal/healthcheck"
+)
+
+// Setting is a configuration setting of the server, shown on the info page.
+// Secrets, such as database connection strings, must not be included.
+type Setting struct {
+	Name  string
+	Value string
+}
+
+// Info contains information about the server shown on the info page.
+type Info struct {
+	StartTime time.Time
+	Settings  []Setting
+}
+
+type handler struct {
+	checker *healthcheck.Checker
+	info    Info
+	now     func() time.Time
+}
+
+// NewServeMux returns a ServeMux serving the following admin endpoints:
+//
+//  * /healthz responds with 200 OK as long as the server is able to respond to
+//    HTTP requests at all, and is intended for liveness probes.
+//  * /readyz responds with 200 OK if the checks of checker pass, and with 503
+//    Service Unavailable otherwise, and is intended for readiness probes.
+//  * / shows a page with runtime information about the server.
+//
+// More endpoints can be registered on the returned ServeMux.
+func NewServeMux(checker *healthcheck.Checker, info Info) *http.ServeMux {
+	h := &handler{
+		checker: checker,
+		info:    info,
+		now:     time.Now,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", h.healthz)
+	mux.HandleFunc("/readyz", h.readyz)
+	mux.HandleFunc("/", h.index)
+	return mux
+}
+
+func (h *handler) healthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintln(w, "ok")
+}
+
+func (h *handler) readyz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if !h.checker.Ready() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	for _, r := range h.checker.Results() {
+		if r.Err != nil {
+			fmt.Fprintf(w, "%s: %v\n", r.Name, r.Err)
+		} else {
+			fmt.Fprintf(w, "%s: ok\n", r.Name)
+		}
+	}
+	if h.checker.Ready() {
+		fmt.Fprintln(w, "ready")
+	} else {
+		fmt.Fprintln(w, "not ready")
+	}
+}
+
+var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>StreckU server</title>
+</head>
+<body>
+<h1>StreckU server</h1>
+<h2>Runtime</h2>
+<table>
+<tr><th>Module</th><td>{{.Module}}</td></tr>
+<tr><th>Go version</th><td>{{.GoVersion}}</td></tr>
+<tr><th>Platform</th><td>{{.Platform}}</td></tr>
+<tr><th>Started</th><td>{{.StartTime.Format "2006-01-02 15:04:05 MST"}} ({{.Uptime}} ago)</td></tr>
+<tr><th>Goroutines</th><td>{{.Goroutines}}</td></tr>
+<tr><th>Heap in use</th><td>{{.HeapInUse}} bytes</td></tr>
+<tr><th>Memory from OS</th><td>{{.Sys}} bytes</td></tr>
+<tr><th>Garbage collections</th><td>{{.NumGC}}</td></tr>
+</table>
+<h2>Health checks</h2>
+<table>
+{{range .Checks}}<tr><th>{{.Name}}</th><td>{{if .Err}}failing: {{.Err}}{{else}}ok{{end}}</td><td>{{.Time.Format "15:04:05"}}</td></tr>
+{{else}}<tr><td>No health check results.</td></tr>
+{{end}}</table>
+<h2>Configuration</h2>
+<table>
+{{range .Settings}}<tr><th>{{.Name}}</th><td>{{.Value}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+func (h *handler) index(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	module := "unknown"
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		module = bi.Main.Path + " " + bi.Main.Version
+	}
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	data := struct {
+		Module     string
+		GoVersion  string
+		Platform   string
+		StartTime  time.Time
+		Uptime     time.Duration
+		Goroutines int
+		HeapInUse  uint64
+		Sys        uint64
+		NumGC      uint32
+		Checks     []healthcheck.Result
+		Settings   []Setting
+	}{
+		Module:     module,
+		GoVersion:  runtime.Version(),
+		Platform:   runtime.GOOS + "/" + runtime.GOARCH,
+		StartTime:  h.info.StartTime,
+		Uptime:     h.now().Sub(h.info.StartTime).Round(time.Second),
+		Goroutines: runtime.NumGoroutine(),
+		HeapInUse:  mem.HeapInuse,
+		Sys:        mem.Sys,
+		NumGC:      mem.NumGC,
+		Checks:     h.checker.Results(),
+		Settings:   h.info.Settings,
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := indexTemplate.Execute(w, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
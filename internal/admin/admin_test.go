@@ -0,0 +1,99 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Saser/strecku/internal/healthcheck"
+	"google.golang.org/grpc/health"
+)
+
+func get(t *testing.T, ts *httptest.Server, path string) (int, string) {
+	t.Helper()
+	resp, err := ts.Client().Get(ts.URL + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, string(body)
+}
+
+func TestNewServeMux(t *testing.T) {
+	ctx := context.Background()
+	checker := healthcheck.NewChecker(health.NewServer())
+	var dbErr error
+	checker.Add("database", func(context.Context) error { return dbErr })
+	ts := httptest.NewServer(NewServeMux(checker, Info{
+		StartTime: time.Now(),
+		Settings: []Setting{
+			{Name: "database.backend", Value: "<postgres>"},
+		},
+	}))
+	t.Cleanup(ts.Close)
+
+	type want struct {
+		path     string
+		code     int
+		contains []string
+	}
+	check := func(t *testing.T, wants []want) {
+		t.Helper()
+		for _, w := range wants {
+			code, body := get(t, ts, w.path)
+			if code != w.code {
+				t.Errorf("GET %s: status = %d; want %d", w.path, code, w.code)
+			}
+			for _, s := range w.contains {
+				if !strings.Contains(body, s) {
+					t.Errorf("GET %s: body does not contain %q:\n%s", w.path, s, body)
+				}
+			}
+		}
+	}
+
+	t.Run("BeforeChecks", func(t *testing.T) {
+		check(t, []want{
+			{path: "/healthz", code: http.StatusOK, contains: []string{"ok"}},
+			{path: "/readyz", code: http.StatusServiceUnavailable, contains: []string{"not ready"}},
+			{path: "/", code: http.StatusOK, contains: []string{"No health check results."}},
+		})
+	})
+	t.Run("Passing", func(t *testing.T) {
+		dbErr = nil
+		checker.CheckNow(ctx)
+		check(t, []want{
+			{path: "/healthz", code: http.StatusOK, contains: []string{"ok"}},
+			{path: "/readyz", code: http.StatusOK, contains: []string{"database: ok", "ready"}},
+			{path: "/", code: http.StatusOK, contains: []string{
+				runtime.Version(),
+				"<th>database</th><td>ok</td>",
+				"<th>database.backend</th><td>&lt;postgres&gt;</td>",
+			}},
+		})
+	})
+	t.Run("Failing", func(t *testing.T) {
+		dbErr = errors.New("connection refused")
+		checker.CheckNow(ctx)
+		check(t, []want{
+			{path: "/healthz", code: http.StatusOK, contains: []string{"ok"}},
+			{path: "/readyz", code: http.StatusServiceUnavailable, contains: []string{"database: connection refused", "not ready"}},
+			{path: "/", code: http.StatusOK, contains: []string{"failing: connection refused"}},
+		})
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		check(t, []want{
+			{path: "/missing", code: http.StatusNotFound},
+		})
+	})
+}
@@ -0,0 +1,230 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/logging"
+	"github.com/Saser/strecku/internal/tokens"
+	"github.com/Saser/strecku/resources/stores"
+	"github.com/Saser/strecku/resources/stores/devices"
+	"github.com/Saser/strecku/resources/stores/devices/apikeys"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var errInvalidAPIKey = status.Error(codes.Unauthenticated, "invalid API key")
+
+// methodScopes maps the full names of the methods that devices can call to
+// the scopes that allow them. Devices cannot call any other methods.
+var methodScopes = map[string]string{
+	"/saser.strecku.v1.StreckU/GetStore":         devices.ScopeStoresRead,
+	"/saser.strecku.v1.StreckU/GetUser":          devices.ScopeUsersRead,
+	"/saser.strecku.v1.StreckU/ListUsers":        devices.ScopeUsersRead,
+	"/saser.strecku.v1.StreckU/GetMembership":    devices.ScopeMembershipsRead,
+	"/saser.strecku.v1.StreckU/ListMemberships":  devices.ScopeMembershipsRead,
+	"/saser.strecku.v1.StreckU/GetProduct":       devices.ScopeProductsRead,
+	"/saser.strecku.v1.StreckU/ListProducts":     devices.ScopeProductsRead,
+	"/saser.strecku.v1.StreckU/GetPricingRule":   devices.ScopeProductsRead,
+	"/saser.strecku.v1.StreckU/ListPricingRules": devices.ScopeProductsRead,
+	"/saser.strecku.v1.StreckU/GetPurchase":      devices.ScopePurchasesRead,
+	"/saser.strecku.v1.StreckU/ListPurchases":    devices.ScopePurchasesRead,
+	"/saser.strecku.v1.StreckU/WatchPurchases":   devices.ScopePurchasesRead,
+	"/saser.strecku.v1.StreckU/CreatePurchase":   devices.ScopePurchasesCreate,
+	"/saser.strecku.v1.StreckU/UndoPurchase":     devices.ScopePurchasesCreate,
+	"/saser.strecku.v1.StreckU/GetPayment":       devices.ScopePaymentsRead,
+	"/saser.strecku.v1.StreckU/ListPayments":     devices.ScopePaymentsRead,
+	"/saser.strecku.v1.StreckU/WatchPayments":    devices.ScopePaymentsRead,
+	"/saser.strecku.v1.StreckU/CreatePayment":    devices.ScopePaymentsCreate,
+	"/saser.strecku.v1.StreckU/IdentifyCard":     devices.ScopeCardsIdentify,
+}
+
+// APIKeys authenticates devices using the credentials of auth.Bearer, that
+// is, the "authorization" metadata key set to "Bearer <secret>", where
+// <secret> is the secret of an API key. Devices are only allowed to call the
+// methods allowed by their scopes, and only on resources in their own store.
+//
+// If Tokens is set, <secret> may instead be a tokens.KioskPurchase token
+// returned by IdentifyCard, which identifies both the device and the member
+// that identified themselves at it. Such callers can only create purchases
+// for the member.
+type APIKeys struct {
+	APIKeys *apikeys.Repository
+	Devices *devices.Repository
+	Tokens  *tokens.Store
+}
+
+// identify returns a copy of ctx carrying the identity of the device whose
+// API key the caller provided. Callers without Bearer credentials are passed
+// through unchanged, while callers with unknown API keys are rejected.
+func (k APIKeys) identify(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 || !strings.HasPrefix(values[0], "Bearer ") {
+		return ctx, nil
+	}
+	secret := strings.TrimPrefix(values[0], "Bearer ")
+	apiKey, err := k.APIKeys.Authenticate(ctx, secret)
+	if err != nil {
+		if errors.Is(err, apikeys.ErrUnauthenticated) {
+			return k.identifyMember(ctx, secret)
+		}
+		logging.FromContext(ctx).Errorf("authenticate API key: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	name, err := apikeys.Parent(apiKey.Name)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("parent of API key %q: %v", apiKey.Name, err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	device, err := k.Devices.LookupDevice(ctx, name)
+	if err != nil {
+		// The API keys of a device are deleted together with it, but a
+		// request may authenticate in between.
+		if notFound := new(devices.NotFoundError); errors.As(err, &notFound) {
+			return nil, errInvalidAPIKey
+		}
+		logging.FromContext(ctx).Errorf("look up device: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	logging.AddField(ctx, "principal", device.Name)
+	return NewContext(ctx, Identity{
+		Name:   device.Name,
+		Device: device.Name,
+		Scopes: device.Scopes,
+	}), nil
+}
+
+// identifyMember returns a copy of ctx carrying the identity of the member
+// that token was issued for by IdentifyCard, at the device that it was
+// issued to.
+func (k APIKeys) identifyMember(ctx context.Context, token string) (context.Context, error) {
+	if k.Tokens == nil {
+		return nil, errInvalidAPIKey
+	}
+	grant, err := k.Tokens.Lookup(tokens.KioskPurchase, token)
+	if err != nil {
+		return nil, errInvalidAPIKey
+	}
+	if _, err := k.Devices.LookupDevice(ctx, grant.Device); err != nil {
+		if notFound := new(devices.NotFoundError); errors.As(err, &notFound) {
+			return nil, errInvalidAPIKey
+		}
+		logging.FromContext(ctx).Errorf("look up device: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	logging.AddField(ctx, "principal", grant.Device)
+	return NewContext(ctx, Identity{
+		Name:   grant.Device,
+		User:   grant.User,
+		Device: grant.Device,
+		Scopes: []string{devices.ScopePurchasesCreate},
+	}), nil
+}
+
+// authorizeMethod fails if id is a device whose scopes do not allow it to
+// call method.
+func authorizeMethod(id Identity, method string) error {
+	if id.Device == "" {
+		return nil
+	}
+	scope, ok := methodScopes[method]
+	if !ok || !devices.HasScope(id.Scopes, scope) {
+		return status.Errorf(codes.PermissionDenied, "device %q is not allowed to call %s", id.Device, method)
+	}
+	return nil
+}
+
+// authorizeRequest fails if id is a device and req names a resource in
+// another store than the one of the device, using its parent, name, store or
+// purchase field. If id is also a member identified at the device, purchases can only
+// be made for that member.
+func authorizeRequest(id Identity, req interface{}) error {
+	if id.Device == "" {
+		return nil
+	}
+	if req, ok := req.(interface{ GetPurchase() *pb.Purchase }); ok && id.User != "" {
+		if user := req.GetPurchase().GetUser(); user != id.User {
+			return status.Errorf(codes.PermissionDenied, "device %q is not allowed to make purchases for %q", id.Device, user)
+		}
+	}
+	var name string
+	switch req := req.(type) {
+	case interface{ GetParent() string }:
+		name = req.GetParent()
+	case interface{ GetName() string }:
+		name = req.GetName()
+	case interface{ GetStore() string }:
+		name = req.GetStore()
+	case interface{ GetPurchase() string }:
+		name = req.GetPurchase()
+	}
+	if !strings.HasPrefix(name, stores.CollectionID+"/") {
+		return nil
+	}
+	store, err := devices.Parent(id.Device)
+	if err != nil {
+		return status.Errorf(codes.Internal, "invalid device name %q", id.Device)
+	}
+	if name != store && !strings.HasPrefix(name, store+"/") {
+		return status.Errorf(codes.PermissionDenied, "device %q is not allowed to access %q", id.Device, name)
+	}
+	return nil
+}
+
+// UnaryServerInterceptor returns an interceptor that attaches the identity of
+// the device whose API key the caller provided to the context, and rejects
+// requests that the device is not allowed to make.
+func (k APIKeys) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := k.identify(ctx)
+		if err != nil {
+			return nil, err
+		}
+		id, _ := FromContext(ctx)
+		if err := authorizeMethod(id, info.FullMethod); err != nil {
+			return nil, err
+		}
+		if err := authorizeRequest(id, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is like UnaryServerInterceptor, but for streaming
+// RPCs. The requests are checked as they are received.
+func (k APIKeys) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := k.identify(ss.Context())
+		if err != nil {
+			return err
+		}
+		id, _ := FromContext(ctx)
+		if err := authorizeMethod(id, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, &deviceStream{
+			ServerStream: &serverStream{ServerStream: ss, ctx: ctx},
+			id:           id,
+		})
+	}
+}
+
+// deviceStream is a grpc.ServerStream that checks the requests it receives
+// using authorizeRequest.
+type deviceStream struct {
+	grpc.ServerStream
+	id Identity
+}
+
+func (s *deviceStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return authorizeRequest(s.id, m)
+}
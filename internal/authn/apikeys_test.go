@@ -0,0 +1,428 @@
+package authn
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/tokens"
+	"github.com/Saser/strecku/resources/stores/devices"
+	"github.com/Saser/strecku/resources/stores/devices/apikeys"
+	"github.com/Saser/strecku/testresources"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// deviceServer is like identityServer, but also responds to a few methods
+// that devices may be allowed to call.
+type deviceServer struct {
+	identityServer
+}
+
+func (deviceServer) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.Product, error) {
+	return &pb.Product{Name: req.Name}, nil
+}
+
+func (deviceServer) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error) {
+	return req.Product, nil
+}
+
+func (deviceServer) CreatePurchase(ctx context.Context, req *pb.CreatePurchaseRequest) (*pb.Purchase, error) {
+	return req.Purchase, nil
+}
+
+func (deviceServer) WatchPurchases(req *pb.WatchPurchasesRequest, stream pb.StreckU_WatchPurchasesServer) error {
+	return stream.Send(&pb.WatchPurchasesResponse{Sequence: 1})
+}
+
+func serveAPIKeys(ctx context.Context, t *testing.T) (pb.StreckUClient, *tokens.Store) {
+	t.Helper()
+	tokenStore := tokens.NewStore()
+	keys := APIKeys{
+		APIKeys: apikeys.SeedRepository(t, map[string]*pb.ApiKey{
+			testresources.Bar_Kiosk_ApiKeySecret:  testresources.Bar_Kiosk_ApiKey,
+			testresources.Mall_Kiosk_ApiKeySecret: testresources.Mall_Kiosk_ApiKey,
+		}),
+		// Mall_Kiosk is missing, as if it was deleted.
+		Devices: devices.SeedRepository(t, []*pb.Device{testresources.Bar_Kiosk}),
+		Tokens:  tokenStore,
+	}
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(keys.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(keys.StreamServerInterceptor()),
+	)
+	pb.RegisterStreckUServer(srv, deviceServer{})
+	lis := bufconn.Listen(1024 * 1024)
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			t.Errorf("srv.Serve(%v) = %v; want nil", lis, err)
+		}
+	}()
+	t.Cleanup(srv.Stop)
+	dial := func(context.Context, string) (net.Conn, error) { return lis.Dial() }
+	cc, err := grpc.DialContext(ctx, "bufconn", grpc.WithInsecure(), grpc.WithContextDialer(dial))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := cc.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	return pb.NewStreckUClient(cc), tokenStore
+}
+
+// withBearer returns a copy of ctx that sends the metadata of auth.Bearer
+// with the given token.
+func withBearer(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+func TestAPIKeys_Identify(t *testing.T) {
+	ctx := context.Background()
+	client, _ := serveAPIKeys(ctx, t)
+	for _, test := range []struct {
+		desc     string
+		ctx      context.Context
+		wantName string
+		wantCode codes.Code
+	}{
+		{
+			desc:     "Anonymous",
+			ctx:      ctx,
+			wantName: "",
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "Basic",
+			ctx:      withBasic(ctx, t, testresources.Alice.EmailAddress, testresources.AlicePassword),
+			wantName: "",
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "OK",
+			ctx:      withBearer(ctx, testresources.Bar_Kiosk_ApiKeySecret),
+			wantName: testresources.Bar_Kiosk.Name,
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "UnknownKey",
+			ctx:      withBearer(ctx, "not a secret"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			desc:     "DeletedDevice",
+			ctx:      withBearer(ctx, testresources.Mall_Kiosk_ApiKeySecret),
+			wantCode: codes.Unauthenticated,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			user, err := client.GetUser(test.ctx, &pb.GetUserRequest{})
+			if got := status.Code(err); got != test.wantCode {
+				t.Fatalf("GetUser: code = %v; want %v (err = %v)", got, test.wantCode, err)
+			}
+			if err == nil && user.Name != test.wantName {
+				t.Errorf("identity name = %q; want %q", user.Name, test.wantName)
+			}
+		})
+	}
+}
+
+func TestAPIKeys_Authorize(t *testing.T) {
+	ctx := context.Background()
+	client, tokenStore := serveAPIKeys(ctx, t)
+	kiosk := withBearer(ctx, testresources.Bar_Kiosk_ApiKeySecret)
+	issue := func(g tokens.Grant) context.Context {
+		token, err := tokenStore.Issue(g, time.Minute)
+		if err != nil {
+			t.Fatalf("tokenStore.Issue(%+v, %v) = _, %v; want nil", g, time.Minute, err)
+		}
+		return withBearer(ctx, token)
+	}
+	member := issue(tokens.Grant{
+		Purpose:    tokens.KioskPurchase,
+		User:       testresources.Alice.Name,
+		Device:     testresources.Bar_Kiosk.Name,
+		Membership: testresources.Bar_Alice.Name,
+	})
+	memberAtDeletedDevice := issue(tokens.Grant{
+		Purpose:    tokens.KioskPurchase,
+		User:       testresources.Alice.Name,
+		Device:     testresources.Mall_Kiosk.Name,
+		Membership: testresources.Mall_Alice.Name,
+	})
+	passwordReset := issue(tokens.Grant{
+		Purpose: tokens.PasswordReset,
+		User:    testresources.Alice.Name,
+	})
+	for _, test := range []struct {
+		desc     string
+		ctx      context.Context
+		call     func(ctx context.Context) error
+		wantCode codes.Code
+	}{
+		{
+			desc: "InScope",
+			ctx:  kiosk,
+			call: func(ctx context.Context) error {
+				_, err := client.GetProduct(ctx, &pb.GetProductRequest{Name: testresources.Beer.Name})
+				return err
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "OtherStore",
+			ctx:  kiosk,
+			call: func(ctx context.Context) error {
+				_, err := client.GetProduct(ctx, &pb.GetProductRequest{Name: testresources.Pills.Name})
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc: "OutOfScope",
+			ctx:  kiosk,
+			call: func(ctx context.Context) error {
+				_, err := client.UpdateProduct(ctx, &pb.UpdateProductRequest{Product: testresources.Beer})
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc: "OutOfScope_Anonymous",
+			ctx:  ctx,
+			call: func(ctx context.Context) error {
+				_, err := client.UpdateProduct(ctx, &pb.UpdateProductRequest{Product: testresources.Beer})
+				return err
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "OutOfScope_Stream",
+			ctx:  kiosk,
+			call: func(ctx context.Context) error {
+				stream, err := client.WatchPurchases(ctx, &pb.WatchPurchasesRequest{Parent: testresources.Bar.Name})
+				if err != nil {
+					return err
+				}
+				_, err = stream.Recv()
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc: "Stream_Anonymous",
+			ctx:  ctx,
+			call: func(ctx context.Context) error {
+				stream, err := client.WatchPurchases(ctx, &pb.WatchPurchasesRequest{Parent: testresources.Bar.Name})
+				if err != nil {
+					return err
+				}
+				if _, err := stream.Recv(); err != nil {
+					return err
+				}
+				if _, err := stream.Recv(); err != io.EOF {
+					return err
+				}
+				return nil
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "Member_OwnPurchase",
+			ctx:  member,
+			call: func(ctx context.Context) error {
+				_, err := client.CreatePurchase(ctx, &pb.CreatePurchaseRequest{
+					Parent:   testresources.Bar.Name,
+					Purchase: &pb.Purchase{User: testresources.Alice.Name},
+				})
+				return err
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "Member_OtherUsersPurchase",
+			ctx:  member,
+			call: func(ctx context.Context) error {
+				_, err := client.CreatePurchase(ctx, &pb.CreatePurchaseRequest{
+					Parent:   testresources.Bar.Name,
+					Purchase: &pb.Purchase{User: testresources.Bob.Name},
+				})
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc: "Member_OtherMethod",
+			ctx:  member,
+			call: func(ctx context.Context) error {
+				_, err := client.GetProduct(ctx, &pb.GetProductRequest{Name: testresources.Beer.Name})
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc: "Member_DeletedDevice",
+			ctx:  memberAtDeletedDevice,
+			call: func(ctx context.Context) error {
+				_, err := client.CreatePurchase(ctx, &pb.CreatePurchaseRequest{
+					Parent:   testresources.Mall.Name,
+					Purchase: &pb.Purchase{User: testresources.Alice.Name},
+				})
+				return err
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			desc: "Member_OtherPurpose",
+			ctx:  passwordReset,
+			call: func(ctx context.Context) error {
+				_, err := client.CreatePurchase(ctx, &pb.CreatePurchaseRequest{
+					Parent:   testresources.Bar.Name,
+					Purchase: &pb.Purchase{User: testresources.Alice.Name},
+				})
+				return err
+			},
+			wantCode: codes.Unauthenticated,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.call(test.ctx)
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestAuthorizeRequest(t *testing.T) {
+	id := Identity{
+		Name:   testresources.Bar_Kiosk.Name,
+		Device: testresources.Bar_Kiosk.Name,
+		Scopes: testresources.Bar_Kiosk.Scopes,
+	}
+	member := Identity{
+		Name:   testresources.Bar_Kiosk.Name,
+		User:   testresources.Alice.Name,
+		Device: testresources.Bar_Kiosk.Name,
+		Scopes: []string{devices.ScopePurchasesCreate},
+	}
+	for _, test := range []struct {
+		desc     string
+		id       Identity
+		req      interface{}
+		wantCode codes.Code
+	}{
+		{
+			desc:     "OwnStore",
+			id:       id,
+			req:      &pb.GetStoreRequest{Name: testresources.Bar.Name},
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "OwnStoreParent",
+			id:       id,
+			req:      &pb.CreatePurchaseRequest{Parent: testresources.Bar.Name},
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "OtherStoreParent",
+			id:       id,
+			req:      &pb.CreatePurchaseRequest{Parent: testresources.Mall.Name},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "OtherStore",
+			id:       id,
+			req:      &pb.GetStoreRequest{Name: testresources.Mall.Name},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "OwnStoreField",
+			id:       id,
+			req:      &pb.IdentifyCardRequest{Store: testresources.Bar.Name},
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "OtherStoreField",
+			id:       id,
+			req:      &pb.IdentifyCardRequest{Store: testresources.Mall.Name},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "OwnStorePurchaseField",
+			id:       id,
+			req:      &pb.UndoPurchaseRequest{Purchase: testresources.Bar_Alice_Beer1.Name},
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "OtherStorePurchaseField",
+			id:       id,
+			req:      &pb.UndoPurchaseRequest{Purchase: testresources.Mall_Alice_Jeans1.Name},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "AnyUsersPurchase",
+			id:       id,
+			req:      &pb.CreatePurchaseRequest{Parent: testresources.Bar.Name, Purchase: &pb.Purchase{User: testresources.Bob.Name}},
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "Member_OwnPurchase",
+			id:       member,
+			req:      &pb.CreatePurchaseRequest{Parent: testresources.Bar.Name, Purchase: &pb.Purchase{User: testresources.Alice.Name}},
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "Member_OtherUsersPurchase",
+			id:       member,
+			req:      &pb.CreatePurchaseRequest{Parent: testresources.Bar.Name, Purchase: &pb.Purchase{User: testresources.Bob.Name}},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "Member_OtherStore",
+			id:       member,
+			req:      &pb.CreatePurchaseRequest{Parent: testresources.Mall.Name, Purchase: &pb.Purchase{User: testresources.Alice.Name}},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			// The store name is a prefix of the name, but the name is not
+			// of a resource in the store.
+			desc:     "StoreNamePrefix",
+			id:       id,
+			req:      &pb.GetStoreRequest{Name: testresources.Bar.Name + "0"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "User",
+			id:       id,
+			req:      &pb.GetUserRequest{Name: testresources.Alice.Name},
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "NoName",
+			id:       id,
+			req:      &pb.ListUsersRequest{},
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "NotDevice",
+			id:       Identity{Name: testresources.Alice.Name, User: testresources.Alice.Name},
+			req:      &pb.GetStoreRequest{Name: testresources.Mall.Name},
+			wantCode: codes.OK,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			err := authorizeRequest(test.id, test.req)
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package authn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Saser/strecku/internal/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Identity is the authenticated identity of the caller of an RPC.
+type Identity struct {
+	// Name identifies the caller, such as "kiosk-bar", "users/{user}" or
+	// "stores/{store}/devices/{device}".
+	Name string
+	// Subject is the subject of the client certificate that the caller was
+	// authenticated with, in RFC 2253 format.
+	Subject string
+	// User is the resource name of the user that the caller authenticated
+	// as using a password, if any.
+	User string
+	// Device is the resource name of the device that the caller
+	// authenticated as using an API key, if any.
+	Device string
+	// Scopes are the scopes granted to Device.
+	Scopes []string
+}
+
+type identityKey struct{}
+
+// NewContext returns a copy of ctx carrying the given identity.
+func NewContext(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the identity carried by ctx, if any.
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+	return id, ok
+}
+
+// CertificateIdentities maps subjects of client certificates, in RFC 2253
+// format such as "CN=kiosk-1,O=Bar", to identity names.
+type CertificateIdentities map[string]string
+
+// identify returns a copy of ctx carrying the identity of the client
+// certificate used by the caller. Callers without a verified client
+// certificate are passed through unchanged, while callers with a verified
+// client certificate whose subject is not known are rejected. The name of the
+// identity is added to the Logger carried by ctx, if any.
+func (c CertificateIdentities) identify(ctx context.Context) (context.Context, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return ctx, nil
+	}
+	subject := info.State.VerifiedChains[0][0].Subject.String()
+	name, ok := c[subject]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("unknown client certificate subject %q", subject))
+	}
+	logging.AddField(ctx, "principal", name)
+	return NewContext(ctx, Identity{Name: name, Subject: subject}), nil
+}
+
+// UnaryServerInterceptor returns an interceptor that attaches the identity of
+// the client certificate of the caller to the context.
+func (c CertificateIdentities) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return unaryInterceptor(c.identify)
+}
+
+// StreamServerInterceptor is like UnaryServerInterceptor, but for streaming
+// RPCs.
+func (c CertificateIdentities) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return streamInterceptor(c.identify)
+}
+
+// unaryInterceptor returns an interceptor that replaces the context of each
+// RPC with the one returned by identify, or fails the RPC if identify returns
+// an error.
+func unaryInterceptor(identify func(context.Context) (context.Context, error)) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := identify(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamInterceptor is like unaryInterceptor, but for streaming RPCs.
+func streamInterceptor(identify func(context.Context) (context.Context, error)) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := identify(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// serverStream is a grpc.ServerStream with a different context.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
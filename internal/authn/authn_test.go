@@ -0,0 +1,148 @@
+package authn
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/certs"
+	"github.com/Saser/strecku/internal/certs/certstest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// identityServer responds to GetUser with a user whose name is the name of
+// the identity of the caller, or the empty string if there is none.
+type identityServer struct {
+	pb.UnimplementedStreckUServer
+}
+
+func (identityServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	id, _ := FromContext(ctx)
+	return &pb.User{Name: id.Name}, nil
+}
+
+// serve starts a server that verifies client certificates issued by ca, if
+// given, and returns its address.
+func serve(t *testing.T, serverCA *certstest.CA, clientCA *certstest.CA, identities CertificateIdentities) string {
+	t.Helper()
+	dir := t.TempDir()
+	certPEM, keyPEM := serverCA.IssueServer(t, "server")
+	certFile := certstest.WriteFile(t, dir, "cert.pem", certPEM)
+	keyFile := certstest.WriteFile(t, dir, "key.pem", keyPEM)
+	caFile := certstest.WriteFile(t, dir, "ca.pem", clientCA.CertPEM)
+	reloader, err := certs.NewReloader(certFile, keyFile, caFile, tls.VerifyClientCertIfGiven)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(reloader.TLSConfig())),
+		grpc.UnaryInterceptor(identities.UnaryServerInterceptor()),
+	)
+	pb.RegisterStreckUServer(srv, identityServer{})
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			t.Error(err)
+		}
+	}()
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+func dial(ctx context.Context, t *testing.T, addr string, serverCA *certstest.CA, clientCert []tls.Certificate) pb.StreckUClient {
+	t.Helper()
+	roots := x509.NewCertPool()
+	roots.AddCert(serverCA.Cert)
+	creds := credentials.NewTLS(&tls.Config{
+		RootCAs:      roots,
+		Certificates: clientCert,
+		ServerName:   "localhost",
+	})
+	cc, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := cc.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	return pb.NewStreckUClient(cc)
+}
+
+func clientCert(t *testing.T, ca *certstest.CA, subject pkix.Name) []tls.Certificate {
+	t.Helper()
+	certPEM, keyPEM := ca.IssueClient(t, subject)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return []tls.Certificate{cert}
+}
+
+func TestCertificateIdentities(t *testing.T) {
+	ctx := context.Background()
+	serverCA := certstest.NewCA(t, "Server CA")
+	clientCA := certstest.NewCA(t, "Client CA")
+	otherCA := certstest.NewCA(t, "Other CA")
+	addr := serve(t, serverCA, clientCA, CertificateIdentities{
+		"CN=kiosk-1,O=Bar": "kiosk-bar",
+	})
+	for _, test := range []struct {
+		desc     string
+		certs    []tls.Certificate
+		wantName string
+		wantCode codes.Code
+	}{
+		{
+			desc:     "NoClientCertificate",
+			certs:    nil,
+			wantName: "",
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "KnownSubject",
+			certs:    clientCert(t, clientCA, pkix.Name{CommonName: "kiosk-1", Organization: []string{"Bar"}}),
+			wantName: "kiosk-bar",
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "UnknownSubject",
+			certs:    clientCert(t, clientCA, pkix.Name{CommonName: "kiosk-2", Organization: []string{"Bar"}}),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			// The client does not present a certificate whose issuer is not
+			// accepted by the server, so the caller has no identity.
+			desc:     "UntrustedIssuer",
+			certs:    clientCert(t, otherCA, pkix.Name{CommonName: "kiosk-1", Organization: []string{"Bar"}}),
+			wantName: "",
+			wantCode: codes.OK,
+		},
+	} {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			client := dial(ctx, t, addr, serverCA, test.certs)
+			user, err := client.GetUser(ctx, &pb.GetUserRequest{Name: "users/irrelevant"})
+			if got := status.Code(err); got != test.wantCode {
+				t.Fatalf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			if got := user.Name; got != test.wantName {
+				t.Errorf("identity name = %q; want %q", got, test.wantName)
+			}
+		})
+	}
+}
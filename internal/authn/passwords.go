@@ -0,0 +1,84 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/Saser/strecku/auth"
+	"github.com/Saser/strecku/internal/lockout"
+	"github.com/Saser/strecku/internal/logging"
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/resources/users"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// errInvalidCredentials does not tell whether the user or the password was
+// wrong, so that callers cannot find out which users exist.
+var errInvalidCredentials = status.Error(codes.Unauthenticated, "invalid username or password")
+
+// Passwords authenticates callers using the credentials of auth.Basic, that
+// is, the "authorization" metadata key set to "Basic <credentials>". The
+// username is either the email address or the resource name of a user, and
+// the password is checked using Users.Authenticate. To protect against
+// password guessing, Users should be guarded by a lockout.Guard.
+type Passwords struct {
+	Users repositories.Users
+}
+
+// identify returns a copy of ctx carrying the identity of the user whose
+// credentials the caller provided. Callers without Basic credentials are
+// passed through unchanged, while callers with invalid credentials are
+// rejected.
+func (p Passwords) identify(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 || !strings.HasPrefix(values[0], "Basic ") {
+		return ctx, nil
+	}
+	creds, err := auth.ParseBasic(values[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "malformed Basic credentials")
+	}
+	name := creds.Username
+	if !strings.HasPrefix(name, users.CollectionID+"/") {
+		name, err = p.Users.ResolveEmail(ctx, creds.Username)
+		if err != nil {
+			if notFound := new(repositories.EmailAddressNotFound); errors.As(err, &notFound) {
+				return nil, errInvalidCredentials
+			}
+			logging.FromContext(ctx).Errorf("resolve email address for authentication: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+	if err := p.Users.Authenticate(ctx, name, creds.Password); err != nil {
+		if blocked := new(lockout.Blocked); errors.As(err, &blocked) {
+			return nil, status.Error(codes.ResourceExhausted, blocked.Error())
+		}
+		if errors.Is(err, repositories.ErrUnauthenticated) ||
+			errors.Is(err, resourcename.ErrInvalidName) ||
+			errors.As(err, new(*repositories.NotFound)) {
+			return nil, errInvalidCredentials
+		}
+		logging.FromContext(ctx).Errorf("authenticate: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	logging.AddField(ctx, "principal", name)
+	return NewContext(ctx, Identity{Name: name, User: name}), nil
+}
+
+// UnaryServerInterceptor returns an interceptor that attaches the identity of
+// the user whose credentials the caller provided to the context.
+func (p Passwords) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return unaryInterceptor(p.identify)
+}
+
+// StreamServerInterceptor is like UnaryServerInterceptor, but for streaming
+// RPCs.
+func (p Passwords) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return streamInterceptor(p.identify)
+}
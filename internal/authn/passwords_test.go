@@ -0,0 +1,136 @@
+package authn
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/auth"
+	"github.com/Saser/strecku/internal/lockout"
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/testresources"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func servePasswords(ctx context.Context, t *testing.T, guard *lockout.Guard) pb.StreckUClient {
+	t.Helper()
+	userRepo := repositories.NewInMemoryUsers()
+	repositories.SeedUsers(ctx, t, userRepo, []*pb.User{testresources.Alice}, []string{testresources.AlicePassword})
+	passwords := Passwords{Users: guard.Users(userRepo)}
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(passwords.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(passwords.StreamServerInterceptor()),
+	)
+	pb.RegisterStreckUServer(srv, identityServer{})
+	lis := bufconn.Listen(1024 * 1024)
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			t.Errorf("srv.Serve(%v) = %v; want nil", lis, err)
+		}
+	}()
+	t.Cleanup(srv.GracefulStop)
+	dial := func(context.Context, string) (net.Conn, error) { return lis.Dial() }
+	cc, err := grpc.DialContext(ctx, "bufconn", grpc.WithInsecure(), grpc.WithContextDialer(dial))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := cc.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	return pb.NewStreckUClient(cc)
+}
+
+// withBasic returns a copy of ctx that sends the metadata of the given Basic
+// credentials. auth.Basic is not used as call credentials, since it requires
+// transport security.
+func withBasic(ctx context.Context, t *testing.T, username, password string) context.Context {
+	t.Helper()
+	md, err := auth.Basic{Username: username, Password: password}.GetRequestMetadata(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", md["authorization"])
+}
+
+func TestPasswords(t *testing.T) {
+	ctx := context.Background()
+	client := servePasswords(ctx, t, lockout.NewGuard())
+	for _, test := range []struct {
+		desc     string
+		ctx      context.Context
+		wantName string
+		wantCode codes.Code
+	}{
+		{
+			desc:     "Anonymous",
+			ctx:      ctx,
+			wantName: "",
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "Bearer",
+			ctx:      metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer token"),
+			wantName: "",
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "EmailAddress",
+			ctx:      withBasic(ctx, t, testresources.Alice.EmailAddress, testresources.AlicePassword),
+			wantName: testresources.Alice.Name,
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "ResourceName",
+			ctx:      withBasic(ctx, t, testresources.Alice.Name, testresources.AlicePassword),
+			wantName: testresources.Alice.Name,
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "UnknownEmailAddress",
+			ctx:      withBasic(ctx, t, testresources.Bob.EmailAddress, testresources.BobPassword),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			desc:     "UnknownUser",
+			ctx:      withBasic(ctx, t, testresources.Bob.Name, testresources.BobPassword),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			desc:     "Malformed",
+			ctx:      metadata.AppendToOutgoingContext(ctx, "authorization", "Basic !!!"),
+			wantCode: codes.Unauthenticated,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			user, err := client.GetUser(test.ctx, &pb.GetUserRequest{})
+			if got := status.Code(err); got != test.wantCode {
+				t.Fatalf("GetUser: code = %v; want %v (err = %v)", got, test.wantCode, err)
+			}
+			if err == nil && user.Name != test.wantName {
+				t.Errorf("identity name = %q; want %q", user.Name, test.wantName)
+			}
+		})
+	}
+}
+
+func TestPasswords_Lockout(t *testing.T) {
+	ctx := context.Background()
+	guard := lockout.NewGuard()
+	client := servePasswords(ctx, t, guard)
+	wrong := withBasic(ctx, t, testresources.Alice.EmailAddress, "wrong password")
+	if _, err := client.GetUser(wrong, &pb.GetUserRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("GetUser with wrong password: err = %v; want code %v", err, codes.Unauthenticated)
+	}
+	// The next attempt is made during the delay after the failure.
+	right := withBasic(ctx, t, testresources.Alice.EmailAddress, testresources.AlicePassword)
+	if _, err := client.GetUser(right, &pb.GetUserRequest{}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("GetUser during delay: err = %v; want code %v", err, codes.ResourceExhausted)
+	}
+}
@@ -0,0 +1,97 @@
+package bankstatements
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown bank statement format")
+	ErrAmountInvalid = errors.New("amount is not a decimal number with at most two fractional digits")
+)
+
+// Transaction is a single transaction on a bank account, as read from a bank
+// statement.
+type Transaction struct {
+	// ID is the reference assigned to the transaction by the bank. It may be
+	// empty if the bank statement does not contain one.
+	ID          string
+	BookingDate time.Time
+	// AmountCents is positive for incoming transfers and negative for
+	// outgoing transfers.
+	AmountCents int64
+	Currency    string
+	// Reference is the structured reference, such as an OCR number, given by
+	// the payer. It may be empty.
+	Reference string
+	// Message is the unstructured message given by the payer, if any.
+	Message string
+	Payer   string
+}
+
+// Description returns a description of the transaction suitable for use as the
+// description of a payment. Transactions with the same non-empty ID have the
+// same description.
+func (t Transaction) Description() string {
+	if t.ID == "" {
+		return fmt.Sprintf("Bank transfer on %s", t.BookingDate.Format("2006-01-02"))
+	}
+	return fmt.Sprintf("Bank transfer on %s (%s)", t.BookingDate.Format("2006-01-02"), t.ID)
+}
+
+// Parse reads a bank statement in either ISO 20022 camt.053 or Bankgirot
+// BgMax format from r, detecting the format from the contents.
+func Parse(r io.Reader) ([]Transaction, error) {
+	br := bufio.NewReader(r)
+	head, err := br.Peek(512)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	switch {
+	case bytes.HasPrefix(head, []byte("01BGMAX")):
+		return ParseBgMax(br)
+	case bytes.Contains(head, []byte("camt.053")):
+		return ParseCamt053(br)
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+// parseAmount parses a decimal amount such as "123.45" into cents.
+func parseAmount(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+	parts := strings.SplitN(s, ".", 2)
+	whole, frac := parts[0], ""
+	if len(parts) == 2 {
+		frac = parts[1]
+	}
+	if whole == "" || len(frac) > 2 || (len(parts) == 2 && frac == "") {
+		return 0, ErrAmountInvalid
+	}
+	for len(frac) < 2 {
+		frac += "0"
+	}
+	for _, r := range whole + frac {
+		if r < '0' || r > '9' {
+			return 0, ErrAmountInvalid
+		}
+	}
+	cents, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, ErrAmountInvalid
+	}
+	if neg {
+		cents = -cents
+	}
+	return cents, nil
+}
@@ -0,0 +1,76 @@
+package bankstatements
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParse(t *testing.T) {
+	for _, test := range []struct {
+		desc    string
+		file    string
+		wantLen int
+		wantErr error
+	}{
+		{desc: "BgMax", file: bgmaxFile, wantLen: 3},
+		{desc: "Camt053", file: camt053File, wantLen: 4},
+		{desc: "Unknown", file: "Date,Amount\n2021-01-14,150.00\n", wantErr: ErrUnknownFormat},
+		{desc: "Empty", file: "", wantErr: ErrUnknownFormat},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(test.file))
+			if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+				t.Fatalf("Parse(...) err = %v; want %v", err, test.wantErr)
+			}
+			if len(got) != test.wantLen {
+				t.Errorf("len(Parse(...)) = %v; want %v", len(got), test.wantLen)
+			}
+		})
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	for _, test := range []struct {
+		s       string
+		want    int64
+		wantErr error
+	}{
+		{s: "150", want: 15000},
+		{s: "150.5", want: 15050},
+		{s: "150.05", want: 15005},
+		{s: "-1.00", want: -100},
+		{s: " 1.00 ", want: 100},
+		{s: "", wantErr: ErrAmountInvalid},
+		{s: "1,00", wantErr: ErrAmountInvalid},
+		{s: "1.000", wantErr: ErrAmountInvalid},
+		{s: "1.", wantErr: ErrAmountInvalid},
+	} {
+		got, err := parseAmount(test.s)
+		if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+			t.Errorf("parseAmount(%q) err = %v; want %v", test.s, err, test.wantErr)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("parseAmount(%q) = %v; want %v", test.s, got, test.want)
+		}
+	}
+}
+
+func TestTransaction_Description(t *testing.T) {
+	date := time.Date(2021, time.January, 14, 0, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		tx   Transaction
+		want string
+	}{
+		{tx: Transaction{ID: "REF1", BookingDate: date}, want: "Bank transfer on 2021-01-14 (REF1)"},
+		{tx: Transaction{BookingDate: date}, want: "Bank transfer on 2021-01-14"},
+	} {
+		if got := test.tx.Description(); got != test.want {
+			t.Errorf("%+v.Description() = %q; want %q", test.tx, got, test.want)
+		}
+	}
+}
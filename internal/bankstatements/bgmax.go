@@ -0,0 +1,119 @@
+package bankstatements
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// bgmaxRecordLength is the length of a record in a BgMax file. Trailing spaces
+// are sometimes stripped, so shorter lines are padded to this length.
+const bgmaxRecordLength = 80
+
+// bgmaxField returns the field at the given 1-based, inclusive positions of a
+// record, converted to UTF-8 and with surrounding spaces removed.
+func bgmaxField(record string, from, to int) string {
+	return strings.TrimSpace(latin1ToUTF8(record[from-1 : to]))
+}
+
+// latin1ToUTF8 converts a string encoded in ISO 8859-1, which is the encoding
+// used by BgMax files, to UTF-8.
+func latin1ToUTF8(s string) string {
+	runes := make([]rune, len(s))
+	for i := 0; i < len(s); i++ {
+		runes[i] = rune(s[i])
+	}
+	return string(runes)
+}
+
+// ParseBgMax reads the incoming payments in a Bankgirot BgMax file from r.
+// Deductions are returned as transactions with negative amounts.
+func ParseBgMax(r io.Reader) ([]Transaction, error) {
+	var (
+		txs      []Transaction
+		currency string
+		// pending contains the indices in txs of the payments in the
+		// current deposit, which get their booking date from the deposit
+		// record that ends the section.
+		pending []int
+	)
+	current := func() *Transaction {
+		if len(pending) == 0 {
+			return nil
+		}
+		return &txs[pending[len(pending)-1]]
+	}
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		record := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(record) == "" {
+			continue
+		}
+		if len(record) < bgmaxRecordLength {
+			record += strings.Repeat(" ", bgmaxRecordLength-len(record))
+		}
+		switch code := record[:2]; code {
+		case "01":
+			if layout := bgmaxField(record, 3, 22); layout != "BGMAX" {
+				return nil, fmt.Errorf("record %d: unknown layout %q", n, layout)
+			}
+		case "05":
+			currency = bgmaxField(record, 23, 25)
+		case "20", "21":
+			amount, err := strconv.ParseInt(bgmaxField(record, 38, 55), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("record %d: amount: %w", n, err)
+			}
+			if code == "21" {
+				amount = -amount
+			}
+			txs = append(txs, Transaction{
+				ID:          bgmaxField(record, 58, 69),
+				AmountCents: amount,
+				Currency:    currency,
+				Reference:   bgmaxField(record, 13, 37),
+			})
+			pending = append(pending, len(txs)-1)
+		case "25":
+			tx := current()
+			if tx == nil {
+				return nil, fmt.Errorf("record %d: information record without payment", n)
+			}
+			tx.Message = strings.TrimSpace(tx.Message + " " + bgmaxField(record, 3, 52))
+		case "26":
+			tx := current()
+			if tx == nil {
+				return nil, fmt.Errorf("record %d: name record without payment", n)
+			}
+			tx.Payer = strings.TrimSpace(bgmaxField(record, 3, 37) + " " + bgmaxField(record, 38, 72))
+		case "15":
+			date, err := time.Parse("20060102", bgmaxField(record, 38, 45))
+			if err != nil {
+				return nil, fmt.Errorf("record %d: payment date: %w", n, err)
+			}
+			if c := bgmaxField(record, 69, 71); c != "" {
+				currency = c
+			}
+			for _, i := range pending {
+				txs[i].BookingDate = date
+				txs[i].Currency = currency
+			}
+			pending = nil
+		case "22", "23", "27", "28", "29", "70":
+			// Extra references, addresses, organisation numbers and the end
+			// record are not needed.
+		default:
+			return nil, fmt.Errorf("record %d: unknown transaction code %q", n, code)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(pending) > 0 {
+		return nil, fmt.Errorf("%d payments without deposit record", len(pending))
+	}
+	return txs, nil
+}
@@ -0,0 +1,101 @@
+package bankstatements
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// bgmaxFile is a BgMax file with two payments and one deduction in a single
+// deposit. Trailing spaces have been stripped from the records, and the name
+// in the second payment is encoded in ISO 8859-1.
+var bgmaxFile = strings.Join([]string{
+	"01BGMAX               0120210115073512345678P",
+	"050009912346          SEK",
+	"2000000000003799849742854556         000000000000015000210000000000010",
+	"26ALICE ANDERSSON",
+	"205555555555                         000000000000002500110000000000020",
+	"25Betalning 9709291958694440",
+	"26B\xd6RJE BENGTSSON",
+	"2100000000001234                     000000000000001000210000000000030",
+	"15000000000000000000000000000000123452021011400001000000000000016500SEK00000003K",
+	"7000000002000000010000000000000001",
+	"",
+}, "\r\n")
+
+func TestParseBgMax(t *testing.T) {
+	date := time.Date(2021, time.January, 14, 0, 0, 0, 0, time.UTC)
+	want := []Transaction{
+		{
+			ID:          "000000000001",
+			BookingDate: date,
+			AmountCents: 15000,
+			Currency:    "SEK",
+			Reference:   "3799849742854556",
+			Payer:       "ALICE ANDERSSON",
+		},
+		{
+			ID:          "000000000002",
+			BookingDate: date,
+			AmountCents: 2500,
+			Currency:    "SEK",
+			Message:     "Betalning 9709291958694440",
+			Payer:       "BÖRJE BENGTSSON",
+		},
+		{
+			ID:          "000000000003",
+			BookingDate: date,
+			AmountCents: -1000,
+			Currency:    "SEK",
+			Reference:   "1234",
+		},
+	}
+	got, err := ParseBgMax(strings.NewReader(bgmaxFile))
+	if err != nil {
+		t.Fatalf("ParseBgMax(...) err = %v; want nil", err)
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("ParseBgMax(...) != want (-got +want)\n%s", diff)
+	}
+}
+
+func TestParseBgMax_Errors(t *testing.T) {
+	for _, test := range []struct {
+		desc string
+		file string
+	}{
+		{
+			desc: "UnknownLayout",
+			file: "01BGMIN               0120210115073512345678P\n",
+		},
+		{
+			desc: "UnknownTransactionCode",
+			file: "01BGMAX               0120210115073512345678P\n99\n",
+		},
+		{
+			desc: "InvalidAmount",
+			file: "2000000000003799849742854556         0000000000000150x0210000000000010\n",
+		},
+		{
+			desc: "NameWithoutPayment",
+			file: "26ALICE ANDERSSON\n",
+		},
+		{
+			desc: "MissingDepositRecord",
+			file: "2000000000003799849742854556         000000000000015000210000000000010\n",
+		},
+		{
+			desc: "InvalidPaymentDate",
+			file: "2000000000003799849742854556         000000000000015000210000000000010\n" +
+				"150000000000000000000000000000001234520211314\n",
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			if _, err := ParseBgMax(strings.NewReader(test.file)); err == nil {
+				t.Error("ParseBgMax(...) err = nil; want non-nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package bankstatements
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// The structs below contain the subset of ISO 20022 camt.053 needed to read
+// transactions. Element names are matched regardless of namespace, so that all
+// versions of camt.053 can be read.
+
+type camtDocument struct {
+	Statements []camtStatement `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtStatement struct {
+	Entries []camtEntry `xml:"Ntry"`
+}
+
+type camtAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+type camtStatus struct {
+	Value string `xml:",chardata"` // before camt.053.001.08
+	Code  string `xml:"Cd"`        // camt.053.001.08 and later
+}
+
+type camtEntry struct {
+	Amount               camtAmount    `xml:"Amt"`
+	CreditDebitIndicator string        `xml:"CdtDbtInd"`
+	Status               camtStatus    `xml:"Sts"`
+	BookingDate          camtDate      `xml:"BookgDt"`
+	AccountServicerRef   string        `xml:"AcctSvcrRef"`
+	Details              []camtDetails `xml:"NtryDtls>TxDtls"`
+}
+
+type camtParty struct {
+	Name      string `xml:"Nm"`
+	PartyName string `xml:"Pty>Nm"`
+}
+
+type camtDetails struct {
+	AccountServicerRef string      `xml:"Refs>AcctSvcrRef"`
+	Amount             *camtAmount `xml:"Amt"`
+	TxAmount           *camtAmount `xml:"AmtDtls>TxAmt>Amt"`
+	Debtor             camtParty   `xml:"RltdPties>Dbtr"`
+	Unstructured       []string    `xml:"RmtInf>Ustrd"`
+	References         []string    `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+}
+
+func (d camtDate) parse() (time.Time, error) {
+	if d.Date != "" {
+		return time.Parse("2006-01-02", strings.TrimSpace(d.Date))
+	}
+	if d.DateTime != "" {
+		s := strings.TrimSpace(d.DateTime)
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return t, nil
+		}
+		return time.Parse("2006-01-02T15:04:05", s)
+	}
+	return time.Time{}, nil
+}
+
+func (a camtAmount) parse(credit bool) (int64, error) {
+	cents, err := parseAmount(a.Value)
+	if err != nil {
+		return 0, err
+	}
+	if !credit {
+		cents = -cents
+	}
+	return cents, nil
+}
+
+// ParseCamt053 reads the booked transactions in an ISO 20022 camt.053 bank to
+// customer statement from r. Entries with several transaction details, such
+// as batch bookings, result in one transaction per details element.
+func ParseCamt053(r io.Reader) ([]Transaction, error) {
+	var doc camtDocument
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	var txs []Transaction
+	for _, stmt := range doc.Statements {
+		for i, entry := range stmt.Entries {
+			status := strings.TrimSpace(entry.Status.Value + entry.Status.Code)
+			if status != "" && status != "BOOK" {
+				continue
+			}
+			var credit bool
+			switch ind := strings.TrimSpace(entry.CreditDebitIndicator); ind {
+			case "CRDT":
+				credit = true
+			case "DBIT":
+				credit = false
+			default:
+				return nil, fmt.Errorf("entry %d: invalid credit/debit indicator %q", i+1, ind)
+			}
+			date, err := entry.BookingDate.parse()
+			if err != nil {
+				return nil, fmt.Errorf("entry %d: booking date: %w", i+1, err)
+			}
+			details := entry.Details
+			if len(details) == 0 {
+				details = []camtDetails{{}}
+			}
+			for _, d := range details {
+				tx := Transaction{
+					ID:          strings.TrimSpace(entry.AccountServicerRef),
+					BookingDate: date,
+					Currency:    entry.Amount.Currency,
+					Message:     strings.TrimSpace(strings.Join(d.Unstructured, " ")),
+					Payer:       strings.TrimSpace(d.Debtor.Name + d.Debtor.PartyName),
+				}
+				if ref := strings.TrimSpace(d.AccountServicerRef); ref != "" {
+					tx.ID = ref
+				}
+				if len(d.References) > 0 {
+					tx.Reference = strings.TrimSpace(d.References[0])
+				}
+				amount := &entry.Amount
+				switch {
+				case d.Amount != nil:
+					amount = d.Amount
+				case d.TxAmount != nil:
+					amount = d.TxAmount
+				case len(details) > 1:
+					return nil, fmt.Errorf("entry %d: transaction details without amount in batch", i+1)
+				}
+				if amount.Currency != "" {
+					tx.Currency = amount.Currency
+				}
+				tx.AmountCents, err = amount.parse(credit)
+				if err != nil {
+					return nil, fmt.Errorf("entry %d: %w", i+1, err)
+				}
+				txs = append(txs, tx)
+			}
+		}
+	}
+	return txs, nil
+}
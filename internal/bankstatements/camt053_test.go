@@ -0,0 +1,155 @@
+package bankstatements
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// camt053File is a camt.053.001.02 statement with a single payment, a batch
+// booking of two payments, an outgoing transfer and a pending entry.
+const camt053File = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+  <BkToCstmrStmt>
+    <GrpHdr><MsgId>MSG1</MsgId></GrpHdr>
+    <Stmt>
+      <Id>STMT1</Id>
+      <Ntry>
+        <Amt Ccy="SEK">150.00</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><Dt>2021-01-14</Dt></BookgDt>
+        <AcctSvcrRef>REF1</AcctSvcrRef>
+        <NtryDtls>
+          <TxDtls>
+            <RltdPties><Dbtr><Nm>Alice Andersson</Nm></Dbtr></RltdPties>
+            <RmtInf>
+              <Strd><CdtrRefInf><Ref>3799849742854556</Ref></CdtrRefInf></Strd>
+            </RmtInf>
+          </TxDtls>
+        </NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="SEK">70.5</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><DtTm>2021-01-15T09:30:00+01:00</DtTm></BookgDt>
+        <AcctSvcrRef>BATCH1</AcctSvcrRef>
+        <NtryDtls>
+          <TxDtls>
+            <Refs><AcctSvcrRef>BATCH1-1</AcctSvcrRef></Refs>
+            <AmtDtls><TxAmt><Amt Ccy="SEK">50.50</Amt></TxAmt></AmtDtls>
+            <RmtInf><Ustrd>Strecklista</Ustrd><Ustrd>9709291958694440</Ustrd></RmtInf>
+          </TxDtls>
+          <TxDtls>
+            <Refs><AcctSvcrRef>BATCH1-2</AcctSvcrRef></Refs>
+            <AmtDtls><TxAmt><Amt Ccy="SEK">20.00</Amt></TxAmt></AmtDtls>
+          </TxDtls>
+        </NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="SEK">99.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><Dt>2021-01-16</Dt></BookgDt>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="SEK">10.00</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts>PDNG</Sts>
+        <BookgDt><Dt>2021-01-17</Dt></BookgDt>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>
+`
+
+func TestParseCamt053(t *testing.T) {
+	want := []Transaction{
+		{
+			ID:          "REF1",
+			BookingDate: time.Date(2021, time.January, 14, 0, 0, 0, 0, time.UTC),
+			AmountCents: 15000,
+			Currency:    "SEK",
+			Reference:   "3799849742854556",
+			Payer:       "Alice Andersson",
+		},
+		{
+			ID:          "BATCH1-1",
+			BookingDate: time.Date(2021, time.January, 15, 9, 30, 0, 0, time.FixedZone("", 60*60)),
+			AmountCents: 5050,
+			Currency:    "SEK",
+			Message:     "Strecklista 9709291958694440",
+		},
+		{
+			ID:          "BATCH1-2",
+			BookingDate: time.Date(2021, time.January, 15, 9, 30, 0, 0, time.FixedZone("", 60*60)),
+			AmountCents: 2000,
+			Currency:    "SEK",
+		},
+		{
+			BookingDate: time.Date(2021, time.January, 16, 0, 0, 0, 0, time.UTC),
+			AmountCents: -9900,
+			Currency:    "SEK",
+		},
+	}
+	got, err := ParseCamt053(strings.NewReader(camt053File))
+	if err != nil {
+		t.Fatalf("ParseCamt053(...) err = %v; want nil", err)
+	}
+	equateTimes := cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })
+	if diff := cmp.Diff(got, want, equateTimes); diff != "" {
+		t.Errorf("ParseCamt053(...) != want (-got +want)\n%s", diff)
+	}
+}
+
+func TestParseCamt053_Status(t *testing.T) {
+	// Since camt.053.001.08, the status is a code in a separate element.
+	file := `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08"><BkToCstmrStmt><Stmt>
+<Ntry><Amt Ccy="SEK">1.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts><BookgDt><Dt>2021-01-14</Dt></BookgDt></Ntry>
+<Ntry><Amt Ccy="SEK">2.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts><Cd>PDNG</Cd></Sts><BookgDt><Dt>2021-01-14</Dt></BookgDt></Ntry>
+</Stmt></BkToCstmrStmt></Document>`
+	got, err := ParseCamt053(strings.NewReader(file))
+	if err != nil {
+		t.Fatalf("ParseCamt053(...) err = %v; want nil", err)
+	}
+	if len(got) != 1 || got[0].AmountCents != 100 {
+		t.Errorf("ParseCamt053(...) = %v; want a single transaction of 100 cents", got)
+	}
+}
+
+func TestParseCamt053_Errors(t *testing.T) {
+	for _, test := range []struct {
+		desc string
+		file string
+	}{
+		{
+			desc: "InvalidXML",
+			file: "<Document><BkToCstmrStmt>",
+		},
+		{
+			desc: "InvalidIndicator",
+			file: `<Document><BkToCstmrStmt><Stmt><Ntry><Amt Ccy="SEK">1.00</Amt><CdtDbtInd>X</CdtDbtInd></Ntry></Stmt></BkToCstmrStmt></Document>`,
+		},
+		{
+			desc: "InvalidAmount",
+			file: `<Document><BkToCstmrStmt><Stmt><Ntry><Amt Ccy="SEK">1,00</Amt><CdtDbtInd>CRDT</CdtDbtInd></Ntry></Stmt></BkToCstmrStmt></Document>`,
+		},
+		{
+			desc: "InvalidDate",
+			file: `<Document><BkToCstmrStmt><Stmt><Ntry><Amt Ccy="SEK">1.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><BookgDt><Dt>14/01/2021</Dt></BookgDt></Ntry></Stmt></BkToCstmrStmt></Document>`,
+		},
+		{
+			desc: "BatchWithoutAmounts",
+			file: `<Document><BkToCstmrStmt><Stmt><Ntry><Amt Ccy="SEK">1.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><NtryDtls><TxDtls/><TxDtls/></NtryDtls></Ntry></Stmt></BkToCstmrStmt></Document>`,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			if _, err := ParseCamt053(strings.NewReader(test.file)); err == nil {
+				t.Error("ParseCamt053(...) err = nil; want non-nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package bankstatements
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/ocr"
+)
+
+// Currency is the currency of the payments that transactions are matched to.
+// Transactions in other currencies are left unmatched, since they would need
+// to be converted.
+const Currency = "SEK"
+
+var (
+	ErrNotIncoming      = errors.New("transaction is not an incoming transfer")
+	ErrNoReference      = errors.New("transaction has no payment reference")
+	ErrUnknownReference = errors.New("payment reference does not belong to any membership")
+)
+
+// CurrencyError is returned when a transaction is not in Currency.
+type CurrencyError struct {
+	Currency string
+}
+
+func (e *CurrencyError) Error() string {
+	return fmt.Sprintf("transaction is in %q, not %s", e.Currency, Currency)
+}
+
+func (e *CurrencyError) Is(target error) bool {
+	other, ok := target.(*CurrencyError)
+	return ok && e.Currency == other.Currency
+}
+
+// InvalidReferenceError is returned when the reference of a transaction is not
+// a valid OCR number.
+type InvalidReferenceError struct {
+	Reference string
+	Err       error
+}
+
+func (e *InvalidReferenceError) Error() string {
+	return fmt.Sprintf("invalid payment reference %q: %v", e.Reference, e.Err)
+}
+
+func (e *InvalidReferenceError) Unwrap() error {
+	return e.Err
+}
+
+func (e *InvalidReferenceError) Is(target error) bool {
+	other, ok := target.(*InvalidReferenceError)
+	return ok && e.Reference == other.Reference && errors.Is(e.Err, other.Err)
+}
+
+// Matched is a transaction that has been matched to a membership.
+type Matched struct {
+	Transaction Transaction
+	Membership  *pb.Membership
+}
+
+// Payment returns a payment for the matched transaction. The name and create
+// time of the payment are left for the server to set.
+func (m Matched) Payment() *pb.Payment {
+	return &pb.Payment{
+		User:        m.Membership.User,
+		Description: m.Transaction.Description(),
+		AmountCents: m.Transaction.AmountCents,
+	}
+}
+
+// Unmatched is a transaction that could not be matched to a membership.
+type Unmatched struct {
+	Transaction Transaction
+	Reason      error
+}
+
+var digits = regexp.MustCompile(`[0-9]+`)
+
+// Match matches incoming transfers to memberships by comparing the reference
+// of each transaction to the payment references of the memberships. If a
+// transaction has no structured reference, its message is searched for a
+// payment reference instead, since payers sometimes put it there. Only
+// transactions in Currency are matched.
+func Match(txs []Transaction, ms []*pb.Membership) (matched []Matched, unmatched []Unmatched) {
+	byReference := make(map[string]*pb.Membership, len(ms))
+	for _, m := range ms {
+		if m.PaymentReference != "" {
+			byReference[m.PaymentReference] = m
+		}
+	}
+	for _, tx := range txs {
+		if tx.AmountCents <= 0 {
+			unmatched = append(unmatched, Unmatched{Transaction: tx, Reason: ErrNotIncoming})
+			continue
+		}
+		if tx.Currency != Currency {
+			unmatched = append(unmatched, Unmatched{Transaction: tx, Reason: &CurrencyError{Currency: tx.Currency}})
+			continue
+		}
+		if tx.Reference == "" {
+			var found *pb.Membership
+			for _, candidate := range digits.FindAllString(tx.Message, -1) {
+				if m, ok := byReference[candidate]; ok {
+					found = m
+					break
+				}
+			}
+			if found == nil {
+				unmatched = append(unmatched, Unmatched{Transaction: tx, Reason: ErrNoReference})
+				continue
+			}
+			matched = append(matched, Matched{Transaction: tx, Membership: found})
+			continue
+		}
+		if err := ocr.Validate(tx.Reference); err != nil {
+			unmatched = append(unmatched, Unmatched{
+				Transaction: tx,
+				Reason:      &InvalidReferenceError{Reference: tx.Reference, Err: err},
+			})
+			continue
+		}
+		m, ok := byReference[tx.Reference]
+		if !ok {
+			unmatched = append(unmatched, Unmatched{Transaction: tx, Reason: ErrUnknownReference})
+			continue
+		}
+		matched = append(matched, Matched{Transaction: tx, Membership: m})
+	}
+	return matched, unmatched
+}
+
+// paymentKey identifies the payment created for a matched transaction.
+type paymentKey struct {
+	user        string
+	description string
+	amountCents int64
+}
+
+func keyOf(payment *pb.Payment) paymentKey {
+	return paymentKey{user: payment.User, description: payment.Description, amountCents: payment.AmountCents}
+}
+
+// Imported splits matched into the transactions whose payments are already
+// among payments, such as when the same statement is reconciled again, and
+// the ones that remain to be imported.
+//
+// A transaction with an ID is recognized by its ID, which is part of the
+// description of its payment. A transaction without an ID can only be
+// recognized by its booking date, its amount and the membership it was
+// matched to, that is, its reference. Since two such transactions may be
+// identical, they are counted: if payments contains n payments for a given
+// date, amount and reference, the first n such transactions are considered
+// imported. Reconciling the same statement twice thus creates no new
+// payments, while identical transfers on the same day are all imported the
+// first time.
+func Imported(matched []Matched, payments []*pb.Payment) (imported []Matched, pending []Matched) {
+	count := make(map[paymentKey]int)
+	for _, payment := range payments {
+		count[keyOf(payment)]++
+	}
+	for _, m := range matched {
+		key := keyOf(m.Payment())
+		if count[key] > 0 {
+			count[key]--
+			imported = append(imported, m)
+			continue
+		}
+		pending = append(pending, m)
+	}
+	return imported, pending
+}
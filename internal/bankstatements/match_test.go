@@ -0,0 +1,112 @@
+package bankstatements
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/ocr"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestMatch(t *testing.T) {
+	date := time.Date(2021, time.January, 14, 0, 0, 0, 0, time.UTC)
+	byReference := Transaction{ID: "1", BookingDate: date, Currency: Currency, AmountCents: 15000, Reference: testresources.Bar_Alice.PaymentReference}
+	byMessage := Transaction{ID: "2", BookingDate: date, Currency: Currency, AmountCents: 2500, Message: "Strecklista " + testresources.Bar_Bob.PaymentReference}
+	outgoing := Transaction{ID: "3", BookingDate: date, Currency: Currency, AmountCents: -9900, Reference: testresources.Bar_Alice.PaymentReference}
+	noReference := Transaction{ID: "4", BookingDate: date, Currency: Currency, AmountCents: 1000, Message: "Thanks for the beer"}
+	invalidReference := Transaction{ID: "5", BookingDate: date, Currency: Currency, AmountCents: 1000, Reference: "12345"}
+	unknownReference := Transaction{ID: "6", BookingDate: date, Currency: Currency, AmountCents: 1000, Reference: testresources.Mall_Alice.PaymentReference}
+	euros := Transaction{ID: "7", BookingDate: date, Currency: "EUR", AmountCents: 1000, Reference: testresources.Bar_Alice.PaymentReference}
+	noCurrency := Transaction{ID: "8", BookingDate: date, AmountCents: 1000, Reference: testresources.Bar_Alice.PaymentReference}
+	txs := []Transaction{byReference, byMessage, outgoing, noReference, invalidReference, unknownReference, euros, noCurrency}
+	ms := []*pb.Membership{testresources.Bar_Alice, testresources.Bar_Bob}
+
+	matched, unmatched := Match(txs, ms)
+	wantMatched := []Matched{
+		{Transaction: byReference, Membership: testresources.Bar_Alice},
+		{Transaction: byMessage, Membership: testresources.Bar_Bob},
+	}
+	if diff := cmp.Diff(matched, wantMatched, protocmp.Transform()); diff != "" {
+		t.Errorf("Match(...) matched != wantMatched (-got +want)\n%s", diff)
+	}
+	wantUnmatched := []Unmatched{
+		{Transaction: outgoing, Reason: ErrNotIncoming},
+		{Transaction: noReference, Reason: ErrNoReference},
+		{Transaction: invalidReference, Reason: &InvalidReferenceError{Reference: "12345", Err: ocr.ErrCheckDigit}},
+		{Transaction: unknownReference, Reason: ErrUnknownReference},
+		{Transaction: euros, Reason: &CurrencyError{Currency: "EUR"}},
+		{Transaction: noCurrency, Reason: &CurrencyError{Currency: ""}},
+	}
+	if diff := cmp.Diff(unmatched, wantUnmatched, cmpopts.EquateErrors()); diff != "" {
+		t.Errorf("Match(...) unmatched != wantUnmatched (-got +want)\n%s", diff)
+	}
+}
+
+func TestMatched_Payment(t *testing.T) {
+	m := Matched{
+		Transaction: Transaction{
+			ID:          "REF1",
+			BookingDate: time.Date(2021, time.January, 14, 0, 0, 0, 0, time.UTC),
+			AmountCents: 15000,
+		},
+		Membership: testresources.Bar_Alice,
+	}
+	want := &pb.Payment{
+		User:        testresources.Alice.Name,
+		Description: "Bank transfer on 2021-01-14 (REF1)",
+		AmountCents: 15000,
+	}
+	if diff := cmp.Diff(m.Payment(), want, protocmp.Transform()); diff != "" {
+		t.Errorf("m.Payment() != want (-got +want)\n%s", diff)
+	}
+}
+
+func TestImported(t *testing.T) {
+	date := time.Date(2021, time.January, 14, 0, 0, 0, 0, time.UTC)
+	withID := Transaction{ID: "1", BookingDate: date, Currency: Currency, AmountCents: 15000, Reference: testresources.Bar_Alice.PaymentReference}
+	// The bank assigns no IDs to these transactions, so the two transfers by
+	// Alice are identical.
+	noID := Transaction{BookingDate: date, Currency: Currency, AmountCents: 2500, Reference: testresources.Bar_Alice.PaymentReference}
+	otherAmount := Transaction{BookingDate: date, Currency: Currency, AmountCents: 5000, Reference: testresources.Bar_Alice.PaymentReference}
+	otherReference := Transaction{BookingDate: date, Currency: Currency, AmountCents: 2500, Reference: testresources.Bar_Bob.PaymentReference}
+	txs := []Transaction{withID, noID, noID, otherAmount, otherReference}
+	ms := []*pb.Membership{testresources.Bar_Alice, testresources.Bar_Bob}
+	matched, _ := Match(txs, ms)
+
+	// The first run imports every transaction.
+	imported, pending := Imported(matched, nil)
+	if len(imported) != 0 {
+		t.Errorf("first run: Imported(...) imported = %v; want none", imported)
+	}
+	if diff := cmp.Diff(pending, matched, protocmp.Transform()); diff != "" {
+		t.Errorf("first run: Imported(...) pending != matched (-got +want)\n%s", diff)
+	}
+	var payments []*pb.Payment
+	for _, m := range pending {
+		payments = append(payments, m.Payment())
+	}
+
+	// Running again on the same statement imports nothing.
+	imported, pending = Imported(matched, payments)
+	if diff := cmp.Diff(imported, matched, protocmp.Transform()); diff != "" {
+		t.Errorf("second run: Imported(...) imported != matched (-got +want)\n%s", diff)
+	}
+	if len(pending) != 0 {
+		t.Errorf("second run: Imported(...) pending = %v; want none", pending)
+	}
+
+	// A statement with another identical transfer only imports that one.
+	matched, _ = Match(append(txs, noID), ms)
+	imported, pending = Imported(matched, payments)
+	wantPending := []Matched{{Transaction: noID, Membership: testresources.Bar_Alice}}
+	if diff := cmp.Diff(pending, wantPending, protocmp.Transform()); diff != "" {
+		t.Errorf("third run: Imported(...) pending != want (-got +want)\n%s", diff)
+	}
+	if got, want := len(imported), len(txs); got != want {
+		t.Errorf("third run: len(imported) = %d; want %d", got, want)
+	}
+}
@@ -0,0 +1,31 @@
+package bankstatements
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/Saser/strecku/internal/statements"
+)
+
+// WriteReport writes a human-readable table of the unmatched transactions to w.
+func WriteReport(w io.Writer, unmatched []Unmatched) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "Date\tID\tAmount\tReference\tPayer\tMessage\tReason")
+	for _, u := range unmatched {
+		tx := u.Transaction
+		fmt.Fprintf(
+			tw,
+			"%s\t%s\t%s %s\t%s\t%s\t%s\t%v\n",
+			tx.BookingDate.Format("2006-01-02"),
+			tx.ID,
+			statements.FormatCents(tx.AmountCents),
+			tx.Currency,
+			tx.Reference,
+			tx.Payer,
+			tx.Message,
+			u.Reason,
+		)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,38 @@
+package bankstatements
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWriteReport(t *testing.T) {
+	unmatched := []Unmatched{
+		{
+			Transaction: Transaction{
+				ID:          "REF4",
+				BookingDate: time.Date(2021, time.January, 14, 0, 0, 0, 0, time.UTC),
+				AmountCents: 1050,
+				Currency:    "SEK",
+				Payer:       "Carol",
+				Message:     "Beer",
+			},
+			Reason: ErrNoReference,
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, unmatched); err != nil {
+		t.Fatalf("WriteReport(&buf, unmatched) = %v; want nil", err)
+	}
+	want := strings.Join([]string{
+		"Date        ID    Amount     Reference  Payer  Message  Reason",
+		"2021-01-14  REF4  10.50 SEK             Carol  Beer     " + ErrNoReference.Error(),
+		"",
+	}, "\n")
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Errorf("WriteReport(&buf, unmatched) output != want (-got +want)\n%s", diff)
+	}
+}
@@ -0,0 +1,391 @@
+package bulkimport
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"github.com/Saser/strecku/resources/stores/products"
+)
+
+const (
+	FileProducts    = "products"
+	FileMemberships = "memberships"
+)
+
+var (
+	ErrFieldCount          = errors.New("wrong number of fields")
+	ErrPriceInvalid        = errors.New("price is not a non-negative decimal number with at most two fractional digits")
+	ErrDuplicateMembership = errors.New("user occurs more than once")
+)
+
+// RowError describes why a single CSV record could not be imported. Record is
+// the number of the record in the file, where the header is record 1. Line is
+// the line where a syntax error was found, which may differ from Record since
+// quoted fields can span several lines, and is zero for other errors.
+type RowError struct {
+	File   string
+	Record int
+	Line   int
+	Err    error
+}
+
+func (e *RowError) Error() string {
+	if e.Line != 0 {
+		return fmt.Sprintf("%s: record %d: line %d: %v", e.File, e.Record, e.Line, e.Err)
+	}
+	return fmt.Sprintf("%s: record %d: %v", e.File, e.Record, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Plan contains the resources to create in an import, along with errors for
+// the rows that could not be turned into resources. A plan with errors cannot
+// be applied.
+type Plan struct {
+	Products    []*pb.Product
+	Memberships []*pb.Membership
+	Errors      []*RowError
+}
+
+// Importer turns CSV files into products and memberships of a store.
+type Importer struct {
+	userRepo       repositories.Users
+	membershipRepo *memberships.Repository
+	productRepo    *products.Repository
+}
+
+func NewImporter(
+	userRepo repositories.Users,
+	membershipRepo *memberships.Repository,
+	productRepo *products.Repository,
+) *Importer {
+	return &Importer{
+		userRepo:       userRepo,
+		membershipRepo: membershipRepo,
+		productRepo:    productRepo,
+	}
+}
+
+// ParsePrice parses a non-negative decimal amount such as "12.50" into a
+// non-positive price in cents, such as -1250.
+func ParsePrice(s string) (int64, error) {
+	parts := strings.SplitN(s, ".", 2)
+	whole := parts[0]
+	frac := ""
+	if len(parts) == 2 {
+		frac = parts[1]
+	}
+	if whole == "" || len(frac) > 2 || (len(parts) == 2 && frac == "") {
+		return 0, ErrPriceInvalid
+	}
+	for len(frac) < 2 {
+		frac += "0"
+	}
+	for _, r := range whole + frac {
+		if r < '0' || r > '9' {
+			return 0, ErrPriceInvalid
+		}
+	}
+	cents, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, ErrPriceInvalid
+	}
+	return -cents, nil
+}
+
+func parseBool(s string) (bool, error) {
+	if s == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(s)
+}
+
+// readRecords reads all records in r, which is the given file, and returns them
+// as maps from column name to value, keyed by the header. Columns in required
+// must be present in the header, and no columns other than those in required
+// and optional may be present. A record with the wrong number of fields is
+// returned as a nil map. Errors in the header, and syntax errors that make the
+// rest of the file unreadable, are returned as a RowError for the record where
+// they occurred.
+func readRecords(file string, r io.Reader, required []string, optional []string) ([]map[string]string, *RowError) {
+	headerErr := func(err error) *RowError {
+		return &RowError{File: file, Record: 1, Err: err}
+	}
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	// The number of fields is checked per record below, so that a malformed
+	// record becomes an error for that record only.
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, headerErr(err)
+	}
+	known := make(map[string]bool)
+	for _, col := range required {
+		known[col] = true
+	}
+	for _, col := range optional {
+		known[col] = true
+	}
+	present := make(map[string]bool)
+	for _, col := range header {
+		if !known[col] {
+			return nil, headerErr(fmt.Errorf("unknown column %q", col))
+		}
+		if present[col] {
+			return nil, headerErr(fmt.Errorf("duplicate column %q", col))
+		}
+		present[col] = true
+	}
+	for _, col := range required {
+		if !present[col] {
+			return nil, headerErr(fmt.Errorf("missing column %q", col))
+		}
+	}
+	var records []map[string]string
+	for {
+		fields, err := cr.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			// The header is record 1, and the records read so far follow it.
+			rowErr := &RowError{File: file, Record: len(records) + 2, Err: err}
+			if parseErr := new(csv.ParseError); errors.As(err, &parseErr) {
+				rowErr.Line = parseErr.Line
+				rowErr.Err = parseErr.Err
+			}
+			return nil, rowErr
+		}
+		if len(fields) != len(header) {
+			records = append(records, nil)
+			continue
+		}
+		record := make(map[string]string, len(header))
+		for i, col := range header {
+			record[col] = strings.TrimSpace(fields[i])
+		}
+		records = append(records, record)
+	}
+}
+
+// Plan reads products and memberships from the given CSV files and turns them
+// into resources of the given store. Either reader may be nil. Errors in
+// individual rows, or in the header of a file, are returned as part of the
+// plan; the returned error is only non-nil if the repositories could not be
+// queried.
+func (i *Importer) Plan(ctx context.Context, store string, productsCSV io.Reader, membershipsCSV io.Reader) (*Plan, error) {
+	plan := new(Plan)
+	if productsCSV != nil {
+		if err := i.planProducts(plan, store, productsCSV); err != nil {
+			return nil, err
+		}
+	}
+	if membershipsCSV != nil {
+		if err := i.planMemberships(ctx, plan, store, membershipsCSV); err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+func (i *Importer) planProducts(plan *Plan, store string, r io.Reader) error {
+	records, fileErr := readRecords(FileProducts, r, []string{"display_name", "full_price"}, []string{"discount_price", "deposit"})
+	if fileErr != nil {
+		plan.Errors = append(plan.Errors, fileErr)
+		return nil
+	}
+	for n, record := range records {
+		rowErr := func(err error) {
+			plan.Errors = append(plan.Errors, &RowError{File: FileProducts, Record: n + 2, Err: err})
+		}
+		if record == nil {
+			rowErr(ErrFieldCount)
+			continue
+		}
+		fullPrice, err := ParsePrice(record["full_price"])
+		if err != nil {
+			rowErr(fmt.Errorf("full price: %w", err))
+			continue
+		}
+		discountPrice := fullPrice
+		if s := record["discount_price"]; s != "" {
+			discountPrice, err = ParsePrice(s)
+			if err != nil {
+				rowErr(fmt.Errorf("discount price: %w", err))
+				continue
+			}
+		}
+		var deposit int64
+		if s := record["deposit"]; s != "" {
+			deposit, err = ParsePrice(s)
+			if err != nil {
+				rowErr(fmt.Errorf("deposit: %w", err))
+				continue
+			}
+		}
+		product := &pb.Product{
+			Name:               products.GenerateName(store),
+			DisplayName:        record["display_name"],
+			FullPriceCents:     fullPrice,
+			DiscountPriceCents: discountPrice,
+			DepositCents:       deposit,
+		}
+		if err := products.Validate(product); err != nil {
+			rowErr(err)
+			continue
+		}
+		plan.Products = append(plan.Products, product)
+	}
+	return nil
+}
+
+func (i *Importer) planMemberships(ctx context.Context, plan *Plan, store string, r io.Reader) error {
+	records, fileErr := readRecords(FileMemberships, r, []string{"email_address"}, []string{"administrator", "discount"})
+	if fileErr != nil {
+		plan.Errors = append(plan.Errors, fileErr)
+		return nil
+	}
+	seen := make(map[string]bool) // user name -> imported by an earlier record
+	for n, record := range records {
+		rowErr := func(err error) {
+			plan.Errors = append(plan.Errors, &RowError{File: FileMemberships, Record: n + 2, Err: err})
+		}
+		if record == nil {
+			rowErr(ErrFieldCount)
+			continue
+		}
+		user, err := i.userRepo.ResolveEmail(ctx, record["email_address"])
+		if err != nil {
+			if notFound := new(repositories.EmailAddressNotFound); errors.As(err, &notFound) {
+				rowErr(err)
+				continue
+			}
+			return err
+		}
+		if seen[user] {
+			rowErr(ErrDuplicateMembership)
+			continue
+		}
+		administrator, err := parseBool(record["administrator"])
+		if err != nil {
+			rowErr(fmt.Errorf("administrator: %w", err))
+			continue
+		}
+		discount, err := parseBool(record["discount"])
+		if err != nil {
+			rowErr(fmt.Errorf("discount: %w", err))
+			continue
+		}
+		name := memberships.GenerateName(store)
+		paymentReference, err := memberships.PaymentReference(name)
+		if err != nil {
+			return err
+		}
+		membership := &pb.Membership{
+			Name:             name,
+			User:             user,
+			Administrator:    administrator,
+			Discount:         discount,
+			PaymentReference: paymentReference,
+		}
+		if err := memberships.Validate(membership); err != nil {
+			rowErr(err)
+			continue
+		}
+		_, err = i.membershipRepo.LookupMembershipIn(ctx, store, user)
+		switch {
+		case err == nil:
+			rowErr(&memberships.ExistsError{Parent: store, User: user})
+			continue
+		case errors.As(err, new(*memberships.NotFoundError)):
+		default:
+			return err
+		}
+		seen[user] = true
+		plan.Memberships = append(plan.Memberships, membership)
+	}
+	return nil
+}
+
+// Apply creates all resources in the given plan, which must not contain any
+// errors. Every resource is validated, and memberships are checked not to
+// exist already, before the first one is created. If a resource cannot be
+// created anyway, the resources created so far are deleted again before
+// returning the error, so that the plan is either applied in full or not at
+// all. Errors from deleting resources are included in the returned error.
+func (i *Importer) Apply(ctx context.Context, plan *Plan) (err error) {
+	if len(plan.Errors) > 0 {
+		return plan.Errors[0]
+	}
+	if err := i.check(ctx, plan); err != nil {
+		return err
+	}
+	var created []func() error
+	defer func() {
+		if err == nil {
+			return
+		}
+		for j := len(created) - 1; j >= 0; j-- {
+			if dErr := created[j](); dErr != nil {
+				err = fmt.Errorf("%v (rollback also failed: %v)", err, dErr)
+			}
+		}
+	}()
+	for _, product := range plan.Products {
+		if err := i.productRepo.CreateProduct(ctx, product); err != nil {
+			return err
+		}
+		name := product.Name
+		created = append(created, func() error { return i.productRepo.DeleteProduct(ctx, name) })
+	}
+	for _, membership := range plan.Memberships {
+		if err := i.membershipRepo.CreateMembership(ctx, membership); err != nil {
+			return err
+		}
+		name := membership.Name
+		created = append(created, func() error { return i.membershipRepo.DeleteMembership(ctx, name) })
+	}
+	return nil
+}
+
+// check returns an error if any resource of plan is invalid, or if any
+// membership of plan already exists.
+func (i *Importer) check(ctx context.Context, plan *Plan) error {
+	for _, product := range plan.Products {
+		if err := products.Validate(product); err != nil {
+			return fmt.Errorf("product %q: %w", product.DisplayName, err)
+		}
+	}
+	for _, membership := range plan.Memberships {
+		if err := memberships.Validate(membership); err != nil {
+			return fmt.Errorf("membership of %q: %w", membership.User, err)
+		}
+		store, err := memberships.Parent(membership.Name)
+		if err != nil {
+			return err
+		}
+		_, err = i.membershipRepo.LookupMembershipIn(ctx, store, membership.User)
+		switch {
+		case err == nil:
+			return &memberships.ExistsError{Parent: store, User: membership.User}
+		case errors.As(err, new(*memberships.NotFoundError)):
+		default:
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,313 @@
+package bulkimport
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"github.com/Saser/strecku/resources/stores/products"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func seed(ctx context.Context, t *testing.T) (*Importer, *memberships.Repository, *products.Repository) {
+	t.Helper()
+	userRepo := repositories.NewInMemoryUsers()
+	repositories.SeedUsers(
+		ctx,
+		t,
+		userRepo,
+		[]*pb.User{testresources.Alice, testresources.Bob},
+		[]string{testresources.AlicePassword, testresources.BobPassword},
+	)
+	membershipRepo := memberships.SeedRepository(t, []*pb.Membership{testresources.Bar_Alice})
+	productRepo := products.SeedRepository(t, []*pb.Product{testresources.Beer})
+	return NewImporter(userRepo, membershipRepo, productRepo), membershipRepo, productRepo
+}
+
+func TestParsePrice(t *testing.T) {
+	for _, test := range []struct {
+		s       string
+		want    int64
+		wantErr error
+	}{
+		{s: "0", want: 0},
+		{s: "12", want: -1200},
+		{s: "12.5", want: -1250},
+		{s: "12.50", want: -1250},
+		{s: "0.05", want: -5},
+		{s: "", wantErr: ErrPriceInvalid},
+		{s: "-12.50", wantErr: ErrPriceInvalid},
+		{s: "12.", wantErr: ErrPriceInvalid},
+		{s: ".50", wantErr: ErrPriceInvalid},
+		{s: "12.505", wantErr: ErrPriceInvalid},
+		{s: "12,50", wantErr: ErrPriceInvalid},
+		{s: "1e3", wantErr: ErrPriceInvalid},
+	} {
+		got, err := ParsePrice(test.s)
+		if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+			t.Errorf("ParsePrice(%q) err = %v; want %v", test.s, err, test.wantErr)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParsePrice(%q) = %v; want %v", test.s, got, test.want)
+		}
+	}
+}
+
+func TestImporter_Plan(t *testing.T) {
+	ctx := context.Background()
+	ignoreName := protocmp.IgnoreFields(&pb.Product{}, "name")
+	ignoreMembershipName := protocmp.IgnoreFields(&pb.Membership{}, "name", "payment_reference")
+	for _, test := range []struct {
+		desc            string
+		productsCSV     string
+		membershipsCSV  string
+		wantProducts    []*pb.Product
+		wantMemberships []*pb.Membership
+		wantErrors      []RowError // Err is compared by its message
+	}{
+		{
+			desc: "OK",
+			productsCSV: "display_name,full_price,discount_price,deposit\n" +
+				"Cider,30.00,25,1\n" +
+				"Water,5,,\n",
+			membershipsCSV: "email_address,administrator\n" +
+				"bob@example.com,true\n",
+			wantProducts: []*pb.Product{
+				{DisplayName: "Cider", FullPriceCents: -3000, DiscountPriceCents: -2500, DepositCents: -100},
+				{DisplayName: "Water", FullPriceCents: -500, DiscountPriceCents: -500},
+			},
+			wantMemberships: []*pb.Membership{
+				{User: testresources.Bob.Name, Administrator: true},
+			},
+		},
+		{
+			desc:        "MissingColumn",
+			productsCSV: "display_name\nCider\n",
+			wantErrors: []RowError{
+				{File: FileProducts, Record: 1, Err: errors.New(`missing column "full_price"`)},
+			},
+		},
+		{
+			desc:           "UnknownColumn",
+			membershipsCSV: "email_address,owner\nbob@example.com,true\n",
+			wantErrors: []RowError{
+				{File: FileMemberships, Record: 1, Err: errors.New(`unknown column "owner"`)},
+			},
+		},
+		{
+			desc: "SyntaxError",
+			// The first record spans two lines, so the malformed second
+			// record is on line 4.
+			productsCSV: "display_name,full_price\n" +
+				"\"Apple\nCider\",30\n" +
+				"Wa\"ter,5\n",
+			wantErrors: []RowError{
+				{File: FileProducts, Record: 3, Line: 4, Err: csv.ErrBareQuote},
+			},
+		},
+		{
+			desc: "RowErrors",
+			productsCSV: "display_name,full_price,deposit\n" +
+				"Cider,thirty,\n" +
+				"Lemonade,10,-1\n" +
+				",30,\n" +
+				"Lemonade,10\n" +
+				"Water,5,\n",
+			membershipsCSV: "email_address,discount\n" +
+				"alice@example.com,\n" +
+				"carol@example.com,\n" +
+				"bob@example.com,yes\n" +
+				"bob@example.com,true\n" +
+				"bob@example.com,false\n",
+			wantProducts: []*pb.Product{
+				{DisplayName: "Water", FullPriceCents: -500, DiscountPriceCents: -500},
+			},
+			wantMemberships: []*pb.Membership{
+				{User: testresources.Bob.Name, Discount: true},
+			},
+			wantErrors: []RowError{
+				{File: FileProducts, Record: 2, Err: errors.New("full price: " + ErrPriceInvalid.Error())},
+				{File: FileProducts, Record: 3, Err: errors.New("deposit: " + ErrPriceInvalid.Error())},
+				{File: FileProducts, Record: 4, Err: products.ErrDisplayNameEmpty},
+				{File: FileProducts, Record: 5, Err: ErrFieldCount},
+				{File: FileMemberships, Record: 2, Err: &memberships.ExistsError{Parent: testresources.Bar.Name, User: testresources.Alice.Name}},
+				{File: FileMemberships, Record: 3, Err: &repositories.EmailAddressNotFound{EmailAddress: "carol@example.com"}},
+				{File: FileMemberships, Record: 4, Err: errors.New(`discount: strconv.ParseBool: parsing "yes": invalid syntax`)},
+				{File: FileMemberships, Record: 6, Err: ErrDuplicateMembership},
+			},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			i, _, _ := seed(ctx, t)
+			var productsCSV, membershipsCSV io.Reader
+			if test.productsCSV != "" {
+				productsCSV = strings.NewReader(test.productsCSV)
+			}
+			if test.membershipsCSV != "" {
+				membershipsCSV = strings.NewReader(test.membershipsCSV)
+			}
+			plan, err := i.Plan(ctx, testresources.Bar.Name, productsCSV, membershipsCSV)
+			if err != nil {
+				t.Fatalf("i.Plan(...) err = %v; want nil", err)
+			}
+			if diff := cmp.Diff(plan.Products, test.wantProducts, protocmp.Transform(), ignoreName, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("plan.Products != test.wantProducts (-got +want)\n%s", diff)
+			}
+			if diff := cmp.Diff(plan.Memberships, test.wantMemberships, protocmp.Transform(), ignoreMembershipName, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("plan.Memberships != test.wantMemberships (-got +want)\n%s", diff)
+			}
+			for _, product := range plan.Products {
+				if parent, err := products.Parent(product.Name); err != nil || parent != testresources.Bar.Name {
+					t.Errorf("products.Parent(%q) = %q, %v; want %q, nil", product.Name, parent, err, testresources.Bar.Name)
+				}
+			}
+			if got, want := len(plan.Errors), len(test.wantErrors); got != want {
+				t.Fatalf("len(plan.Errors) = %v; want %v (errors: %v)", got, want, plan.Errors)
+			}
+			for j, got := range plan.Errors {
+				want := test.wantErrors[j]
+				if got.File != want.File || got.Record != want.Record || got.Line != want.Line || got.Err.Error() != want.Err.Error() {
+					t.Errorf("plan.Errors[%d] = %v; want %v", j, got, &want)
+				}
+			}
+		})
+	}
+}
+
+func TestImporter_Apply(t *testing.T) {
+	ctx := context.Background()
+	i, membershipRepo, productRepo := seed(ctx, t)
+	plan, err := i.Plan(
+		ctx,
+		testresources.Bar.Name,
+		strings.NewReader("display_name,full_price\nCider,30\n"),
+		strings.NewReader("email_address\nbob@example.com\n"),
+	)
+	if err != nil {
+		t.Fatalf("i.Plan(...) err = %v; want nil", err)
+	}
+	if err := i.Apply(ctx, plan); err != nil {
+		t.Fatalf("i.Apply(ctx, plan) = %v; want nil", err)
+	}
+	if _, err := productRepo.LookupProduct(ctx, plan.Products[0].Name); err != nil {
+		t.Errorf("productRepo.LookupProduct(ctx, %q) err = %v; want nil", plan.Products[0].Name, err)
+	}
+	if _, err := membershipRepo.LookupMembership(ctx, plan.Memberships[0].Name); err != nil {
+		t.Errorf("membershipRepo.LookupMembership(ctx, %q) err = %v; want nil", plan.Memberships[0].Name, err)
+	}
+}
+
+func TestImporter_Apply_Conflict(t *testing.T) {
+	ctx := context.Background()
+	i, membershipRepo, productRepo := seed(ctx, t)
+	plan, err := i.Plan(
+		ctx,
+		testresources.Bar.Name,
+		strings.NewReader("display_name,full_price\nCider,30\n"),
+		strings.NewReader("email_address\nbob@example.com\n"),
+	)
+	if err != nil {
+		t.Fatalf("i.Plan(...) err = %v; want nil", err)
+	}
+	// Create Bob's membership after planning, so that Apply fails before
+	// creating anything, including the product.
+	conflicting := memberships.Clone(plan.Memberships[0])
+	conflicting.Name = memberships.GenerateName(testresources.Bar.Name)
+	if err := membershipRepo.CreateMembership(ctx, conflicting); err != nil {
+		t.Fatalf("membershipRepo.CreateMembership(ctx, %v) = %v; want nil", conflicting, err)
+	}
+	if err := i.Apply(ctx, plan); err == nil {
+		t.Fatal("i.Apply(ctx, plan) = nil; want non-nil")
+	}
+	wantErr := &products.NotFoundError{Name: plan.Products[0].Name}
+	if _, err := productRepo.LookupProduct(ctx, plan.Products[0].Name); !cmp.Equal(err, wantErr, cmpopts.EquateErrors()) {
+		t.Errorf("productRepo.LookupProduct(ctx, %q) err = %v; want %v", plan.Products[0].Name, err, wantErr)
+	}
+}
+
+func TestImporter_Apply_Rollback(t *testing.T) {
+	ctx := context.Background()
+	for _, test := range []struct {
+		desc string
+		// fail makes the last resource of plan fail to be created, after the
+		// ones before it have been created.
+		fail func(plan *Plan)
+	}{
+		{
+			desc: "Product",
+			fail: func(plan *Plan) {
+				plan.Memberships = nil
+				existing := products.Clone(testresources.Beer)
+				existing.DisplayName = "Stout"
+				plan.Products = append(plan.Products, existing)
+			},
+		},
+		{
+			desc: "Membership",
+			fail: func(plan *Plan) {
+				plan.Memberships[0].Name = testresources.Bar_Alice.Name
+				plan.Memberships[0].PaymentReference = testresources.Bar_Alice.PaymentReference
+			},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			i, membershipRepo, productRepo := seed(ctx, t)
+			plan, err := i.Plan(
+				ctx,
+				testresources.Bar.Name,
+				strings.NewReader("display_name,full_price\nCider,30\nWater,5\n"),
+				strings.NewReader("email_address\nbob@example.com\n"),
+			)
+			if err != nil {
+				t.Fatalf("i.Plan(...) err = %v; want nil", err)
+			}
+			test.fail(plan)
+			if err := i.Apply(ctx, plan); err == nil {
+				t.Fatal("i.Apply(ctx, plan) = nil; want non-nil")
+			}
+			for _, product := range plan.Products[:2] {
+				wantErr := &products.NotFoundError{Name: product.Name}
+				if _, err := productRepo.LookupProduct(ctx, product.Name); !cmp.Equal(err, wantErr, cmpopts.EquateErrors()) {
+					t.Errorf("productRepo.LookupProduct(ctx, %q) err = %v; want %v", product.Name, err, wantErr)
+				}
+			}
+			// The resources that existed before are left alone.
+			if _, err := productRepo.LookupProduct(ctx, testresources.Beer.Name); err != nil {
+				t.Errorf("productRepo.LookupProduct(ctx, %q) err = %v; want nil", testresources.Beer.Name, err)
+			}
+			got, err := membershipRepo.ListMemberships(ctx)
+			if err != nil {
+				t.Fatalf("membershipRepo.ListMemberships(ctx) err = %v; want nil", err)
+			}
+			want := []*pb.Membership{testresources.Bar_Alice}
+			if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+				t.Errorf("membershipRepo.ListMemberships(ctx) != want (-got +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestImporter_Apply_Errors(t *testing.T) {
+	ctx := context.Background()
+	i, _, productRepo := seed(ctx, t)
+	plan, err := i.Plan(ctx, testresources.Bar.Name, strings.NewReader("display_name,full_price\nCider,30\nWater,free\n"), nil)
+	if err != nil {
+		t.Fatalf("i.Plan(...) err = %v; want nil", err)
+	}
+	if err := i.Apply(ctx, plan); err == nil {
+		t.Fatal("i.Apply(ctx, plan) = nil; want non-nil")
+	}
+	if _, err := productRepo.LookupProduct(ctx, plan.Products[0].Name); err == nil {
+		t.Errorf("productRepo.LookupProduct(ctx, %q) err = nil; want non-nil", plan.Products[0].Name)
+	}
+}
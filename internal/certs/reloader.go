@@ -0,0 +1,164 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+var ErrNoCertificates = errors.New("no certificates found in PEM file")
+
+// DefaultCheckInterval is the default minimum time between checks for changed
+// files.
+const DefaultCheckInterval = 5 * time.Second
+
+// Reloader loads a certificate and key, and optionally a pool of client CA
+// certificates, from PEM files. The files are reloaded when they change, so
+// that renewed certificates are used without restarting the server.
+type Reloader struct {
+	certFile, keyFile, clientCAFile string
+	clientAuth                      tls.ClientAuthType
+
+	// CheckInterval is the minimum time between checks for changed files.
+	CheckInterval time.Duration
+	// OnError, if non-nil, is called when reloading fails. The previously
+	// loaded files keep being used in that case.
+	OnError func(error)
+	// now is used instead of time.Now in tests.
+	now func() time.Time
+
+	mu        sync.Mutex
+	lastCheck time.Time
+	modTimes  map[string]time.Time
+	config    *tls.Config
+}
+
+// NewReloader returns a Reloader for the given certificate and key files. If
+// clientCAFile is non-empty, client certificates are verified against the CA
+// certificates in it according to clientAuth. The files are loaded before
+// returning, and an error is returned if they cannot be loaded.
+func NewReloader(certFile, keyFile, clientCAFile string, clientAuth tls.ClientAuthType) (*Reloader, error) {
+	r := &Reloader{
+		certFile:      certFile,
+		keyFile:       keyFile,
+		clientCAFile:  clientCAFile,
+		clientAuth:    clientAuth,
+		CheckInterval: DefaultCheckInterval,
+		now:           time.Now,
+	}
+	modTimes, err := r.stat()
+	if err != nil {
+		return nil, err
+	}
+	config, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	r.modTimes = modTimes
+	r.config = config
+	r.lastCheck = r.now()
+	return r, nil
+}
+
+func (r *Reloader) files() []string {
+	files := []string{r.certFile, r.keyFile}
+	if r.clientCAFile != "" {
+		files = append(files, r.clientCAFile)
+	}
+	return files
+}
+
+func (r *Reloader) stat() (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time)
+	for _, f := range r.files() {
+		info, err := os.Stat(f)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[f] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+func (r *Reloader) load() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		// gRPC requires HTTP/2, while REST clients may use HTTP/1.1.
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+	if r.clientCAFile != "" {
+		pem, err := ioutil.ReadFile(r.clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s: %w", r.clientCAFile, ErrNoCertificates)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = r.clientAuth
+	}
+	return config, nil
+}
+
+// current returns the configuration from the most recently loaded files,
+// first reloading them if they have changed since they were last loaded.
+func (r *Reloader) current() *tls.Config {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	if now.Sub(r.lastCheck) < r.CheckInterval {
+		return r.config
+	}
+	r.lastCheck = now
+	modTimes, err := r.stat()
+	if err != nil {
+		r.reportError(err)
+		return r.config
+	}
+	changed := false
+	for f, t := range modTimes {
+		if !t.Equal(r.modTimes[f]) {
+			changed = true
+		}
+	}
+	if !changed {
+		return r.config
+	}
+	config, err := r.load()
+	if err != nil {
+		// The files may be in the middle of being replaced, so the
+		// modification times are not saved, to try again later.
+		r.reportError(err)
+		return r.config
+	}
+	r.modTimes = modTimes
+	r.config = config
+	return r.config
+}
+
+func (r *Reloader) reportError(err error) {
+	if r.OnError != nil {
+		r.OnError(fmt.Errorf("reload TLS files: %w", err))
+	}
+}
+
+// TLSConfig returns a TLS configuration for servers that always uses the most
+// recently loaded files.
+func (r *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.current(), nil
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Saser/strecku/internal/certs/certstest"
+)
+
+// commonName returns the common name of the certificate in config.
+func commonName(t *testing.T, config *tls.Config) string {
+	t.Helper()
+	cert, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert.Subject.CommonName
+}
+
+// touch sets the modification time of the given files to a time in the
+// future, so that the change is noticed regardless of the resolution of
+// modification times.
+func touch(t *testing.T, future time.Duration, files ...string) {
+	t.Helper()
+	mtime := time.Now().Add(future)
+	for _, f := range files {
+		if err := os.Chtimes(f, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestReloader(t *testing.T) {
+	dir := t.TempDir()
+	ca := certstest.NewCA(t, "Test CA")
+	certPEM, keyPEM := ca.IssueServer(t, "server-1")
+	certFile := certstest.WriteFile(t, dir, "cert.pem", certPEM)
+	keyFile := certstest.WriteFile(t, dir, "key.pem", keyPEM)
+
+	r, err := NewReloader(certFile, keyFile, "", tls.NoClientCert)
+	if err != nil {
+		t.Fatalf("NewReloader(...) err = %v; want nil", err)
+	}
+	now := time.Now()
+	r.now = func() time.Time { return now }
+	var reloadErrs []error
+	r.OnError = func(err error) { reloadErrs = append(reloadErrs, err) }
+	if got, want := commonName(t, r.current()), "server-1"; got != want {
+		t.Fatalf("initial common name = %q; want %q", got, want)
+	}
+
+	// Replace the files. The change is not noticed until CheckInterval has
+	// passed.
+	certPEM, keyPEM = ca.IssueServer(t, "server-2")
+	certstest.WriteFile(t, dir, "cert.pem", certPEM)
+	certstest.WriteFile(t, dir, "key.pem", keyPEM)
+	touch(t, time.Minute, certFile, keyFile)
+	if got, want := commonName(t, r.current()), "server-1"; got != want {
+		t.Errorf("common name before CheckInterval = %q; want %q", got, want)
+	}
+	now = now.Add(r.CheckInterval)
+	if got, want := commonName(t, r.current()), "server-2"; got != want {
+		t.Errorf("common name after CheckInterval = %q; want %q", got, want)
+	}
+
+	// Replace only the certificate, so that it does not match the key. The
+	// previous files keep being used.
+	certPEM, _ = ca.IssueServer(t, "server-3")
+	certstest.WriteFile(t, dir, "cert.pem", certPEM)
+	touch(t, 2*time.Minute, certFile)
+	now = now.Add(r.CheckInterval)
+	if got, want := commonName(t, r.current()), "server-2"; got != want {
+		t.Errorf("common name with mismatched key = %q; want %q", got, want)
+	}
+	if len(reloadErrs) != 1 {
+		t.Errorf("reload errors = %v; want exactly one", reloadErrs)
+	}
+
+	// Once the key is also replaced, the new files are used.
+	certPEM, keyPEM = ca.IssueServer(t, "server-3")
+	certstest.WriteFile(t, dir, "cert.pem", certPEM)
+	certstest.WriteFile(t, dir, "key.pem", keyPEM)
+	touch(t, 3*time.Minute, certFile, keyFile)
+	now = now.Add(r.CheckInterval)
+	if got, want := commonName(t, r.current()), "server-3"; got != want {
+		t.Errorf("common name after fixing key = %q; want %q", got, want)
+	}
+}
+
+func TestReloader_ClientCAs(t *testing.T) {
+	dir := t.TempDir()
+	ca := certstest.NewCA(t, "Test CA")
+	certPEM, keyPEM := ca.IssueServer(t, "server")
+	certFile := certstest.WriteFile(t, dir, "cert.pem", certPEM)
+	keyFile := certstest.WriteFile(t, dir, "key.pem", keyPEM)
+	caFile := certstest.WriteFile(t, dir, "ca.pem", ca.CertPEM)
+
+	r, err := NewReloader(certFile, keyFile, caFile, tls.RequireAndVerifyClientCert)
+	if err != nil {
+		t.Fatalf("NewReloader(...) err = %v; want nil", err)
+	}
+	config := r.current()
+	if config.ClientCAs == nil {
+		t.Error("config.ClientCAs = nil; want non-nil")
+	}
+	if got, want := config.ClientAuth, tls.RequireAndVerifyClientCert; got != want {
+		t.Errorf("config.ClientAuth = %v; want %v", got, want)
+	}
+}
+
+func TestNewReloader_Errors(t *testing.T) {
+	dir := t.TempDir()
+	ca := certstest.NewCA(t, "Test CA")
+	certPEM, keyPEM := ca.IssueServer(t, "server")
+	certFile := certstest.WriteFile(t, dir, "cert.pem", certPEM)
+	keyFile := certstest.WriteFile(t, dir, "key.pem", keyPEM)
+	emptyFile := certstest.WriteFile(t, dir, "empty.pem", nil)
+
+	if _, err := NewReloader(certFile, dir+"/missing.pem", "", tls.NoClientCert); err == nil {
+		t.Error("NewReloader with missing key file: err = nil; want non-nil")
+	}
+	if _, err := NewReloader(keyFile, certFile, "", tls.NoClientCert); err == nil {
+		t.Error("NewReloader with swapped files: err = nil; want non-nil")
+	}
+	if _, err := NewReloader(certFile, keyFile, emptyFile, tls.VerifyClientCertIfGiven); !errors.Is(err, ErrNoCertificates) {
+		t.Errorf("NewReloader with empty client CA file: err = %v; want %v", err, ErrNoCertificates)
+	}
+}
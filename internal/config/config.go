@@ -0,0 +1,550 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/mail"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Saser/strecku/internal/logging"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	BackendMemory   = "memory"
+	BackendPostgres = "postgres"
+)
+
+const (
+	MailBackendFile = "file"
+	MailBackendSMTP = "smtp"
+)
+
+const (
+	// ClientAuthRequest verifies client certificates if they are presented,
+	// but also accepts clients without certificates.
+	ClientAuthRequest = "request"
+	// ClientAuthRequire rejects clients without a valid client certificate.
+	ClientAuthRequire = "require"
+)
+
+// EnvConfigFile is the environment variable that can be used instead of the
+// -config flag to point out the configuration file.
+const EnvConfigFile = "STRECKU_CONFIG"
+
+// Config is the configuration of the StreckU server.
+type Config struct {
+	// Address is the address to listen on for gRPC, gRPC-Web and REST
+	// requests.
+	Address string `yaml:"address"`
+	// AdminAddress is the address to listen on for the HTTP admin endpoints,
+	// such as liveness and readiness checks and Prometheus metrics. If empty,
+	// they are not served.
+	AdminAddress string `yaml:"admin_address"`
+	// LogLevel is the minimum level of log messages, such as "info".
+	LogLevel string `yaml:"log_level"`
+	// LogFormat is the format of log messages: "text" or "json".
+	LogFormat string   `yaml:"log_format"`
+	TLS       TLS      `yaml:"tls"`
+	CORS      CORS     `yaml:"cors"`
+	Database  Database `yaml:"database"`
+	Mail      Mail     `yaml:"mail"`
+}
+
+// TLS contains the TLS material of the server. If CertFile and KeyFile are
+// empty, the server does not use TLS. The files are reloaded when they change.
+type TLS struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// ClientCAFile is a PEM file of CA certificates used to verify client
+	// certificates. If empty, client certificates are not requested.
+	ClientCAFile string `yaml:"client_ca_file"`
+	// ClientAuth is either "request" or "require". It defaults to "request"
+	// if ClientCAFile is set.
+	ClientAuth string `yaml:"client_auth"`
+	// ClientIdentities maps subjects of client certificates, in RFC 2253
+	// format such as "CN=kiosk-1,O=Bar", to identity names. Clients with a
+	// verified certificate whose subject is not in the map are rejected.
+	ClientIdentities map[string]string `yaml:"client_identities"`
+	// AdminIdentities lists the names of identities in ClientIdentities that
+	// may administer the server, such as listing and clearing lockouts.
+	AdminIdentities []string `yaml:"admin_identities"`
+}
+
+// CORS configures which web pages may make cross-origin gRPC-Web and REST
+// requests to the server.
+type CORS struct {
+	// AllowedOrigins lists the allowed origins, such as
+	// "https://kiosk.example.com". The origin "*" allows any origin. If empty,
+	// cross-origin requests are not allowed.
+	AllowedOrigins []string `yaml:"allowed_origins"`
+}
+
+// Database configures where data is stored.
+type Database struct {
+	// Backend is either "memory" or "postgres".
+	Backend string `yaml:"backend"`
+	// DSN is the connection string of the PostgreSQL database. It is only
+	// used, and then required, with the "postgres" backend.
+	DSN string `yaml:"dsn"`
+	// AutoMigrate makes the server apply the migrations in MigrationsDir
+	// to the PostgreSQL database at startup.
+	AutoMigrate bool `yaml:"auto_migrate"`
+	// MigrationsDir is the directory containing the migrations, such as
+	// the database directory of the StreckU repository.
+	MigrationsDir string `yaml:"migrations_dir"`
+}
+
+// Mail configures how the server sends email, such as tokens for resetting
+// passwords and verifying email addresses.
+type Mail struct {
+	// Backend is either "file", which writes messages to File for local
+	// development, or "smtp", which sends them through an SMTP server.
+	Backend string `yaml:"backend"`
+	// From is the address that messages are sent from.
+	From string `yaml:"from"`
+	// File is the file that messages are appended to with the "file"
+	// backend. If empty, messages are written to standard error.
+	File string `yaml:"file"`
+	SMTP SMTP   `yaml:"smtp"`
+}
+
+// SMTP configures the SMTP server used by the "smtp" mail backend.
+type SMTP struct {
+	// Address is the host and port of the server, such as
+	// "smtp.example.com:587".
+	Address string `yaml:"address"`
+	// Username and Password are used for authentication, if Username is
+	// set.
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+// Default returns the configuration used for settings that are not set
+// anywhere else.
+func Default() *Config {
+	return &Config{
+		Address:   ":8080",
+		LogLevel:  "info",
+		LogFormat: "text",
+		Database: Database{
+			Backend:       BackendMemory,
+			MigrationsDir: "database",
+		},
+		Mail: Mail{
+			Backend: MailBackendFile,
+			From:    "strecku@localhost",
+		},
+	}
+}
+
+// setting is a single configuration setting that can be set using a flag or
+// an environment variable. Exactly one of field, list and boolean is set; the
+// value of a list setting is comma-separated, and the value of a boolean
+// setting is parsed using strconv.ParseBool.
+type setting struct {
+	flag    string
+	env     string
+	usage   string
+	field   func(*Config) *string
+	list    func(*Config) *[]string
+	boolean func(*Config) *bool
+}
+
+func (s setting) set(c *Config, v string) error {
+	if s.field != nil {
+		*s.field(c) = v
+		return nil
+	}
+	if s.boolean != nil {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+		*s.boolean(c) = b
+		return nil
+	}
+	var list []string
+	for _, e := range strings.Split(v, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			list = append(list, e)
+		}
+	}
+	*s.list(c) = list
+	return nil
+}
+
+// boolFlag is the flag.Value of a boolean setting, which, like flags created
+// using flag.Bool, can be given without a value to set it to true.
+type boolFlag struct {
+	value *string
+}
+
+func (f boolFlag) String() string {
+	if f.value == nil {
+		return ""
+	}
+	return *f.value
+}
+
+func (f boolFlag) Set(v string) error {
+	*f.value = v
+	return nil
+}
+
+func (f boolFlag) IsBoolFlag() bool { return true }
+
+var settings = []setting{
+	{
+		flag:  "address",
+		env:   "STRECKU_ADDRESS",
+		usage: "Address to listen on for gRPC, gRPC-Web and REST requests.",
+		field: func(c *Config) *string { return &c.Address },
+	},
+	{
+		flag:  "admin-address",
+		env:   "STRECKU_ADMIN_ADDRESS",
+		usage: "Address to listen on for HTTP admin endpoints and metrics. If empty, they are not served.",
+		field: func(c *Config) *string { return &c.AdminAddress },
+	},
+	{
+		flag:  "log-level",
+		env:   "STRECKU_LOG_LEVEL",
+		usage: "Minimum level of log messages: debug, info, warn or error.",
+		field: func(c *Config) *string { return &c.LogLevel },
+	},
+	{
+		flag:  "log-format",
+		env:   "STRECKU_LOG_FORMAT",
+		usage: `Format of log messages: "text" or "json".`,
+		field: func(c *Config) *string { return &c.LogFormat },
+	},
+	{
+		flag:  "tls-cert-file",
+		env:   "STRECKU_TLS_CERT_FILE",
+		usage: "PEM file containing the TLS certificate chain of the server.",
+		field: func(c *Config) *string { return &c.TLS.CertFile },
+	},
+	{
+		flag:  "tls-key-file",
+		env:   "STRECKU_TLS_KEY_FILE",
+		usage: "PEM file containing the TLS private key of the server.",
+		field: func(c *Config) *string { return &c.TLS.KeyFile },
+	},
+	{
+		flag:  "tls-client-ca-file",
+		env:   "STRECKU_TLS_CLIENT_CA_FILE",
+		usage: "PEM file containing the CA certificates used to verify client certificates.",
+		field: func(c *Config) *string { return &c.TLS.ClientCAFile },
+	},
+	{
+		flag:  "tls-client-auth",
+		env:   "STRECKU_TLS_CLIENT_AUTH",
+		usage: `Whether client certificates are optional ("request") or mandatory ("require").`,
+		field: func(c *Config) *string { return &c.TLS.ClientAuth },
+	},
+	{
+		flag:  "cors-allowed-origins",
+		env:   "STRECKU_CORS_ALLOWED_ORIGINS",
+		usage: `Comma-separated list of origins allowed to make cross-origin requests, or "*" for any origin.`,
+		list:  func(c *Config) *[]string { return &c.CORS.AllowedOrigins },
+	},
+	{
+		flag:  "database-backend",
+		env:   "STRECKU_DATABASE_BACKEND",
+		usage: `Where to store data: "memory" or "postgres".`,
+		field: func(c *Config) *string { return &c.Database.Backend },
+	},
+	{
+		flag:  "database-dsn",
+		env:   "STRECKU_DATABASE_DSN",
+		usage: "Connection string of the PostgreSQL database.",
+		field: func(c *Config) *string { return &c.Database.DSN },
+	},
+	{
+		flag:    "database-auto-migrate",
+		env:     "STRECKU_DATABASE_AUTO_MIGRATE",
+		usage:   "Whether to apply database migrations at startup.",
+		boolean: func(c *Config) *bool { return &c.Database.AutoMigrate },
+	},
+	{
+		flag:  "database-migrations-dir",
+		env:   "STRECKU_DATABASE_MIGRATIONS_DIR",
+		usage: "Directory containing the database migrations.",
+		field: func(c *Config) *string { return &c.Database.MigrationsDir },
+	},
+	{
+		flag:  "mail-backend",
+		env:   "STRECKU_MAIL_BACKEND",
+		usage: `How to send email: "file" or "smtp".`,
+		field: func(c *Config) *string { return &c.Mail.Backend },
+	},
+	{
+		flag:  "mail-from",
+		env:   "STRECKU_MAIL_FROM",
+		usage: "Address that email is sent from.",
+		field: func(c *Config) *string { return &c.Mail.From },
+	},
+	{
+		flag:  "mail-file",
+		env:   "STRECKU_MAIL_FILE",
+		usage: "File that email is appended to with the file backend. If empty, email is written to standard error.",
+		field: func(c *Config) *string { return &c.Mail.File },
+	},
+	{
+		flag:  "mail-smtp-address",
+		env:   "STRECKU_MAIL_SMTP_ADDRESS",
+		usage: "Host and port of the SMTP server used by the smtp backend.",
+		field: func(c *Config) *string { return &c.Mail.SMTP.Address },
+	},
+	{
+		flag:  "mail-smtp-username",
+		env:   "STRECKU_MAIL_SMTP_USERNAME",
+		usage: "Username for authenticating to the SMTP server.",
+		field: func(c *Config) *string { return &c.Mail.SMTP.Username },
+	},
+	{
+		flag:  "mail-smtp-password",
+		env:   "STRECKU_MAIL_SMTP_PASSWORD",
+		usage: "Password for authenticating to the SMTP server.",
+		field: func(c *Config) *string { return &c.Mail.SMTP.Password },
+	},
+}
+
+// Load builds the configuration from the given command-line arguments,
+// environment and optional configuration file. Settings are taken from the
+// first of these places that sets them:
+//
+//  1. command-line flags,
+//  2. environment variables,
+//  3. the YAML configuration file given by -config or STRECKU_CONFIG,
+//  4. the defaults returned by Default.
+//
+// The returned configuration has been validated. If args contains -help,
+// flag.ErrHelp is returned.
+func Load(name string, args []string, getenv func(string) string) (*Config, error) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	configFile := fs.String("config", "", fmt.Sprintf("YAML configuration file. Can also be set using %s.", EnvConfigFile))
+	values := make(map[string]*string, len(settings))
+	for _, s := range settings {
+		usage := fmt.Sprintf("%s Can also be set using %s.", s.usage, s.env)
+		if s.boolean != nil {
+			values[s.flag] = new(string)
+			fs.Var(boolFlag{value: values[s.flag]}, s.flag, usage)
+			continue
+		}
+		values[s.flag] = fs.String(s.flag, "", usage)
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %q", fs.Args())
+	}
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+
+	cfg := Default()
+	file := getenv(EnvConfigFile)
+	if set["config"] {
+		file = *configFile
+	}
+	if file != "" {
+		if err := readFile(cfg, file); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range settings {
+		if v := getenv(s.env); v != "" {
+			if err := s.set(cfg, v); err != nil {
+				return nil, fmt.Errorf("%s: %w", s.env, err)
+			}
+		}
+		if set[s.flag] {
+			if err := s.set(cfg, *values[s.flag]); err != nil {
+				return nil, fmt.Errorf("-%s: %w", s.flag, err)
+			}
+		}
+	}
+	if cfg.TLS.ClientCAFile != "" && cfg.TLS.ClientAuth == "" {
+		cfg.TLS.ClientAuth = ClientAuthRequest
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func readFile(cfg *Config, name string) error {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && err != io.EOF {
+		return fmt.Errorf("config file %s: %w", name, err)
+	}
+	return nil
+}
+
+// Validate returns an error describing the first invalid setting in c, if any.
+func (c *Config) Validate() error {
+	if _, _, err := net.SplitHostPort(c.Address); err != nil {
+		return fmt.Errorf("address: %w", err)
+	}
+	if c.AdminAddress != "" {
+		if _, _, err := net.SplitHostPort(c.AdminAddress); err != nil {
+			return fmt.Errorf("admin_address: %w", err)
+		}
+	}
+	if _, err := logging.ParseLevel(c.LogLevel); err != nil {
+		return fmt.Errorf("log_level: %w", err)
+	}
+	if _, err := logging.ParseFormat(c.LogFormat); err != nil {
+		return fmt.Errorf("log_format: %w", err)
+	}
+	if err := c.TLS.validate(); err != nil {
+		return fmt.Errorf("tls: %w", err)
+	}
+	if err := c.CORS.validate(); err != nil {
+		return fmt.Errorf("cors: %w", err)
+	}
+	if err := c.Database.validate(); err != nil {
+		return fmt.Errorf("database: %w", err)
+	}
+	if err := c.Mail.validate(); err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+	return nil
+}
+
+// Enabled reports whether the server should use TLS.
+func (t TLS) Enabled() bool {
+	return t.CertFile != "" || t.KeyFile != ""
+}
+
+func (t TLS) validate() error {
+	if !t.Enabled() {
+		if t.ClientCAFile != "" || t.ClientAuth != "" || len(t.ClientIdentities) > 0 || len(t.AdminIdentities) > 0 {
+			return errors.New("client certificates require cert_file and key_file")
+		}
+		return nil
+	}
+	if t.CertFile == "" || t.KeyFile == "" {
+		return errors.New("cert_file and key_file must be set together")
+	}
+	files := []string{t.CertFile, t.KeyFile}
+	if t.ClientCAFile != "" {
+		files = append(files, t.ClientCAFile)
+		switch t.ClientAuth {
+		case ClientAuthRequest, ClientAuthRequire:
+		default:
+			return fmt.Errorf("unknown client_auth %q; want %q or %q", t.ClientAuth, ClientAuthRequest, ClientAuthRequire)
+		}
+	} else if t.ClientAuth != "" || len(t.ClientIdentities) > 0 || len(t.AdminIdentities) > 0 {
+		return errors.New("client_auth, client_identities and admin_identities require client_ca_file")
+	}
+	for _, name := range t.AdminIdentities {
+		if !t.hasIdentity(name) {
+			return fmt.Errorf("admin_identities: %q is not in client_identities", name)
+		}
+	}
+	for _, f := range files {
+		if _, err := os.Stat(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasIdentity reports whether name is the name of an identity in
+// ClientIdentities.
+func (t TLS) hasIdentity(name string) bool {
+	for _, n := range t.ClientIdentities {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowOrigin reports whether cross-origin requests from origin are allowed.
+func (c CORS) AllowOrigin(origin string) bool {
+	for _, o := range c.AllowedOrigins {
+		if o == "*" || o == origin {
+			return true
+		}
+	}
+	return false
+}
+
+func (c CORS) validate() error {
+	for _, o := range c.AllowedOrigins {
+		if o == "*" {
+			continue
+		}
+		u, err := url.Parse(o)
+		if err != nil {
+			return fmt.Errorf("allowed_origins: %w", err)
+		}
+		if u.Scheme == "" || u.Host == "" || u.Path != "" || u.RawQuery != "" || u.Fragment != "" {
+			return fmt.Errorf("allowed_origins: %q is not an origin such as %q", o, "https://example.com")
+		}
+	}
+	return nil
+}
+
+func (d Database) validate() error {
+	switch d.Backend {
+	case BackendMemory:
+		if d.DSN != "" {
+			return fmt.Errorf("dsn is set, but backend is %q", BackendMemory)
+		}
+		if d.AutoMigrate {
+			return fmt.Errorf("auto_migrate is set, but backend is %q", BackendMemory)
+		}
+	case BackendPostgres:
+		if d.DSN == "" {
+			return fmt.Errorf("dsn is required with backend %q", BackendPostgres)
+		}
+		if d.AutoMigrate {
+			if _, err := os.Stat(d.MigrationsDir); err != nil {
+				return fmt.Errorf("migrations_dir: %w", err)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown backend %q; want %q or %q", d.Backend, BackendMemory, BackendPostgres)
+	}
+	return nil
+}
+
+func (m Mail) validate() error {
+	if _, err := mail.ParseAddress(m.From); err != nil {
+		return fmt.Errorf("from: %w", err)
+	}
+	switch m.Backend {
+	case MailBackendFile:
+		if m.SMTP != (SMTP{}) {
+			return fmt.Errorf("smtp is set, but backend is %q", MailBackendFile)
+		}
+	case MailBackendSMTP:
+		if m.File != "" {
+			return fmt.Errorf("file is set, but backend is %q", MailBackendSMTP)
+		}
+		if _, _, err := net.SplitHostPort(m.SMTP.Address); err != nil {
+			return fmt.Errorf("smtp: address: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown backend %q; want %q or %q", m.Backend, MailBackendFile, MailBackendSMTP)
+	}
+	return nil
+}
@@ -0,0 +1,456 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func env(m map[string]string) func(string) string {
+	return func(key string) string { return m[key] }
+}
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certFile := writeFile(t, dir, "cert.pem", "cert")
+	keyFile := writeFile(t, dir, "key.pem", "key")
+	configFile := writeFile(t, dir, "config.yaml", strings.Join([]string{
+		`address: ":9000"`,
+		`admin_address: ":9001"`,
+		`log_level: warn`,
+		`log_format: json`,
+		`tls:`,
+		`  cert_file: ` + certFile,
+		`  key_file: ` + keyFile,
+		`database:`,
+		`  backend: postgres`,
+		`  dsn: postgres://file`,
+		`mail:`,
+		`  backend: smtp`,
+		`  from: StreckU <strecku@example.com>`,
+		`  smtp:`,
+		`    address: smtp.example.com:587`,
+		`    username: strecku`,
+		``,
+	}, "\n"))
+	otherConfigFile := writeFile(t, dir, "other.yaml", "log_level: error\n")
+	caFile := writeFile(t, dir, "ca.pem", "ca")
+	mtlsConfigFile := writeFile(t, dir, "mtls.yaml", strings.Join([]string{
+		`tls:`,
+		`  cert_file: ` + certFile,
+		`  key_file: ` + keyFile,
+		`  client_ca_file: ` + caFile,
+		`  client_identities:`,
+		`    "CN=kiosk-1,O=Bar": kiosk-bar`,
+		`    "CN=alice,O=Admins": alice`,
+		`  admin_identities: [alice]`,
+		``,
+	}, "\n"))
+	fromFile := &Config{
+		Address:      ":9000",
+		AdminAddress: ":9001",
+		LogLevel:     "warn",
+		LogFormat:    "json",
+		TLS:          TLS{CertFile: certFile, KeyFile: keyFile},
+		Database:     Database{Backend: BackendPostgres, DSN: "postgres://file", MigrationsDir: "database"},
+		Mail: Mail{
+			Backend: MailBackendSMTP,
+			From:    "StreckU <strecku@example.com>",
+			SMTP:    SMTP{Address: "smtp.example.com:587", Username: "strecku"},
+		},
+	}
+	for _, test := range []struct {
+		desc string
+		args []string
+		env  map[string]string
+		want *Config
+	}{
+		{
+			desc: "Defaults",
+			args: nil,
+			env:  nil,
+			want: Default(),
+		},
+		{
+			desc: "File",
+			args: []string{"-config", configFile},
+			env:  nil,
+			want: fromFile,
+		},
+		{
+			desc: "FileFromEnv",
+			args: nil,
+			env:  map[string]string{EnvConfigFile: configFile},
+			want: fromFile,
+		},
+		{
+			desc: "FileFlagOverridesEnv",
+			args: []string{"-config", otherConfigFile},
+			env:  map[string]string{EnvConfigFile: configFile},
+			want: func() *Config {
+				cfg := Default()
+				cfg.LogLevel = "error"
+				return cfg
+			}(),
+		},
+		{
+			desc: "EnvOverridesFile",
+			args: []string{"-config", configFile},
+			env: map[string]string{
+				"STRECKU_ADDRESS":      ":9001",
+				"STRECKU_DATABASE_DSN": "postgres://env",
+			},
+			want: func() *Config {
+				cfg := *fromFile
+				cfg.Address = ":9001"
+				cfg.Database.DSN = "postgres://env"
+				return &cfg
+			}(),
+		},
+		{
+			desc: "FlagsOverrideEnv",
+			args: []string{"-config", configFile, "-address", ":9002", "-log-level", "debug"},
+			env: map[string]string{
+				"STRECKU_ADDRESS":   ":9001",
+				"STRECKU_LOG_LEVEL": "error",
+			},
+			want: func() *Config {
+				cfg := *fromFile
+				cfg.Address = ":9002"
+				cfg.LogLevel = "debug"
+				return &cfg
+			}(),
+		},
+		{
+			desc: "EmptyFlagOverridesFile",
+			args: []string{"-config", configFile, "-tls-cert-file", "", "-tls-key-file", ""},
+			env:  nil,
+			want: func() *Config {
+				cfg := *fromFile
+				cfg.TLS = TLS{}
+				return &cfg
+			}(),
+		},
+		{
+			desc: "AutoMigrate",
+			args: []string{"-config", configFile, "-database-auto-migrate", "-database-migrations-dir", dir},
+			env:  nil,
+			want: func() *Config {
+				cfg := *fromFile
+				cfg.Database.AutoMigrate = true
+				cfg.Database.MigrationsDir = dir
+				return &cfg
+			}(),
+		},
+		{
+			desc: "AutoMigrateFromEnv",
+			args: []string{"-config", configFile},
+			env: map[string]string{
+				"STRECKU_DATABASE_AUTO_MIGRATE":   "true",
+				"STRECKU_DATABASE_MIGRATIONS_DIR": dir,
+			},
+			want: func() *Config {
+				cfg := *fromFile
+				cfg.Database.AutoMigrate = true
+				cfg.Database.MigrationsDir = dir
+				return &cfg
+			}(),
+		},
+		{
+			desc: "AutoMigrateFlagOverridesEnv",
+			args: []string{"-config", configFile, "-database-auto-migrate=false"},
+			env:  map[string]string{"STRECKU_DATABASE_AUTO_MIGRATE": "true"},
+			want: fromFile,
+		},
+		{
+			desc: "CORSFromEnv",
+			args: nil,
+			env:  map[string]string{"STRECKU_CORS_ALLOWED_ORIGINS": "https://a.example.com, https://b.example.com,"},
+			want: func() *Config {
+				cfg := Default()
+				cfg.CORS.AllowedOrigins = []string{"https://a.example.com", "https://b.example.com"}
+				return cfg
+			}(),
+		},
+		{
+			desc: "MailFromEnv",
+			args: []string{"-config", configFile},
+			env:  map[string]string{"STRECKU_MAIL_SMTP_PASSWORD": "secret"},
+			want: func() *Config {
+				cfg := *fromFile
+				cfg.Mail.SMTP.Password = "secret"
+				return &cfg
+			}(),
+		},
+		{
+			desc: "MailFile",
+			args: []string{"-mail-file", filepath.Join(dir, "mail.txt")},
+			env:  nil,
+			want: func() *Config {
+				cfg := Default()
+				cfg.Mail.File = filepath.Join(dir, "mail.txt")
+				return cfg
+			}(),
+		},
+		{
+			desc: "ClientCertificates",
+			args: []string{"-config", mtlsConfigFile},
+			env:  nil,
+			want: func() *Config {
+				cfg := Default()
+				cfg.TLS = TLS{
+					CertFile:         certFile,
+					KeyFile:          keyFile,
+					ClientCAFile:     caFile,
+					ClientAuth:       ClientAuthRequest,
+					ClientIdentities: map[string]string{"CN=kiosk-1,O=Bar": "kiosk-bar", "CN=alice,O=Admins": "alice"},
+					AdminIdentities:  []string{"alice"},
+				}
+				return cfg
+			}(),
+		},
+		{
+			desc: "ClientAuthFromEnv",
+			args: []string{"-config", mtlsConfigFile},
+			env:  map[string]string{"STRECKU_TLS_CLIENT_AUTH": "require"},
+			want: func() *Config {
+				cfg := Default()
+				cfg.TLS = TLS{
+					CertFile:         certFile,
+					KeyFile:          keyFile,
+					ClientCAFile:     caFile,
+					ClientAuth:       ClientAuthRequire,
+					ClientIdentities: map[string]string{"CN=kiosk-1,O=Bar": "kiosk-bar", "CN=alice,O=Admins": "alice"},
+					AdminIdentities:  []string{"alice"},
+				}
+				return cfg
+			}(),
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := Load("server", test.args, env(test.env))
+			if err != nil {
+				t.Fatalf("Load(%q, %q, env) err = %v; want nil", "server", test.args, err)
+			}
+			if diff := cmp.Diff(got, test.want); diff != "" {
+				t.Errorf("Load(%q, %q, env) = (-got +want)\n%s", "server", test.args, diff)
+			}
+		})
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	unknownField := writeFile(t, dir, "unknown.yaml", "adress: \":9000\"\n")
+	certFile := writeFile(t, dir, "cert.pem", "cert")
+	keyFile := writeFile(t, dir, "key.pem", "key")
+	tlsArgs := []string{"-tls-cert-file", certFile, "-tls-key-file", keyFile}
+	unknownAdmin := writeFile(t, dir, "admin.yaml", strings.Join([]string{
+		`tls:`,
+		`  cert_file: ` + certFile,
+		`  key_file: ` + keyFile,
+		`  client_ca_file: ` + certFile,
+		`  client_identities:`,
+		`    "CN=kiosk-1,O=Bar": kiosk-bar`,
+		`  admin_identities: [alice]`,
+		``,
+	}, "\n"))
+	for _, test := range []struct {
+		desc    string
+		args    []string
+		wantErr string
+	}{
+		{
+			desc:    "UnknownFlag",
+			args:    []string{"-port", "8080"},
+			wantErr: "flag provided but not defined: -port",
+		},
+		{
+			desc:    "UnexpectedArguments",
+			args:    []string{"serve"},
+			wantErr: `unexpected arguments: ["serve"]`,
+		},
+		{
+			desc:    "MissingConfigFile",
+			args:    []string{"-config", filepath.Join(dir, "missing.yaml")},
+			wantErr: "config file: open ",
+		},
+		{
+			desc:    "UnknownConfigField",
+			args:    []string{"-config", unknownField},
+			wantErr: "field adress not found",
+		},
+		{
+			desc:    "InvalidAddress",
+			args:    []string{"-address", "8080"},
+			wantErr: "address: ",
+		},
+		{
+			desc:    "InvalidAdminAddress",
+			args:    []string{"-admin-address", "9090"},
+			wantErr: "admin_address: ",
+		},
+		{
+			desc:    "InvalidLogLevel",
+			args:    []string{"-log-level", "verbose"},
+			wantErr: `log_level: unknown log level "verbose"`,
+		},
+		{
+			desc:    "InvalidLogFormat",
+			args:    []string{"-log-format", "logfmt"},
+			wantErr: `log_format: unknown log format "logfmt"`,
+		},
+		{
+			desc:    "TLSKeyWithoutCert",
+			args:    []string{"-tls-key-file", "key.pem"},
+			wantErr: "tls: cert_file and key_file must be set together",
+		},
+		{
+			desc:    "TLSMissingFile",
+			args:    []string{"-tls-cert-file", filepath.Join(dir, "missing.pem"), "-tls-key-file", keyFile},
+			wantErr: "tls: stat ",
+		},
+		{
+			desc:    "ClientCAWithoutTLS",
+			args:    []string{"-tls-client-ca-file", certFile},
+			wantErr: "tls: client certificates require cert_file and key_file",
+		},
+		{
+			desc:    "ClientAuthWithoutClientCA",
+			args:    append(tlsArgs, "-tls-client-auth", "require"),
+			wantErr: "tls: client_auth, client_identities and admin_identities require client_ca_file",
+		},
+		{
+			desc:    "UnknownAdminIdentity",
+			args:    []string{"-config", unknownAdmin},
+			wantErr: `tls: admin_identities: "alice" is not in client_identities`,
+		},
+		{
+			desc:    "UnknownClientAuth",
+			args:    append(tlsArgs, "-tls-client-ca-file", certFile, "-tls-client-auth", "optional"),
+			wantErr: `tls: unknown client_auth "optional"`,
+		},
+		{
+			desc:    "MissingClientCAFile",
+			args:    append(tlsArgs, "-tls-client-ca-file", filepath.Join(dir, "missing.pem")),
+			wantErr: "tls: stat ",
+		},
+		{
+			desc:    "InvalidOrigin",
+			args:    []string{"-cors-allowed-origins", "https://example.com/kiosk"},
+			wantErr: `cors: allowed_origins: "https://example.com/kiosk" is not an origin`,
+		},
+		{
+			desc:    "InvalidBoolean",
+			args:    []string{"-database-auto-migrate=maybe"},
+			wantErr: `-database-auto-migrate: strconv.ParseBool: parsing "maybe": invalid syntax`,
+		},
+		{
+			desc:    "AutoMigrateWithMemory",
+			args:    []string{"-database-auto-migrate"},
+			wantErr: `database: auto_migrate is set, but backend is "memory"`,
+		},
+		{
+			desc:    "MissingMigrationsDir",
+			args:    []string{"-database-backend", "postgres", "-database-dsn", "postgres://", "-database-auto-migrate", "-database-migrations-dir", filepath.Join(dir, "missing")},
+			wantErr: "database: migrations_dir: stat ",
+		},
+		{
+			desc:    "UnknownBackend",
+			args:    []string{"-database-backend", "mysql"},
+			wantErr: `database: unknown backend "mysql"`,
+		},
+		{
+			desc:    "PostgresWithoutDSN",
+			args:    []string{"-database-backend", "postgres"},
+			wantErr: `database: dsn is required with backend "postgres"`,
+		},
+		{
+			desc:    "MemoryWithDSN",
+			args:    []string{"-database-dsn", "postgres://"},
+			wantErr: `database: dsn is set, but backend is "memory"`,
+		},
+		{
+			desc:    "InvalidMailFrom",
+			args:    []string{"-mail-from", "strecku"},
+			wantErr: "mail: from: ",
+		},
+		{
+			desc:    "UnknownMailBackend",
+			args:    []string{"-mail-backend", "sendmail"},
+			wantErr: `mail: unknown backend "sendmail"`,
+		},
+		{
+			desc:    "SMTPWithoutAddress",
+			args:    []string{"-mail-backend", "smtp"},
+			wantErr: "mail: smtp: address: ",
+		},
+		{
+			desc:    "SMTPWithFile",
+			args:    []string{"-mail-backend", "smtp", "-mail-smtp-address", "localhost:25", "-mail-file", "mail.txt"},
+			wantErr: `mail: file is set, but backend is "smtp"`,
+		},
+		{
+			desc:    "FileWithSMTP",
+			args:    []string{"-mail-smtp-address", "localhost:25"},
+			wantErr: `mail: smtp is set, but backend is "file"`,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := Load("server", test.args, env(nil))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("Load(%q, %q, env) err = %v; want error containing %q", "server", test.args, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestCORS_AllowOrigin(t *testing.T) {
+	for _, test := range []struct {
+		desc   string
+		cors   CORS
+		origin string
+		want   bool
+	}{
+		{desc: "None", cors: CORS{}, origin: "https://example.com", want: false},
+		{desc: "Listed", cors: CORS{AllowedOrigins: []string{"https://a.example.com", "https://example.com"}}, origin: "https://example.com", want: true},
+		{desc: "NotListed", cors: CORS{AllowedOrigins: []string{"https://a.example.com"}}, origin: "https://example.com", want: false},
+		{desc: "DifferentScheme", cors: CORS{AllowedOrigins: []string{"https://example.com"}}, origin: "http://example.com", want: false},
+		{desc: "Any", cors: CORS{AllowedOrigins: []string{"*"}}, origin: "https://example.com", want: true},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			if got := test.cors.AllowOrigin(test.origin); got != test.want {
+				t.Errorf("%+v.AllowOrigin(%q) = %v; want %v", test.cors, test.origin, got, test.want)
+			}
+		})
+	}
+}
+
+func TestLoad_Help(t *testing.T) {
+	if _, err := Load("server", []string{"-help"}, env(nil)); !errors.Is(err, flag.ErrHelp) {
+		t.Errorf("Load(%q, -help, env) err = %v; want %v", "server", err, flag.ErrHelp)
+	}
+}
@@ -0,0 +1,145 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
+
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+)
+
+// migrationsLockID is the key of the advisory lock held by MigrateUp.
+const migrationsLockID = 0x5354524543 // "STREC"
+
+// Migrator applies the migrations in a directory, such as the database
+// directory of this repository, to a PostgreSQL database.
+type Migrator struct {
+	db *sql.DB
+	m  *migrate.Migrate
+}
+
+// NewMigrator returns a Migrator for the database with the given connection
+// string, using the migrations in dir. The Migrator has its own connection to
+// the database, which is closed by Close.
+func NewMigrator(ctx context.Context, connString, dir string) (*Migrator, error) {
+	db, err := Open(ctx, connString)
+	if err != nil {
+		return nil, err
+	}
+	srcInstance, err := source.Open("file://" + dir)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("open migrations: %w", err)
+	}
+	dbInstance, err := postgres.WithInstance(db, new(postgres.Config))
+	if err != nil {
+		srcInstance.Close()
+		db.Close()
+		return nil, err
+	}
+	m, err := migrate.NewWithInstance("file", srcInstance, "postgres", dbInstance)
+	if err != nil {
+		srcInstance.Close()
+		dbInstance.Close()
+		return nil, err
+	}
+	return &Migrator{db: db, m: m}, nil
+}
+
+// Up applies all migrations that have not been applied.
+func (m *Migrator) Up() error {
+	if err := m.m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// Down rolls back the given number of the most recently applied migrations.
+func (m *Migrator) Down(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("number of migrations to roll back is %d; want a positive number", n)
+	}
+	return m.m.Steps(-n)
+}
+
+// Goto applies or rolls back migrations until the schema has the given
+// version.
+func (m *Migrator) Goto(version uint) error {
+	if err := m.m.Migrate(version); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// Force sets the version of the schema without applying any migrations, and
+// clears the dirty flag. It is used to recover after a migration failed
+// halfway, once the schema has been repaired by hand.
+func (m *Migrator) Force(version int) error {
+	return m.m.Force(version)
+}
+
+// Version returns the version of the schema, which is zero if no migrations
+// have been applied. If dirty is true, the last migration failed and the
+// schema must be repaired by hand.
+func (m *Migrator) Version() (version uint, dirty bool, err error) {
+	version, dirty, err = m.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+// Close closes the connection to the database and the migrations.
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.m.Close()
+	if srcErr != nil {
+		return srcErr
+	}
+	return dbErr
+}
+
+// MigrateUp applies all migrations in dir that have not been applied to the
+// database with the given connection string, and returns the resulting
+// version of the schema. While migrating, an advisory lock is held using db,
+// so that servers that start at the same time migrate one at a time; the
+// servers that wait for the lock find that there is nothing left to do.
+// Waiting for the lock can be cancelled using ctx.
+func MigrateUp(ctx context.Context, db *sql.DB, connString, dir string) (version uint, err error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationsLockID); err != nil {
+		return 0, fmt.Errorf("acquire migrations lock: %w", err)
+	}
+	defer func() {
+		// The lock belongs to the session, which outlives conn in the
+		// connection pool of db, so it must be released explicitly.
+		if _, uErr := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationsLockID); uErr != nil && err == nil {
+			err = fmt.Errorf("release migrations lock: %w", uErr)
+		}
+	}()
+	m, err := NewMigrator(ctx, connString, dir)
+	if err != nil {
+		return 0, err
+	}
+	defer m.Close()
+	if err := m.Up(); err != nil {
+		return 0, err
+	}
+	var dirty bool
+	version, dirty, err = m.Version()
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("schema version %d is dirty", version)
+	}
+	return version, nil
+}
@@ -0,0 +1,106 @@
+package database_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Saser/strecku/internal/database"
+	"github.com/Saser/strecku/internal/testdatabase"
+	"golang.org/x/sync/errgroup"
+)
+
+const migrationsPath = "../../database"
+
+func TestMigrator(t *testing.T) {
+	if testing.Short() {
+		t.Skipf("skipping: -short is set")
+	}
+	ctx := context.Background()
+	connString := testdatabase.ConnString(ctx, t, migrationsPath)
+	m, err := database.NewMigrator(ctx, connString, migrationsPath)
+	if err != nil {
+		t.Fatalf("NewMigrator(...) err = %v; want nil", err)
+	}
+	defer func() {
+		if err := m.Close(); err != nil {
+			t.Errorf("m.Close() = %v; want nil", err)
+		}
+	}()
+	checkVersion := func(want uint) {
+		t.Helper()
+		got, dirty, err := m.Version()
+		if err != nil {
+			t.Fatalf("m.Version() err = %v; want nil", err)
+		}
+		if got != want || dirty {
+			t.Errorf("m.Version() = %d, %v; want %d, false", got, dirty, want)
+		}
+	}
+	checkVersion(2)
+	if err := m.Down(1); err != nil {
+		t.Fatalf("m.Down(1) = %v; want nil", err)
+	}
+	checkVersion(1)
+	if err := m.Up(); err != nil {
+		t.Fatalf("m.Up() = %v; want nil", err)
+	}
+	checkVersion(2)
+	// Up is a no-op when all migrations have been applied.
+	if err := m.Up(); err != nil {
+		t.Fatalf("m.Up() = %v; want nil", err)
+	}
+	if err := m.Goto(1); err != nil {
+		t.Fatalf("m.Goto(1) = %v; want nil", err)
+	}
+	checkVersion(1)
+	if err := m.Down(1); err != nil {
+		t.Fatalf("m.Down(1) = %v; want nil", err)
+	}
+	checkVersion(0)
+	if err := m.Down(0); err == nil {
+		t.Error("m.Down(0) = nil; want non-nil")
+	}
+}
+
+func TestMigrateUp_Concurrent(t *testing.T) {
+	if testing.Short() {
+		t.Skipf("skipping: -short is set")
+	}
+	ctx := context.Background()
+	connString := testdatabase.ConnString(ctx, t, migrationsPath)
+	// Start from an empty schema.
+	m, err := database.NewMigrator(ctx, connString, migrationsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Down(2); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := database.Open(ctx, connString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	var g errgroup.Group
+	versions := make([]uint, 3)
+	for i := range versions {
+		i := i
+		g.Go(func() error {
+			var err error
+			versions[i], err = database.MigrateUp(ctx, db, connString, migrationsPath)
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		t.Fatalf("MigrateUp(...) err = %v; want nil", err)
+	}
+	for i, v := range versions {
+		if v != 2 {
+			t.Errorf("versions[%d] = %d; want 2", i, v)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultHistorySize is the number of events that a bus keeps for
+	// subscriptions that resume from a sequence number.
+	DefaultHistorySize = 1000
+	// DefaultBufferSize is the number of events that a subscription may
+	// fall behind before it is ended.
+	DefaultBufferSize = 100
+)
+
+var (
+	// ErrSlowConsumer ends subscriptions that fall more than the buffer size
+	// of the bus behind. Publishing never blocks on subscribers; a
+	// subscriber that is ended this way can resume from the sequence number
+	// of the last event it received.
+	ErrSlowConsumer = errors.New("events: subscriber fell too far behind")
+	// ErrClosed ends subscriptions that were closed by the subscriber.
+	ErrClosed = errors.New("events: subscription closed")
+)
+
+// SequenceError is returned when subscribing with a sequence number that is
+// no longer in the history of a bus, or that is later than the latest event.
+type SequenceError struct {
+	Sequence uint64
+	Oldest   uint64 // the oldest sequence number that can be resumed from
+	Latest   uint64 // the sequence number of the latest event
+}
+
+func (e *SequenceError) Error() string {
+	return fmt.Sprintf("events: cannot resume from sequence %d; want a sequence in [%d, %d]", e.Sequence, e.Oldest, e.Latest)
+}
+
+func (e *SequenceError) Is(target error) bool {
+	other, ok := target.(*SequenceError)
+	return ok && *e == *other
+}
+
+// Type is the kind of change that an event describes.
+type Type int
+
+const (
+	Created Type = iota + 1
+	Updated
+	Deleted
+)
+
+var typeNames = []string{
+	Created: "created",
+	Updated: "updated",
+	Deleted: "deleted",
+}
+
+func (t Type) String() string {
+	if t <= 0 || int(t) >= len(typeNames) {
+		return fmt.Sprintf("Type(%d)", int(t))
+	}
+	return typeNames[t]
+}
+
+// Event describes a change of a resource.
+type Event struct {
+	// Sequence is the position of the event on its bus, starting from 1.
+	Sequence uint64
+	Type     Type
+	// Name is the resource name of the changed resource.
+	Name string
+	// Resource is the resource after the change, or for Deleted events,
+	// the resource before it was deleted. Subscribers must not modify it.
+	Resource proto.Message
+	// Previous is the resource before the change for Updated events, and nil
+	// otherwise. Subscribers must not modify it.
+	Previous proto.Message
+}
+
+// Bus distributes events from publishers to subscribers in the same process.
+// Events are numbered in the order they are published, and the latest events
+// are kept so that subscribers can resume where they left off.
+type Bus struct {
+	historySize int
+	bufferSize  int
+
+	mu      sync.Mutex
+	latest  uint64  // sequence number of the latest event
+	history []Event // the latest events, oldest first
+	subs    map[*Subscription]struct{}
+}
+
+// NewBus returns a bus that keeps the latest historySize events, and whose
+// subscriptions may fall bufferSize events behind.
+func NewBus(historySize, bufferSize int) *Bus {
+	return &Bus{
+		historySize: historySize,
+		bufferSize:  bufferSize,
+		subs:        make(map[*Subscription]struct{}),
+	}
+}
+
+// Latest returns the sequence number of the latest event, or 0 if no events
+// have been published.
+func (b *Bus) Latest() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.latest
+}
+
+// Publish publishes e with the next sequence number, which it returns. The
+// Sequence field of e is ignored. Publish does not block on subscribers.
+func (b *Bus) Publish(e Event) uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.latest++
+	e.Sequence = b.latest
+	if b.historySize > 0 {
+		if len(b.history) == b.historySize {
+			copy(b.history, b.history[1:])
+			b.history = b.history[:len(b.history)-1]
+		}
+		b.history = append(b.history, e)
+	}
+	for s := range b.subs {
+		if s.filter != nil && !s.filter(e) {
+			continue
+		}
+		select {
+		case s.c <- e:
+		default:
+			b.end(s, ErrSlowConsumer)
+		}
+	}
+	return e.Sequence
+}
+
+// Subscribe returns a subscription to the events for which filter returns
+// true, or all events if filter is nil. If after is 0, the subscription
+// receives the events published from now on; otherwise it first receives the
+// events after that sequence number. Subscribe returns a *SequenceError if
+// those events are no longer kept, or if after is later than the latest
+// event.
+func (b *Bus) Subscribe(after uint64, filter func(Event) bool) (*Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var replay []Event
+	if after > 0 {
+		oldest := b.latest + 1
+		if len(b.history) > 0 {
+			oldest = b.history[0].Sequence
+		}
+		// Resuming from the event just before the oldest kept one loses
+		// nothing.
+		if after+1 < oldest || after > b.latest {
+			return nil, &SequenceError{Sequence: after, Oldest: oldest - 1, Latest: b.latest}
+		}
+		for _, e := range b.history {
+			if e.Sequence > after && (filter == nil || filter(e)) {
+				replay = append(replay, e)
+			}
+		}
+	}
+	s := &Subscription{
+		bus:    b,
+		filter: filter,
+		c:      make(chan Event, len(replay)+b.bufferSize),
+	}
+	for _, e := range replay {
+		s.c <- e
+	}
+	b.subs[s] = struct{}{}
+	return s, nil
+}
+
+// end ends s with err. b.mu must be held.
+func (b *Bus) end(s *Subscription, err error) {
+	if _, ok := b.subs[s]; !ok {
+		return
+	}
+	delete(b.subs, s)
+	s.err = err
+	close(s.c)
+}
+
+// Subscription receives events from a bus.
+type Subscription struct {
+	bus    *Bus
+	filter func(Event) bool
+	c      chan Event
+	err    error // guarded by bus.mu
+}
+
+// Events returns the channel on which the events are received. The channel is
+// closed when the subscription ends, after which Err reports why.
+func (s *Subscription) Events() <-chan Event {
+	return s.c
+}
+
+// Err returns ErrSlowConsumer or ErrClosed after the subscription has ended,
+// and nil before.
+func (s *Subscription) Err() error {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	return s.err
+}
+
+// Close ends the subscription with ErrClosed, unless it has already ended.
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	s.bus.end(s, ErrClosed)
+}
@@ -0,0 +1,153 @@
+package events
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+)
+
+// receive returns the events that are immediately available on s.
+func receive(t *testing.T, s *Subscription) []uint64 {
+	t.Helper()
+	var seqs []uint64
+	for {
+		select {
+		case e, ok := <-s.Events():
+			if !ok {
+				return seqs
+			}
+			seqs = append(seqs, e.Sequence)
+		default:
+			return seqs
+		}
+	}
+}
+
+func equal(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func publish(b *Bus, names ...string) {
+	for _, name := range names {
+		b.Publish(Event{Type: Created, Name: name, Resource: &pb.Store{Name: name}})
+	}
+}
+
+func TestBus_Subscribe(t *testing.T) {
+	b := NewBus(DefaultHistorySize, DefaultBufferSize)
+	publish(b, "stores/a")
+	s, err := b.Subscribe(0, nil)
+	if err != nil {
+		t.Fatalf("Subscribe(0, nil) = %v; want nil", err)
+	}
+	defer s.Close()
+	publish(b, "stores/b", "stores/c")
+	if got, want := receive(t, s), []uint64{2, 3}; !equal(got, want) {
+		t.Errorf("received %v; want %v", got, want)
+	}
+	if got, want := b.Latest(), uint64(3); got != want {
+		t.Errorf("Latest() = %d; want %d", got, want)
+	}
+}
+
+func TestBus_Subscribe_Resume(t *testing.T) {
+	b := NewBus(DefaultHistorySize, DefaultBufferSize)
+	publish(b, "stores/a", "stores/b", "stores/a")
+	onlyA := func(e Event) bool { return e.Name == "stores/a" }
+	s, err := b.Subscribe(1, onlyA)
+	if err != nil {
+		t.Fatalf("Subscribe(1, onlyA) = %v; want nil", err)
+	}
+	defer s.Close()
+	publish(b, "stores/b", "stores/a")
+	if got, want := receive(t, s), []uint64{3, 5}; !equal(got, want) {
+		t.Errorf("received %v; want %v", got, want)
+	}
+}
+
+func TestBus_Subscribe_SequenceError(t *testing.T) {
+	b := NewBus(2, DefaultBufferSize)
+	publish(b, "stores/a", "stores/b", "stores/c", "stores/d")
+	// Events 3 and 4 are kept, so sequences 2 through 4 can be resumed from.
+	for _, after := range []uint64{2, 3, 4} {
+		s, err := b.Subscribe(after, nil)
+		if err != nil {
+			t.Errorf("Subscribe(%d, nil) = %v; want nil", after, err)
+			continue
+		}
+		s.Close()
+	}
+	for _, after := range []uint64{1, 5} {
+		want := &SequenceError{Sequence: after, Oldest: 2, Latest: 4}
+		if _, err := b.Subscribe(after, nil); !errors.Is(err, want) {
+			t.Errorf("Subscribe(%d, nil) = %v; want %v", after, err, want)
+		}
+	}
+}
+
+func TestBus_SlowConsumer(t *testing.T) {
+	b := NewBus(DefaultHistorySize, 2)
+	slow, err := b.Subscribe(0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fast, err := b.Subscribe(0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fast.Close()
+	publish(b, "stores/a", "stores/b")
+	if got, want := receive(t, fast), []uint64{1, 2}; !equal(got, want) {
+		t.Errorf("fast received %v; want %v", got, want)
+	}
+	publish(b, "stores/c")
+	// The slow subscriber still receives the events that fit in its buffer
+	// before its subscription ends.
+	if got, want := receive(t, slow), []uint64{1, 2}; !equal(got, want) {
+		t.Errorf("slow received %v; want %v", got, want)
+	}
+	if got, want := slow.Err(), ErrSlowConsumer; got != want {
+		t.Errorf("slow.Err() = %v; want %v", got, want)
+	}
+	if err := fast.Err(); err != nil {
+		t.Errorf("fast.Err() = %v; want nil", err)
+	}
+	// The slow subscriber can resume from the last event it received.
+	resumed, err := b.Subscribe(2, nil)
+	if err != nil {
+		t.Fatalf("Subscribe(2, nil) = %v; want nil", err)
+	}
+	defer resumed.Close()
+	if got, want := receive(t, resumed), []uint64{3}; !equal(got, want) {
+		t.Errorf("resumed received %v; want %v", got, want)
+	}
+}
+
+func TestSubscription_Close(t *testing.T) {
+	b := NewBus(DefaultHistorySize, DefaultBufferSize)
+	s, err := b.Subscribe(0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Err(); err != nil {
+		t.Errorf("Err() before Close = %v; want nil", err)
+	}
+	s.Close()
+	s.Close()
+	if _, ok := <-s.Events(); ok {
+		t.Error("Events() is open after Close")
+	}
+	if got, want := s.Err(), ErrClosed; got != want {
+		t.Errorf("Err() after Close = %v; want %v", got, want)
+	}
+	publish(b, "stores/a")
+}
@@ -0,0 +1,301 @@
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/resources/stores/payments"
+	"github.com/Saser/strecku/resources/stores/products"
+	"github.com/Saser/strecku/resources/stores/purchases"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrEndBeforeStart = errors.New("end time is before start time")
+
+type UnknownColumnError struct {
+	Column string
+}
+
+func (e *UnknownColumnError) Error() string {
+	return fmt.Sprintf("unknown column: %q", e.Column)
+}
+
+func (e *UnknownColumnError) Is(target error) bool {
+	other, ok := target.(*UnknownColumnError)
+	return ok && e.Column == other.Column
+}
+
+type DuplicateColumnError struct {
+	Column string
+}
+
+func (e *DuplicateColumnError) Error() string {
+	return fmt.Sprintf("duplicate column: %q", e.Column)
+}
+
+func (e *DuplicateColumnError) Is(target error) bool {
+	other, ok := target.(*DuplicateColumnError)
+	return ok && e.Column == other.Column
+}
+
+// Row is a single exported purchase line or payment.
+type Row struct {
+	Type               string // "purchase" or "payment"
+	CreateTime         *timestamppb.Timestamp
+	Name               string
+	User               string
+	UserEmailAddress   string
+	Description        string
+	Product            string
+	ProductDisplayName string
+	Quantity           int32
+	PriceCents         int64
+	AmountCents        int64
+	LineKind           string // such as "charge" or "refund"; empty for payments
+}
+
+var columns = map[string]func(Row) string{
+	"type": func(r Row) string { return r.Type },
+	"create_time": func(r Row) string {
+		if r.CreateTime == nil {
+			return ""
+		}
+		return r.CreateTime.AsTime().UTC().Format(time.RFC3339)
+	},
+	"name":                 func(r Row) string { return r.Name },
+	"user":                 func(r Row) string { return r.User },
+	"user_email_address":   func(r Row) string { return r.UserEmailAddress },
+	"description":          func(r Row) string { return r.Description },
+	"product":              func(r Row) string { return r.Product },
+	"product_display_name": func(r Row) string { return r.ProductDisplayName },
+	"quantity":             func(r Row) string { return strconv.FormatInt(int64(r.Quantity), 10) },
+	"price_cents":          func(r Row) string { return strconv.FormatInt(r.PriceCents, 10) },
+	"amount_cents":         func(r Row) string { return strconv.FormatInt(r.AmountCents, 10) },
+	"line_kind":            func(r Row) string { return r.LineKind },
+}
+
+// DefaultColumns contains all available columns in their default order.
+var DefaultColumns = []string{
+	"type",
+	"create_time",
+	"name",
+	"user",
+	"user_email_address",
+	"description",
+	"product",
+	"product_display_name",
+	"quantity",
+	"price_cents",
+	"amount_cents",
+	"line_kind",
+}
+
+// ValidateColumns returns an error if any of the given columns is unknown or
+// occurs more than once.
+func ValidateColumns(cols []string) error {
+	seen := make(map[string]bool, len(cols))
+	for _, col := range cols {
+		if _, ok := columns[col]; !ok {
+			return &UnknownColumnError{Column: col}
+		}
+		if seen[col] {
+			return &DuplicateColumnError{Column: col}
+		}
+		seen[col] = true
+	}
+	return nil
+}
+
+// Exporter collects the purchases and payments of a store into rows.
+type Exporter struct {
+	userRepo     repositories.Users
+	productRepo  *products.Repository
+	purchaseRepo *purchases.Repository
+	paymentRepo  *payments.Repository
+}
+
+func NewExporter(
+	userRepo repositories.Users,
+	productRepo *products.Repository,
+	purchaseRepo *purchases.Repository,
+	paymentRepo *payments.Repository,
+) *Exporter {
+	return &Exporter{
+		userRepo:     userRepo,
+		productRepo:  productRepo,
+		purchaseRepo: purchaseRepo,
+		paymentRepo:  paymentRepo,
+	}
+}
+
+// Rows returns one row per purchase line and per payment made in the given
+// store during the half-open interval [start, end), ordered by time. A zero
+// start or end means that the interval is unbounded in that direction.
+// Purchases and payments without a create time are only included if start is
+// zero.
+func (e *Exporter) Rows(ctx context.Context, store string, start, end time.Time) ([]Row, error) {
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return nil, ErrEndBeforeStart
+	}
+	include := func(ts *timestamppb.Timestamp) bool {
+		var t time.Time
+		if ts != nil {
+			t = ts.AsTime()
+		}
+		if !start.IsZero() && (ts == nil || t.Before(start)) {
+			return false
+		}
+		return end.IsZero() || t.Before(end)
+	}
+	allPurchases, err := e.purchaseRepo.FilterPurchases(ctx, func(purchase *pb.Purchase) bool {
+		parent, err := purchases.Parent(purchase.Name)
+		if err != nil {
+			return false
+		}
+		return parent == store && include(purchase.CreateTime) && !purchases.Voided(purchase)
+	})
+	if err != nil {
+		return nil, err
+	}
+	allPayments, err := e.paymentRepo.FilterPayments(ctx, func(payment *pb.Payment) bool {
+		parent, err := payments.Parent(payment.Name)
+		if err != nil {
+			return false
+		}
+		return parent == store && include(payment.CreateTime)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make(map[string]string)       // user name -> email address
+	displayNames := make(map[string]string) // product name -> display name
+	emailAddress := func(user string) (string, error) {
+		if email, ok := emails[user]; ok {
+			return email, nil
+		}
+		u, err := e.userRepo.Lookup(ctx, user)
+		if err != nil {
+			// The user may have been deleted after making the
+			// purchase or payment, in which case the email
+			// address is left empty.
+			if notFound := new(repositories.NotFound); errors.As(err, &notFound) {
+				emails[user] = ""
+				return "", nil
+			}
+			return "", err
+		}
+		emails[user] = u.EmailAddress
+		return u.EmailAddress, nil
+	}
+	productDisplayName := func(product string) (string, error) {
+		if product == "" {
+			return "", nil
+		}
+		if displayName, ok := displayNames[product]; ok {
+			return displayName, nil
+		}
+		p, err := e.productRepo.LookupProduct(ctx, product)
+		if err != nil {
+			if notFound := new(products.NotFoundError); errors.As(err, &notFound) {
+				displayNames[product] = ""
+				return "", nil
+			}
+			return "", err
+		}
+		displayNames[product] = p.DisplayName
+		return p.DisplayName, nil
+	}
+
+	var rows []Row
+	for _, purchase := range allPurchases {
+		email, err := emailAddress(purchase.User)
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range purchase.Lines {
+			displayName, err := productDisplayName(line.Product)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, Row{
+				Type:               "purchase",
+				CreateTime:         purchase.CreateTime,
+				Name:               purchase.Name,
+				User:               purchase.User,
+				UserEmailAddress:   email,
+				Description:        line.Description,
+				Product:            line.Product,
+				ProductDisplayName: displayName,
+				Quantity:           line.Quantity,
+				PriceCents:         line.PriceCents,
+				AmountCents:        int64(line.Quantity) * line.PriceCents,
+				LineKind:           strings.ToLower(purchases.LineKind(line).String()),
+			})
+		}
+	}
+	for _, payment := range allPayments {
+		email, err := emailAddress(payment.User)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, Row{
+			Type:             "payment",
+			CreateTime:       payment.CreateTime,
+			Name:             payment.Name,
+			User:             payment.User,
+			UserEmailAddress: email,
+			Description:      payment.Description,
+			Quantity:         1,
+			PriceCents:       payment.AmountCents,
+			AmountCents:      payment.AmountCents,
+		})
+	}
+	// Lines in the same purchase share both time and name, so the sort must
+	// be stable to keep them in their original order.
+	sort.SliceStable(rows, func(i, j int) bool {
+		ti, tj := rows[i].CreateTime.AsTime(), rows[j].CreateTime.AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return rows[i].Name < rows[j].Name
+	})
+	return rows, nil
+}
+
+// WriteCSV writes the given rows to w as CSV as specified by RFC 4180, with
+// the given columns in order. The first record is a header containing the
+// column names. If cols is empty, DefaultColumns is used.
+func WriteCSV(w io.Writer, rows []Row, cols []string) error {
+	if len(cols) == 0 {
+		cols = DefaultColumns
+	}
+	if err := ValidateColumns(cols); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	cw.UseCRLF = true
+	if err := cw.Write(cols); err != nil {
+		return err
+	}
+	record := make([]string, len(cols))
+	for _, row := range rows {
+		for i, col := range cols {
+			record[i] = columns[col](row)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
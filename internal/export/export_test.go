@@ -0,0 +1,227 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/resources/stores/payments"
+	"github.com/Saser/strecku/resources/stores/products"
+	"github.com/Saser/strecku/resources/stores/purchases"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func seed(ctx context.Context, t *testing.T) *Exporter {
+	t.Helper()
+	userRepo := repositories.NewInMemoryUsers()
+	repositories.SeedUsers(
+		ctx,
+		t,
+		userRepo,
+		[]*pb.User{testresources.Alice},
+		[]string{testresources.AlicePassword},
+	)
+	productRepo := products.SeedRepository(t, []*pb.Product{
+		testresources.Beer,
+		testresources.Jeans,
+	})
+	purchaseRepo := purchases.SeedRepository(t, []*pb.Purchase{
+		testresources.Bar_Alice_Beer1,
+		testresources.Bar_Alice_Cocktail1,
+		testresources.Bar_Bob_Beer1_Voided,
+		testresources.Mall_Alice_Jeans1,
+	})
+	paymentRepo := payments.SeedRepository(t, []*pb.Payment{
+		testresources.Bar_Alice_Payment,
+		testresources.Bar_Bob_Payment,
+	})
+	return NewExporter(userRepo, productRepo, purchaseRepo, paymentRepo)
+}
+
+func TestValidateColumns(t *testing.T) {
+	for _, test := range []struct {
+		cols []string
+		want error
+	}{
+		{cols: nil, want: nil},
+		{cols: DefaultColumns, want: nil},
+		{cols: []string{"amount_cents", "type"}, want: nil},
+		{cols: []string{"type", "invalid"}, want: &UnknownColumnError{Column: "invalid"}},
+		{cols: []string{"type", "name", "type"}, want: &DuplicateColumnError{Column: "type"}},
+	} {
+		if got := ValidateColumns(test.cols); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+			t.Errorf("ValidateColumns(%q) = %v; want %v", test.cols, got, test.want)
+		}
+	}
+}
+
+func TestExporter_Rows(t *testing.T) {
+	ctx := context.Background()
+	e := seed(ctx, t)
+	beer1 := Row{
+		Type:               "purchase",
+		CreateTime:         testresources.Bar_Alice_Beer1.CreateTime,
+		Name:               testresources.Bar_Alice_Beer1.Name,
+		User:               testresources.Alice.Name,
+		UserEmailAddress:   testresources.Alice.EmailAddress,
+		Description:        testresources.Beer.DisplayName,
+		Product:            testresources.Beer.Name,
+		ProductDisplayName: testresources.Beer.DisplayName,
+		Quantity:           1,
+		PriceCents:         testresources.Beer.FullPriceCents,
+		AmountCents:        testresources.Beer.FullPriceCents,
+		LineKind:           "charge",
+	}
+	// The cocktail product is not in the product repository, so the
+	// display name of the product is left empty.
+	cocktail1 := Row{
+		Type:               "purchase",
+		CreateTime:         testresources.Bar_Alice_Cocktail1.CreateTime,
+		Name:               testresources.Bar_Alice_Cocktail1.Name,
+		User:               testresources.Alice.Name,
+		UserEmailAddress:   testresources.Alice.EmailAddress,
+		Description:        testresources.Cocktail.DisplayName,
+		Product:            testresources.Cocktail.Name,
+		ProductDisplayName: "",
+		Quantity:           1,
+		PriceCents:         testresources.Cocktail.FullPriceCents,
+		AmountCents:        testresources.Cocktail.FullPriceCents,
+		LineKind:           "charge",
+	}
+	alicePayment := Row{
+		Type:             "payment",
+		CreateTime:       testresources.Bar_Alice_Payment.CreateTime,
+		Name:             testresources.Bar_Alice_Payment.Name,
+		User:             testresources.Alice.Name,
+		UserEmailAddress: testresources.Alice.EmailAddress,
+		Description:      testresources.Bar_Alice_Payment.Description,
+		Quantity:         1,
+		PriceCents:       testresources.Bar_Alice_Payment.AmountCents,
+		AmountCents:      testresources.Bar_Alice_Payment.AmountCents,
+	}
+	// Bob is not in the user repository, so the email address is left
+	// empty.
+	bobPayment := Row{
+		Type:             "payment",
+		CreateTime:       testresources.Bar_Bob_Payment.CreateTime,
+		Name:             testresources.Bar_Bob_Payment.Name,
+		User:             testresources.Bob.Name,
+		UserEmailAddress: "",
+		Description:      testresources.Bar_Bob_Payment.Description,
+		Quantity:         1,
+		PriceCents:       testresources.Bar_Bob_Payment.AmountCents,
+		AmountCents:      testresources.Bar_Bob_Payment.AmountCents,
+	}
+	for _, test := range []struct {
+		desc       string
+		start, end time.Time
+		want       []Row
+	}{
+		{
+			desc:  "All",
+			start: time.Time{},
+			end:   time.Time{},
+			want:  []Row{beer1, cocktail1, alicePayment, bobPayment},
+		},
+		{
+			desc:  "December",
+			start: time.Date(2020, time.December, 2, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+			want:  []Row{cocktail1, alicePayment},
+		},
+		{
+			desc:  "Empty",
+			start: time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC),
+			want:  nil,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			rows, err := e.Rows(ctx, testresources.Bar.Name, test.start, test.end)
+			if err != nil {
+				t.Fatalf("e.Rows(ctx, %q, %v, %v) err = %v; want nil", testresources.Bar.Name, test.start, test.end, err)
+			}
+			if diff := cmp.Diff(rows, test.want, protocmp.Transform(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("e.Rows(ctx, %q, %v, %v) rows != test.want (-got +want)\n%s", testresources.Bar.Name, test.start, test.end, diff)
+			}
+		})
+	}
+}
+
+func TestExporter_Rows_EndBeforeStart(t *testing.T) {
+	ctx := context.Background()
+	e := seed(ctx, t)
+	start := time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := e.Rows(ctx, testresources.Bar.Name, start, end); !cmp.Equal(err, ErrEndBeforeStart, cmpopts.EquateErrors()) {
+		t.Errorf("e.Rows(ctx, %q, %v, %v) err = %v; want %v", testresources.Bar.Name, start, end, err, ErrEndBeforeStart)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	rows := []Row{
+		{
+			Type:               "purchase",
+			CreateTime:         testresources.Bar_Alice_Beer1.CreateTime,
+			Name:               testresources.Bar_Alice_Beer1.Name,
+			UserEmailAddress:   testresources.Alice.EmailAddress,
+			Description:        `Beer, "draft"`,
+			ProductDisplayName: testresources.Beer.DisplayName,
+			Quantity:           2,
+			PriceCents:         -5000,
+			AmountCents:        -10000,
+			LineKind:           "charge",
+		},
+		{
+			Type:             "payment",
+			CreateTime:       testresources.Bar_Alice_Payment.CreateTime,
+			Name:             testresources.Bar_Alice_Payment.Name,
+			UserEmailAddress: testresources.Alice.EmailAddress,
+			Description:      "Payment",
+			Quantity:         1,
+			PriceCents:       10000,
+			AmountCents:      10000,
+		},
+	}
+	t.Run("SelectedColumns", func(t *testing.T) {
+		var buf bytes.Buffer
+		cols := []string{"create_time", "user_email_address", "description", "quantity", "amount_cents", "line_kind"}
+		if err := WriteCSV(&buf, rows, cols); err != nil {
+			t.Fatalf("WriteCSV(&buf, rows, %q) = %v; want nil", cols, err)
+		}
+		want := strings.Join([]string{
+			"create_time,user_email_address,description,quantity,amount_cents,line_kind",
+			`2020-12-01T18:00:00Z,alice@example.com,"Beer, ""draft""",2,-10000,charge`,
+			"2020-12-31T10:00:00Z,alice@example.com,Payment,1,10000,",
+			"",
+		}, "\r\n")
+		if diff := cmp.Diff(buf.String(), want); diff != "" {
+			t.Errorf("WriteCSV(&buf, rows, %q) output != want (-got +want)\n%s", cols, diff)
+		}
+	})
+	t.Run("DefaultColumns", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := WriteCSV(&buf, nil, nil); err != nil {
+			t.Fatalf("WriteCSV(&buf, nil, nil) = %v; want nil", err)
+		}
+		want := strings.Join(DefaultColumns, ",") + "\r\n"
+		if diff := cmp.Diff(buf.String(), want); diff != "" {
+			t.Errorf("WriteCSV(&buf, nil, nil) output != want (-got +want)\n%s", diff)
+		}
+	})
+	t.Run("UnknownColumn", func(t *testing.T) {
+		var buf bytes.Buffer
+		cols := []string{"invalid"}
+		want := &UnknownColumnError{Column: "invalid"}
+		if err := WriteCSV(&buf, rows, cols); !cmp.Equal(err, want, cmpopts.EquateErrors()) {
+			t.Errorf("WriteCSV(&buf, rows, %q) = %v; want %v", cols, err, want)
+		}
+	})
+}
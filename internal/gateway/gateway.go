@@ -0,0 +1,63 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/logging"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// New returns a handler that transcodes REST/JSON requests, as described by
+// the google.api.http annotations of the StreckU service, into calls to the
+// service over cc. Responses are encoded using protojson, and errors are
+// translated into the HTTP status codes corresponding to their gRPC codes. A
+// PATCH request without an update mask only updates the fields present in the
+// body.
+// The Authorization header of a request is passed on as the "authorization"
+// metadata key, so that the same credentials can be used for REST and gRPC.
+// Likewise, the X-Request-Id header is passed on as the request ID used in the
+// logs of the server; if it is missing or invalid, a new ID is generated. The
+// ID is returned in the X-Request-Id header of the response.
+func New(ctx context.Context, cc *grpc.ClientConn) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+	)
+	if err := pb.RegisterStreckUHandler(ctx, mux, cc); err != nil {
+		return nil, err
+	}
+	return withRequestID(mux), nil
+}
+
+func withRequestID(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Request-Id")
+		if !logging.ValidRequestID(id) {
+			id = logging.NewRequestID()
+			r.Header.Set("X-Request-Id", id)
+		}
+		w.Header().Set("X-Request-Id", id)
+		h.ServeHTTP(w, r)
+	})
+}
+
+// incomingHeader decides which HTTP request headers are passed on as
+// metadata, in addition to the Authorization header and the headers prefixed
+// by Grpc-Metadata-, which are handled by runtime.DefaultHeaderMatcher.
+func incomingHeader(key string) (string, bool) {
+	if http.CanonicalHeaderKey(key) == "X-Request-Id" {
+		return logging.RequestIDKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,348 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/logging"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+// fakeServer implements a few RPCs of the StreckU service in ways that make
+// it possible to observe how REST requests were transcoded.
+type fakeServer struct {
+	pb.UnimplementedStreckUServer
+}
+
+// GetUser returns a user whose display name is the authorization metadata of
+// the request, or for users/requests, the request ID metadata.
+func (fakeServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	switch req.Name {
+	case "users/alice":
+		return &pb.User{
+			Name:        req.Name,
+			DisplayName: strings.Join(md.Get("authorization"), ","),
+		}, nil
+	case "users/requests":
+		return &pb.User{
+			Name:        req.Name,
+			DisplayName: strings.Join(md.Get(logging.RequestIDKey), ","),
+		}, nil
+	default:
+		return nil, status.Errorf(codes.NotFound, "user %q not found", req.Name)
+	}
+}
+
+func (fakeServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
+	if req.User.DisplayName == "" {
+		return nil, status.Error(codes.InvalidArgument, "display name is required")
+	}
+	user := req.User
+	user.Name = "users/new"
+	return user, nil
+}
+
+// UpdateUser returns the user, with the email address set to the paths of
+// the update mask.
+func (fakeServer) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
+	user := req.User
+	user.EmailAddress = strings.Join(req.UpdateMask.GetPaths(), ",")
+	return user, nil
+}
+
+func (fakeServer) ExportStore(req *pb.ExportStoreRequest, stream pb.StreckU_ExportStoreServer) error {
+	for _, data := range []string{"header\n", "record\n"} {
+		if err := stream.Send(&pb.ExportStoreResponse{Data: []byte(data)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func serve(ctx context.Context, t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := grpc.NewServer()
+	pb.RegisterStreckUServer(srv, fakeServer{})
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			t.Errorf("srv.Serve(%v) = %v; want nil", lis, err)
+		}
+	}()
+	t.Cleanup(srv.GracefulStop)
+	dial := func(context.Context, string) (net.Conn, error) { return lis.Dial() }
+	cc, err := grpc.DialContext(ctx, "bufconn", grpc.WithInsecure(), grpc.WithContextDialer(dial))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := cc.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	handler, err := New(ctx, cc)
+	if err != nil {
+		t.Fatalf("New(ctx, cc) err = %v; want nil", err)
+	}
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	ts := serve(ctx, t)
+	for _, test := range []struct {
+		desc       string
+		method     string
+		path       string
+		header     http.Header
+		body       string
+		wantStatus int
+		// wantJSON contains the expected top-level fields of the response.
+		wantJSON map[string]interface{}
+	}{
+		{
+			desc:       "Get",
+			method:     http.MethodGet,
+			path:       "/v1/users/alice",
+			wantStatus: http.StatusOK,
+			wantJSON: map[string]interface{}{
+				"name":                 "users/alice",
+				"emailAddress":         "",
+				"displayName":          "",
+				"emailAddressVerified": false,
+			},
+		},
+		{
+			desc:       "Authorization",
+			method:     http.MethodGet,
+			path:       "/v1/users/alice",
+			header:     http.Header{"Authorization": []string{"Bearer foobar"}},
+			wantStatus: http.StatusOK,
+			wantJSON: map[string]interface{}{
+				"name":                 "users/alice",
+				"emailAddress":         "",
+				"displayName":          "Bearer foobar",
+				"emailAddressVerified": false,
+			},
+		},
+		{
+			desc:       "NotFound",
+			method:     http.MethodGet,
+			path:       "/v1/users/bob",
+			wantStatus: http.StatusNotFound,
+			wantJSON: map[string]interface{}{
+				"code":    float64(codes.NotFound),
+				"message": `user "users/bob" not found`,
+				"details": []interface{}{},
+			},
+		},
+		{
+			desc:       "Create",
+			method:     http.MethodPost,
+			path:       "/v1/users",
+			body:       `{"emailAddress": "new@example.com", "displayName": "New", "unknownField": 1}`,
+			wantStatus: http.StatusOK,
+			wantJSON: map[string]interface{}{
+				"name":                 "users/new",
+				"emailAddress":         "new@example.com",
+				"displayName":          "New",
+				"emailAddressVerified": false,
+			},
+		},
+		{
+			desc:       "InvalidArgument",
+			method:     http.MethodPost,
+			path:       "/v1/users",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantJSON: map[string]interface{}{
+				"code":    float64(codes.InvalidArgument),
+				"message": "display name is required",
+				"details": []interface{}{},
+			},
+		},
+		{
+			desc:       "UpdateWithMask",
+			method:     http.MethodPatch,
+			path:       "/v1/users/alice?updateMask=display_name",
+			body:       `{"displayName": "Alice"}`,
+			wantStatus: http.StatusOK,
+			wantJSON: map[string]interface{}{
+				"name":                 "users/alice",
+				"emailAddress":         "display_name",
+				"displayName":          "Alice",
+				"emailAddressVerified": false,
+			},
+		},
+		{
+			// Without an explicit update mask, the mask is inferred from the
+			// fields present in the body.
+			desc:       "UpdateWithoutMask",
+			method:     http.MethodPatch,
+			path:       "/v1/users/alice",
+			body:       `{"displayName": "Alice"}`,
+			wantStatus: http.StatusOK,
+			wantJSON: map[string]interface{}{
+				"name":                 "users/alice",
+				"emailAddress":         "display_name",
+				"displayName":          "Alice",
+				"emailAddressVerified": false,
+			},
+		},
+		{
+			desc:       "Unimplemented",
+			method:     http.MethodGet,
+			path:       "/v1/stores",
+			wantStatus: http.StatusNotImplemented,
+			wantJSON: map[string]interface{}{
+				"code":    float64(codes.Unimplemented),
+				"message": "method ListStores not implemented",
+				"details": []interface{}{},
+			},
+		},
+		{
+			desc:       "CustomMethod",
+			method:     http.MethodPost,
+			path:       "/v1/stores/bar/memberships/alice:changePin",
+			body:       `{"pin": "1234"}`,
+			wantStatus: http.StatusNotImplemented,
+			wantJSON: map[string]interface{}{
+				"code":    float64(codes.Unimplemented),
+				"message": "method ChangeMembershipPin not implemented",
+				"details": []interface{}{},
+			},
+		},
+		{
+			desc:       "CustomMethod_Undo",
+			method:     http.MethodPost,
+			path:       "/v1/stores/bar/purchases/beer:undo",
+			body:       `{}`,
+			wantStatus: http.StatusNotImplemented,
+			wantJSON: map[string]interface{}{
+				"code":    float64(codes.Unimplemented),
+				"message": "method UndoPurchase not implemented",
+				"details": []interface{}{},
+			},
+		},
+	} {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(ctx, test.method, ts.URL+test.path, strings.NewReader(test.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for k, v := range test.header {
+				req.Header[k] = v
+			}
+			resp, err := ts.Client().Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if got := resp.StatusCode; got != test.wantStatus {
+				t.Errorf("%s %s: status = %d; want %d", test.method, test.path, got, test.wantStatus)
+			}
+			var got map[string]interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, test.wantJSON); diff != "" {
+				t.Errorf("%s %s: unexpected body (-got +want)\n%s", test.method, test.path, diff)
+			}
+		})
+	}
+}
+
+func TestNew_RequestID(t *testing.T) {
+	ctx := context.Background()
+	ts := serve(ctx, t)
+	for _, test := range []struct {
+		desc   string
+		id     string
+		wantID func(string) bool
+	}{
+		{desc: "FromCaller", id: "kiosk-1234", wantID: func(id string) bool { return id == "kiosk-1234" }},
+		{desc: "Generated", id: "", wantID: logging.ValidRequestID},
+		{desc: "Invalid", id: "two words", wantID: func(id string) bool { return id != "two words" && logging.ValidRequestID(id) }},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/v1/users/requests", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.id != "" {
+				req.Header.Set("X-Request-Id", test.id)
+			}
+			resp, err := ts.Client().Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			id := resp.Header.Get("X-Request-Id")
+			if !test.wantID(id) {
+				t.Errorf("X-Request-Id = %q; want a valid ID", id)
+			}
+			var user struct {
+				DisplayName string `json:"displayName"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+				t.Fatal(err)
+			}
+			// The ID passed on to the server is the one in the response.
+			if got := user.DisplayName; got != id {
+				t.Errorf("request ID metadata = %q; want %q", got, id)
+			}
+		})
+	}
+}
+
+func TestNew_ServerStreaming(t *testing.T) {
+	ctx := context.Background()
+	ts := serve(ctx, t)
+	resp, err := ts.Client().Get(ts.URL + "/v1/stores/bar:export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Errorf("status = %d; want %d", got, want)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each message in the stream is a separate JSON object.
+	var got []string
+	dec := json.NewDecoder(strings.NewReader(string(body)))
+	for dec.More() {
+		var msg struct {
+			Result struct {
+				Data []byte `json:"data"`
+			} `json:"result"`
+		}
+		if err := dec.Decode(&msg); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, string(msg.Result.Data))
+	}
+	if diff := cmp.Diff(got, []string{"header\n", "record\n"}); diff != "" {
+		t.Errorf("unexpected chunks (-got +want)\n%s", diff)
+	}
+}
@@ -0,0 +1,182 @@
+package healthcheck
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// DefaultInterval is the default time between two runs of the checks.
+	DefaultInterval = 10 * time.Second
+	// DefaultTimeout is the default time a single check may take before it
+	// is considered to have failed.
+	DefaultTimeout = 5 * time.Second
+)
+
+// Check returns a non-nil error if a dependency of the server, such as a
+// database, is unhealthy.
+type Check func(ctx context.Context) error
+
+// Result is the outcome of the most recent run of a check.
+type Result struct {
+	Name string
+	// Err is the error returned by the check, or nil if it passed.
+	Err  error
+	Time time.Time
+}
+
+// Checker periodically runs a set of checks, and reports the server as serving
+// through the gRPC health checking protocol if and only if all checks pass.
+type Checker struct {
+	// Interval is the time between two runs of the checks.
+	Interval time.Duration
+	// Timeout is the time a single check may take.
+	Timeout time.Duration
+	// OnChange, if non-nil, is called when the result of a check changes
+	// between passing and failing.
+	OnChange func(Result)
+
+	server   *health.Server
+	services []string
+	names    []string
+	checks   map[string]Check
+
+	mu       sync.Mutex
+	results  map[string]Result
+	shutdown bool
+}
+
+// NewChecker returns a Checker that sets the serving status of the given
+// services, as well as that of the server as a whole, on server. Until the
+// checks have run for the first time, the server is reported as not serving.
+func NewChecker(server *health.Server, services ...string) *Checker {
+	c := &Checker{
+		Interval: DefaultInterval,
+		Timeout:  DefaultTimeout,
+		server:   server,
+		services: append([]string{""}, services...),
+		checks:   make(map[string]Check),
+		results:  make(map[string]Result),
+	}
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// Add adds a check with the given name. Add must not be called after Run.
+func (c *Checker) Add(name string, check Check) {
+	if _, ok := c.checks[name]; !ok {
+		c.names = append(c.names, name)
+		sort.Strings(c.names)
+	}
+	c.checks[name] = check
+}
+
+func (c *Checker) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
+
+// CheckNow runs all checks once and updates the serving status. It returns
+// true if all checks passed.
+func (c *Checker) CheckNow(ctx context.Context) bool {
+	results := make([]Result, len(c.names))
+	var wg sync.WaitGroup
+	for i, name := range c.names {
+		i, name := i, name
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+			defer cancel()
+			results[i] = Result{
+				Name: name,
+				Err:  c.checks[name](ctx),
+				Time: time.Now(),
+			}
+		}()
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	healthy := true
+	for _, r := range results {
+		prev, ok := c.results[r.Name]
+		if c.OnChange != nil && (!ok || (prev.Err == nil) != (r.Err == nil)) {
+			c.OnChange(r)
+		}
+		c.results[r.Name] = r
+		if r.Err != nil {
+			healthy = false
+		}
+	}
+	if c.shutdown {
+		return healthy
+	}
+	if healthy {
+		c.setStatus(healthpb.HealthCheckResponse_SERVING)
+	} else {
+		c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	return healthy
+}
+
+// Run runs the checks every Interval until ctx is done.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+	for {
+		c.CheckNow(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown permanently reports the server as not serving, so that load
+// balancers stop sending new requests to it while it shuts down.
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.shutdown = true
+	c.server.Shutdown()
+}
+
+// Results returns the most recent result of each check, sorted by name.
+// Checks that have not run yet are not included.
+func (c *Checker) Results() []Result {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var results []Result
+	for _, name := range c.names {
+		if r, ok := c.results[name]; ok {
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
+// Ready reports whether the server is ready to serve requests: all checks
+// have run and passed, and the server is not shutting down.
+func (c *Checker) Ready() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.shutdown {
+		return false
+	}
+	for _, name := range c.names {
+		r, ok := c.results[name]
+		if !ok || r.Err != nil {
+			return false
+		}
+	}
+	return true
+}
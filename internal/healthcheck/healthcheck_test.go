@@ -0,0 +1,146 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const service = "saser.strecku.v1.StreckU"
+
+func servingStatus(ctx context.Context, t *testing.T, server *health.Server, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := server.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("server.Check(ctx, %q) err = %v; want nil", service, err)
+	}
+	return resp.Status
+}
+
+func checkStatus(ctx context.Context, t *testing.T, server *health.Server, want healthpb.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+	for _, s := range []string{"", service} {
+		if got := servingStatus(ctx, t, server, s); got != want {
+			t.Errorf("status of %q = %v; want %v", s, got, want)
+		}
+	}
+}
+
+func TestChecker(t *testing.T) {
+	ctx := context.Background()
+	server := health.NewServer()
+	c := NewChecker(server, service)
+	var changes []Result
+	c.OnChange = func(r Result) { changes = append(changes, r) }
+	dbErr := errors.New("database is down")
+	var failing error
+	c.Add("database", func(context.Context) error { return failing })
+	c.Add("cache", func(context.Context) error { return nil })
+
+	checkStatus(ctx, t, server, healthpb.HealthCheckResponse_NOT_SERVING)
+	if c.Ready() {
+		t.Error("Ready() before checks have run = true; want false")
+	}
+
+	if !c.CheckNow(ctx) {
+		t.Error("CheckNow(ctx) with passing checks = false; want true")
+	}
+	checkStatus(ctx, t, server, healthpb.HealthCheckResponse_SERVING)
+	if !c.Ready() {
+		t.Error("Ready() with passing checks = false; want true")
+	}
+	if got, want := len(changes), 2; got != want {
+		t.Errorf("len(changes) after first run = %d; want %d", got, want)
+	}
+
+	failing = dbErr
+	if c.CheckNow(ctx) {
+		t.Error("CheckNow(ctx) with failing check = true; want false")
+	}
+	checkStatus(ctx, t, server, healthpb.HealthCheckResponse_NOT_SERVING)
+	if c.Ready() {
+		t.Error("Ready() with failing check = true; want false")
+	}
+	if got, want := len(changes), 3; got != want {
+		t.Fatalf("len(changes) after failure = %d; want %d", got, want)
+	}
+	if got := changes[2]; got.Name != "database" || got.Err != dbErr {
+		t.Errorf("changes[2] = %+v; want failure of database", got)
+	}
+	results := c.Results()
+	if got, want := len(results), 2; got != want {
+		t.Fatalf("len(Results()) = %d; want %d", got, want)
+	}
+	if results[0].Name != "cache" || results[0].Err != nil {
+		t.Errorf("Results()[0] = %+v; want passing cache", results[0])
+	}
+	if results[1].Name != "database" || results[1].Err != dbErr {
+		t.Errorf("Results()[1] = %+v; want failing database", results[1])
+	}
+
+	// A check that keeps failing does not cause another change.
+	c.CheckNow(ctx)
+	if got, want := len(changes), 3; got != want {
+		t.Errorf("len(changes) after repeated failure = %d; want %d", got, want)
+	}
+
+	failing = nil
+	c.CheckNow(ctx)
+	checkStatus(ctx, t, server, healthpb.HealthCheckResponse_SERVING)
+
+	c.Shutdown()
+	checkStatus(ctx, t, server, healthpb.HealthCheckResponse_NOT_SERVING)
+	c.CheckNow(ctx)
+	checkStatus(ctx, t, server, healthpb.HealthCheckResponse_NOT_SERVING)
+	if c.Ready() {
+		t.Error("Ready() after Shutdown() = true; want false")
+	}
+}
+
+func TestChecker_Timeout(t *testing.T) {
+	ctx := context.Background()
+	c := NewChecker(health.NewServer())
+	c.Timeout = 10 * time.Millisecond
+	c.Add("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if c.CheckNow(ctx) {
+		t.Error("CheckNow(ctx) with slow check = true; want false")
+	}
+	if got := c.Results()[0].Err; !errors.Is(got, context.DeadlineExceeded) {
+		t.Errorf("result of slow check = %v; want %v", got, context.DeadlineExceeded)
+	}
+}
+
+func TestChecker_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	server := health.NewServer()
+	c := NewChecker(server)
+	c.Interval = time.Millisecond
+	runs := make(chan struct{}, 10)
+	c.Add("counter", func(context.Context) error {
+		select {
+		case runs <- struct{}{}:
+		default:
+		}
+		return nil
+	})
+	done := make(chan struct{})
+	go func() {
+		c.Run(ctx)
+		close(done)
+	}()
+	for i := 0; i < 3; i++ {
+		<-runs
+	}
+	cancel()
+	<-done
+	if got, want := servingStatus(context.Background(), t, server, ""), healthpb.HealthCheckResponse_SERVING; got != want {
+		t.Errorf("status = %v; want %v", got, want)
+	}
+}
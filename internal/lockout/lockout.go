@@ -0,0 +1,429 @@
+package lockout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+var NameFormat = resourcename.MustParseFormat("lockouts/{lockout}")
+
+func generateName() string {
+	name, err := NameFormat.Format(resourcename.UUIDs{"lockout": uuid.New()})
+	if err != nil {
+		panic(err)
+	}
+	return name
+}
+
+// Blocked is returned by Authenticate and Check when an attempt to
+// authenticate is rejected without checking the password or PIN, because of
+// earlier failures.
+type Blocked struct {
+	// User is the resource name of the user or membership whose account is
+	// blocked, or empty if the peer is blocked.
+	User string
+	// Peer is the address of the peer that is blocked, or empty if the
+	// account of the user is blocked.
+	Peer string
+	// Until is the time when attempts are allowed again.
+	Until time.Time
+}
+
+func (e *Blocked) Error() string {
+	subject := fmt.Sprintf("user %q", e.User)
+	if e.User == "" {
+		subject = fmt.Sprintf("peer %q", e.Peer)
+	}
+	return fmt.Sprintf("too many failed attempts to authenticate by %s; try again after %s", subject, e.Until.Format(time.RFC3339))
+}
+
+func (e *Blocked) Is(target error) bool {
+	other, ok := target.(*Blocked)
+	return ok && e.User == other.User && e.Peer == other.Peer && e.Until.Equal(other.Until)
+}
+
+// Policy decides how long attempts to authenticate are rejected after
+// consecutive failures.
+type Policy struct {
+	// MaxFailures is the number of consecutive failures after which
+	// attempts are rejected for LockoutDuration.
+	MaxFailures int
+	// BaseDelay is how long attempts are rejected after the first failure.
+	// The delay is doubled for every further failure, up to MaxDelay.
+	BaseDelay time.Duration
+	// MaxDelay is the longest delay between failures, before MaxFailures
+	// is reached.
+	MaxDelay time.Duration
+	// LockoutDuration is how long attempts are rejected after MaxFailures
+	// failures. It is also how long failures are remembered: a failure
+	// more than LockoutDuration after the previous one is counted as the
+	// first.
+	LockoutDuration time.Duration
+}
+
+var (
+	// DefaultAccountPolicy is the default policy for failures to
+	// authenticate as a single user.
+	DefaultAccountPolicy = Policy{
+		MaxFailures:     5,
+		BaseDelay:       time.Second,
+		MaxDelay:        time.Minute,
+		LockoutDuration: 15 * time.Minute,
+	}
+	// DefaultPeerPolicy is the default policy for failures to authenticate
+	// from a single peer, as any user. It allows more failures than
+	// DefaultAccountPolicy, since several people may share an address.
+	DefaultPeerPolicy = Policy{
+		MaxFailures:     20,
+		BaseDelay:       time.Second,
+		MaxDelay:        time.Minute,
+		LockoutDuration: 15 * time.Minute,
+	}
+)
+
+// delay returns how long attempts are rejected after the given number of
+// consecutive failures.
+func (p Policy) delay(failures int) time.Duration {
+	if failures >= p.MaxFailures {
+		return p.LockoutDuration
+	}
+	d := p.BaseDelay
+	for i := 1; i < failures && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+	if d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	return d
+}
+
+// EventType is the type of a security Event.
+type EventType int
+
+const (
+	// EventLoginSucceeded means that a user authenticated successfully.
+	EventLoginSucceeded EventType = iota
+	// EventLoginFailed means that the password or user was wrong.
+	EventLoginFailed
+	// EventLoginBlocked means that an attempt was rejected without
+	// checking the password, because of earlier failures.
+	EventLoginBlocked
+	// EventLockedOut means that an account or peer reached the maximum
+	// number of failures, and was locked out.
+	EventLockedOut
+	// EventLockoutCleared means that a lockout was cleared before it
+	// expired.
+	EventLockoutCleared
+)
+
+var eventTypeNames = []string{
+	EventLoginSucceeded: "login_succeeded",
+	EventLoginFailed:    "login_failed",
+	EventLoginBlocked:   "login_blocked",
+	EventLockedOut:      "locked_out",
+	EventLockoutCleared: "lockout_cleared",
+}
+
+func (t EventType) String() string {
+	if t < 0 || int(t) >= len(eventTypeNames) {
+		return fmt.Sprintf("EventType(%d)", int(t))
+	}
+	return eventTypeNames[t]
+}
+
+// Event is a security event concerning authentication.
+type Event struct {
+	Time time.Time
+	Type EventType
+	// User is the resource name of the user or membership concerned, if
+	// known.
+	User string
+	// Peer is the address of the peer concerned, if known.
+	Peer string
+}
+
+// Lockout is an account or peer that has reached the maximum number of
+// failures, and whose attempts to authenticate are rejected.
+type Lockout struct {
+	// Name is the resource name of the lockout, which is used to clear
+	// it. Format: lockouts/{lockout}
+	Name string
+	// User is the resource name of the user or membership whose account is
+	// locked out, or empty if the lockout is of a peer.
+	User string
+	// Peer is the address of the peer that is locked out, or empty if the
+	// lockout is of an account.
+	Peer string
+	// Failures is the number of consecutive failures.
+	Failures int
+	// Until is the time when the lockout expires.
+	Until time.Time
+}
+
+// entry records the consecutive failures of an account or peer.
+type entry struct {
+	name        string
+	failures    int
+	lastFailure time.Time
+	until       time.Time
+	// accounts is the set of accounts that the failures were attempts to
+	// authenticate as, where "" is an unknown account.
+	accounts map[string]bool
+}
+
+// expired reports whether the failures recorded by e have been forgotten.
+func (e *entry) expired(p Policy, now time.Time) bool {
+	return !now.Before(e.lastFailure.Add(p.LockoutDuration))
+}
+
+// Guard limits the rate of failed attempts to authenticate, both per
+// account and per peer. After a failure, further attempts are rejected for
+// a delay that grows exponentially with the number of consecutive failures,
+// until the account or peer is locked out after a maximum number of
+// failures. A successful attempt resets the failures of the account, and
+// those of the peer if they were all attempts to authenticate as the same
+// account.
+//
+// The state of a Guard is kept in memory, so it works with any
+// repositories.Users, but is not shared between servers.
+type Guard struct {
+	// Account is the policy for failures to authenticate as a single user.
+	Account Policy
+	// Peer is the policy for failures to authenticate from a single peer.
+	Peer Policy
+	// OnEvent, if non-nil, is called with every security event.
+	OnEvent func(Event)
+	// now is used instead of time.Now in tests.
+	now func() time.Time
+
+	mu       sync.Mutex
+	accounts map[string]*entry // user or membership name -> entry
+	peers    map[string]*entry // peer address -> entry
+}
+
+// NewGuard returns a Guard using DefaultAccountPolicy and DefaultPeerPolicy.
+func NewGuard() *Guard {
+	return &Guard{
+		Account:  DefaultAccountPolicy,
+		Peer:     DefaultPeerPolicy,
+		now:      time.Now,
+		accounts: make(map[string]*entry),
+		peers:    make(map[string]*entry),
+	}
+}
+
+func (g *Guard) emit(t EventType, user, peer string) {
+	if g.OnEvent != nil {
+		g.OnEvent(Event{Time: g.now(), Type: t, User: user, Peer: peer})
+	}
+}
+
+// check returns a Blocked error if attempts by the given user or peer are
+// currently rejected.
+func (g *Guard) check(user, peer string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := g.now()
+	if e, ok := g.accounts[user]; ok && !e.expired(g.Account, now) && now.Before(e.until) {
+		return &Blocked{User: user, Until: e.until}
+	}
+	if e, ok := g.peers[peer]; ok && !e.expired(g.Peer, now) && now.Before(e.until) {
+		return &Blocked{Peer: peer, Until: e.until}
+	}
+	return nil
+}
+
+// fail records a failure to authenticate as account in entries, and reports
+// whether it caused a lockout.
+func fail(entries map[string]*entry, key string, account string, p Policy, now time.Time) bool {
+	for k, e := range entries {
+		if e.expired(p, now) {
+			delete(entries, k)
+		}
+	}
+	e, ok := entries[key]
+	if !ok {
+		e = &entry{name: generateName(), accounts: make(map[string]bool)}
+		entries[key] = e
+	}
+	e.accounts[account] = true
+	e.failures++
+	e.lastFailure = now
+	e.until = now.Add(p.delay(e.failures))
+	return e.failures == p.MaxFailures
+}
+
+func (g *Guard) failed(user, peer string) {
+	g.mu.Lock()
+	now := g.now()
+	var accountLocked, peerLocked bool
+	if user != "" {
+		accountLocked = fail(g.accounts, user, user, g.Account, now)
+	}
+	if peer != "" {
+		peerLocked = fail(g.peers, peer, user, g.Peer, now)
+	}
+	g.mu.Unlock()
+	g.emit(EventLoginFailed, user, peer)
+	if accountLocked {
+		g.emit(EventLockedOut, user, "")
+	}
+	if peerLocked {
+		g.emit(EventLockedOut, "", peer)
+	}
+}
+
+func (g *Guard) succeeded(user, peer string) {
+	g.mu.Lock()
+	delete(g.accounts, user)
+	// Otherwise, a peer guessing the password of one account could reset its
+	// failures by authenticating as another.
+	if e, ok := g.peers[peer]; ok && user != "" && len(e.accounts) == 1 && e.accounts[user] {
+		delete(g.peers, peer)
+	}
+	g.mu.Unlock()
+	g.emit(EventLoginSucceeded, user, peer)
+}
+
+// Lockouts returns the current lockouts, ordered by when they expire.
+func (g *Guard) Lockouts() []Lockout {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := g.now()
+	var lockouts []Lockout
+	for user, e := range g.accounts {
+		if e.failures >= g.Account.MaxFailures && !e.expired(g.Account, now) {
+			lockouts = append(lockouts, Lockout{Name: e.name, User: user, Failures: e.failures, Until: e.until})
+		}
+	}
+	for peer, e := range g.peers {
+		if e.failures >= g.Peer.MaxFailures && !e.expired(g.Peer, now) {
+			lockouts = append(lockouts, Lockout{Name: e.name, Peer: peer, Failures: e.failures, Until: e.until})
+		}
+	}
+	sort.Slice(lockouts, func(i, j int) bool {
+		if !lockouts[i].Until.Equal(lockouts[j].Until) {
+			return lockouts[i].Until.Before(lockouts[j].Until)
+		}
+		return lockouts[i].Name < lockouts[j].Name
+	})
+	return lockouts
+}
+
+// Clear clears the lockout with the given name, along with the failures of
+// its account or peer. If there is no such lockout, a
+// repositories.NotFound error is returned.
+func (g *Guard) Clear(name string) error {
+	if _, err := NameFormat.Parse(name); err != nil {
+		return err
+	}
+	for _, l := range g.Lockouts() {
+		if l.Name != name {
+			continue
+		}
+		g.mu.Lock()
+		if l.User != "" {
+			delete(g.accounts, l.User)
+		} else {
+			delete(g.peers, l.Peer)
+		}
+		g.mu.Unlock()
+		g.emit(EventLockoutCleared, l.User, l.Peer)
+		return nil
+	}
+	return &repositories.NotFound{Name: name}
+}
+
+// Check returns a Blocked error if attempts to authenticate as account, or
+// from the peer of ctx, are currently rejected. The account is the resource
+// name of a user or of a membership, whose PIN is being checked. If account
+// is empty, only the peer is checked.
+func (g *Guard) Check(ctx context.Context, account string) error {
+	peer := PeerAddress(ctx)
+	if err := g.check(account, peer); err != nil {
+		g.emit(EventLoginBlocked, account, peer)
+		return err
+	}
+	return nil
+}
+
+// Failed records a failed attempt to authenticate as account from the peer
+// of ctx. If account is empty, the failure only counts for the peer.
+func (g *Guard) Failed(ctx context.Context, account string) {
+	g.failed(account, PeerAddress(ctx))
+}
+
+// Succeeded records a successful attempt to authenticate as account from the
+// peer of ctx, which resets the failures of the account. The failures of the
+// peer are only reset if they were all attempts to authenticate as account.
+func (g *Guard) Succeeded(ctx context.Context, account string) {
+	g.succeeded(account, PeerAddress(ctx))
+}
+
+// Users returns a repository whose Authenticate method is guarded by g. The
+// other methods are passed through to r.
+func (g *Guard) Users(r repositories.Users) repositories.Users {
+	return &users{Users: r, g: g}
+}
+
+type users struct {
+	repositories.Users
+	g *Guard
+}
+
+// Authenticate rejects attempts with a Blocked error if the user or the peer
+// of ctx have failed too often. Otherwise the attempt is passed on to the
+// underlying repository. A wrong password counts as a failure for both the
+// user and the peer, while a user that does not exist only counts as a
+// failure for the peer.
+func (u *users) Authenticate(ctx context.Context, name string, password string) error {
+	if err := u.g.Check(ctx, name); err != nil {
+		return err
+	}
+	err := u.Users.Authenticate(ctx, name, password)
+	switch {
+	case err == nil:
+		u.g.Succeeded(ctx, name)
+	case errors.Is(err, repositories.ErrUnauthenticated):
+		u.g.Failed(ctx, name)
+	case errors.As(err, new(*repositories.NotFound)):
+		u.g.Failed(ctx, "")
+	}
+	return err
+}
+
+// PeerAddress returns the address, without port, of the host that made the
+// RPC with the given context, or the empty string if it is not known. RPCs
+// made over an in-process connection, such as those from the REST gateway,
+// are attributed to the last address of the x-forwarded-for metadata, which
+// is added by the gateway.
+func PeerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if p.Addr.Network() == "tcp" {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if fwd := md.Get("x-forwarded-for"); len(fwd) > 0 {
+		addrs := strings.Split(fwd[len(fwd)-1], ",")
+		return strings.TrimSpace(addrs[len(addrs)-1])
+	}
+	return p.Addr.String()
+}
@@ -0,0 +1,328 @@
+package lockout
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// fakeClock is a clock that only moves when told to.
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time          { return c.t }
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+var testPolicy = Policy{
+	MaxFailures:     3,
+	BaseDelay:       time.Second,
+	MaxDelay:        3 * time.Second,
+	LockoutDuration: time.Minute,
+}
+
+func setup(ctx context.Context, t *testing.T) (*Guard, repositories.Users, *fakeClock, *[]Event) {
+	t.Helper()
+	r := repositories.NewInMemoryUsers()
+	repositories.SeedUsers(ctx, t, r, []*pb.User{testresources.Alice}, []string{testresources.AlicePassword})
+	clock := &fakeClock{t: time.Date(2020, 11, 1, 12, 0, 0, 0, time.UTC)}
+	events := new([]Event)
+	g := NewGuard()
+	g.Account = testPolicy
+	g.Peer = Policy{
+		MaxFailures:     5,
+		BaseDelay:       0,
+		MaxDelay:        0,
+		LockoutDuration: time.Minute,
+	}
+	g.now = clock.now
+	g.OnEvent = func(e Event) { *events = append(*events, e) }
+	return g, g.Users(r), clock, events
+}
+
+func peerContext(ctx context.Context, addr string) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 50000}})
+}
+
+func TestPolicy_delay(t *testing.T) {
+	for failures, want := range map[int]time.Duration{
+		1: time.Second,
+		2: 2 * time.Second,
+		3: time.Minute, // MaxFailures
+	} {
+		if got := testPolicy.delay(failures); got != want {
+			t.Errorf("delay(%d) = %v; want %v", failures, got, want)
+		}
+	}
+	p := DefaultAccountPolicy
+	for failures, want := range map[int]time.Duration{
+		1: time.Second,
+		2: 2 * time.Second,
+		4: 8 * time.Second,
+	} {
+		if got := p.delay(failures); got != want {
+			t.Errorf("DefaultAccountPolicy.delay(%d) = %v; want %v", failures, got, want)
+		}
+	}
+	if got, want := DefaultPeerPolicy.delay(19), time.Minute; got != want {
+		t.Errorf("DefaultPeerPolicy.delay(19) = %v; want %v", got, want)
+	}
+}
+
+func TestGuard_Backoff(t *testing.T) {
+	ctx := peerContext(context.Background(), "192.0.2.1")
+	g, users, clock, _ := setup(ctx, t)
+	alice := testresources.Alice.Name
+
+	if err := users.Authenticate(ctx, alice, "wrong"); !errors.Is(err, repositories.ErrUnauthenticated) {
+		t.Fatalf("first failure: err = %v; want %v", err, repositories.ErrUnauthenticated)
+	}
+	// Even the right password is rejected during the delay.
+	wantBlocked := &Blocked{User: alice, Until: clock.t.Add(time.Second)}
+	if err := users.Authenticate(ctx, alice, testresources.AlicePassword); !errors.Is(err, wantBlocked) {
+		t.Fatalf("during delay: err = %v; want %v", err, wantBlocked)
+	}
+	clock.advance(time.Second)
+	if err := users.Authenticate(ctx, alice, "wrong"); !errors.Is(err, repositories.ErrUnauthenticated) {
+		t.Fatalf("second failure: err = %v; want %v", err, repositories.ErrUnauthenticated)
+	}
+	// The delay has doubled.
+	clock.advance(time.Second)
+	if err := users.Authenticate(ctx, alice, "wrong"); !errors.As(err, new(*Blocked)) {
+		t.Fatalf("during doubled delay: err = %v; want Blocked", err)
+	}
+	clock.advance(time.Second)
+	if err := users.Authenticate(ctx, alice, testresources.AlicePassword); err != nil {
+		t.Fatalf("after delay: err = %v; want nil", err)
+	}
+	// Success resets the failures.
+	if err := users.Authenticate(ctx, alice, "wrong"); !errors.Is(err, repositories.ErrUnauthenticated) {
+		t.Fatal(err)
+	}
+	if got := g.accounts[alice].failures; got != 1 {
+		t.Errorf("failures after success and failure = %d; want 1", got)
+	}
+}
+
+func TestGuard_Lockout(t *testing.T) {
+	ctx := peerContext(context.Background(), "192.0.2.1")
+	g, users, clock, events := setup(ctx, t)
+	alice := testresources.Alice.Name
+	for i := 0; i < testPolicy.MaxFailures; i++ {
+		clock.advance(testPolicy.MaxDelay)
+		if err := users.Authenticate(ctx, alice, "wrong"); !errors.Is(err, repositories.ErrUnauthenticated) {
+			t.Fatalf("failure %d: err = %v; want %v", i, err, repositories.ErrUnauthenticated)
+		}
+	}
+	lockouts := g.Lockouts()
+	want := []Lockout{{User: alice, Failures: 3, Until: clock.t.Add(time.Minute)}}
+	if diff := cmp.Diff(lockouts, want, cmpopts.IgnoreFields(Lockout{}, "Name")); diff != "" {
+		t.Fatalf("Lockouts() = (-got +want)\n%s", diff)
+	}
+	clock.advance(30 * time.Second)
+	if err := users.Authenticate(ctx, alice, testresources.AlicePassword); !errors.As(err, new(*Blocked)) {
+		t.Errorf("during lockout: err = %v; want Blocked", err)
+	}
+	// Lockouts expire, and the failures are forgotten.
+	clock.advance(30 * time.Second)
+	if got := g.Lockouts(); len(got) != 0 {
+		t.Errorf("Lockouts() after expiry = %v; want none", got)
+	}
+	if err := users.Authenticate(ctx, alice, "wrong"); !errors.Is(err, repositories.ErrUnauthenticated) {
+		t.Errorf("after lockout: err = %v; want %v", err, repositories.ErrUnauthenticated)
+	}
+	if got := g.accounts[alice].failures; got != 1 {
+		t.Errorf("failures after expiry = %d; want 1", got)
+	}
+
+	var types []EventType
+	for _, e := range *events {
+		types = append(types, e.Type)
+	}
+	wantTypes := []EventType{
+		EventLoginFailed,
+		EventLoginFailed,
+		EventLoginFailed,
+		EventLockedOut,
+		EventLoginBlocked,
+		EventLoginFailed,
+	}
+	if diff := cmp.Diff(types, wantTypes); diff != "" {
+		t.Errorf("event types = (-got +want)\n%s", diff)
+	}
+	if e := (*events)[0]; e.User != alice || e.Peer != "192.0.2.1" {
+		t.Errorf("first event = %+v; want user %q and peer %q", e, alice, "192.0.2.1")
+	}
+}
+
+func TestGuard_PeerLockout(t *testing.T) {
+	ctx := peerContext(context.Background(), "192.0.2.1")
+	g, users, _, _ := setup(ctx, t)
+	// Guessing users that do not exist only counts against the peer.
+	for i := 0; i < g.Peer.MaxFailures; i++ {
+		if err := users.Authenticate(ctx, testresources.Bob.Name, "guess"); !errors.As(err, new(*repositories.NotFound)) {
+			t.Fatalf("failure %d: err = %v; want NotFound", i, err)
+		}
+	}
+	lockouts := g.Lockouts()
+	if len(lockouts) != 1 || lockouts[0].Peer != "192.0.2.1" {
+		t.Fatalf("Lockouts() = %+v; want a single lockout of the peer", lockouts)
+	}
+	if err := users.Authenticate(ctx, testresources.Alice.Name, testresources.AlicePassword); !errors.As(err, new(*Blocked)) {
+		t.Errorf("from locked out peer: err = %v; want Blocked", err)
+	}
+	// Other peers are not affected.
+	other := peerContext(context.Background(), "192.0.2.2")
+	if err := users.Authenticate(other, testresources.Alice.Name, testresources.AlicePassword); err != nil {
+		t.Errorf("from other peer: err = %v; want nil", err)
+	}
+	if err := g.Clear(lockouts[0].Name); err != nil {
+		t.Fatalf("Clear(%q) = %v; want nil", lockouts[0].Name, err)
+	}
+	if err := users.Authenticate(ctx, testresources.Alice.Name, testresources.AlicePassword); err != nil {
+		t.Errorf("after Clear: err = %v; want nil", err)
+	}
+}
+
+func TestGuard_Check(t *testing.T) {
+	ctx := peerContext(context.Background(), "192.0.2.1")
+	g, _, clock, events := setup(ctx, t)
+	account := testresources.Bar_Alice.Name
+	for i := 0; i < g.Account.MaxFailures; i++ {
+		if err := g.Check(ctx, account); err != nil {
+			t.Fatalf("failure %d: Check(ctx, %q) = %v; want nil", i, account, err)
+		}
+		g.Failed(ctx, account)
+		clock.advance(g.Account.MaxDelay)
+	}
+	lockouts := g.Lockouts()
+	if len(lockouts) != 1 || lockouts[0].User != account {
+		t.Fatalf("Lockouts() = %+v; want a single lockout of %q", lockouts, account)
+	}
+	if err := g.Check(ctx, account); !errors.As(err, new(*Blocked)) {
+		t.Errorf("Check(ctx, %q) = %v; want Blocked", account, err)
+	}
+	if got, want := (*events)[len(*events)-1].Type, EventLoginBlocked; got != want {
+		t.Errorf("last event type = %v; want %v", got, want)
+	}
+	// Other accounts are not affected, and an empty account only checks the
+	// peer.
+	for _, other := range []string{testresources.Bar_Bob.Name, ""} {
+		if err := g.Check(ctx, other); err != nil {
+			t.Errorf("Check(ctx, %q) = %v; want nil", other, err)
+		}
+	}
+	// Failures without an account only count against the peer, which has
+	// also been charged with the failures of the account.
+	for i := g.Account.MaxFailures; i < g.Peer.MaxFailures; i++ {
+		g.Failed(ctx, "")
+	}
+	if err := g.Check(ctx, testresources.Bar_Bob.Name); !errors.As(err, new(*Blocked)) {
+		t.Errorf("after Failed(ctx, \"\"): Check(ctx, %q) = %v; want Blocked", testresources.Bar_Bob.Name, err)
+	}
+	// A success without an account does not reset the failures of the peer.
+	g.Succeeded(ctx, "")
+	if err := g.Check(ctx, ""); !errors.As(err, new(*Blocked)) {
+		t.Errorf("after Succeeded(ctx, \"\"): Check(ctx, \"\") = %v; want Blocked", err)
+	}
+}
+
+func TestGuard_Succeeded(t *testing.T) {
+	ctx := peerContext(context.Background(), "192.0.2.1")
+	g, _, _, _ := setup(ctx, t)
+	alice := testresources.Bar_Alice.Name
+	bob := testresources.Bar_Bob.Name
+	// Succeeding as another account does not reset the failures of the
+	// peer, which is locked out by its next failure.
+	for i := 1; i < g.Peer.MaxFailures; i++ {
+		g.Failed(ctx, alice)
+	}
+	g.Succeeded(ctx, bob)
+	g.Failed(ctx, alice)
+	if err := g.Check(ctx, bob); !errors.As(err, new(*Blocked)) {
+		t.Errorf("Check(ctx, %q) = %v; want Blocked", bob, err)
+	}
+	// Succeeding as the same account resets the failures of the peer.
+	other := peerContext(context.Background(), "192.0.2.2")
+	for i := 1; i < g.Peer.MaxFailures; i++ {
+		g.Failed(other, alice)
+	}
+	g.Succeeded(other, alice)
+	g.Failed(other, alice)
+	if got := g.peers["192.0.2.2"].failures; got != 1 {
+		t.Errorf("failures of peer after success and failure = %d; want 1", got)
+	}
+	if err := g.Check(other, bob); err != nil {
+		t.Errorf("Check(other, %q) = %v; want nil", bob, err)
+	}
+}
+
+func TestGuard_Clear_Errors(t *testing.T) {
+	g := NewGuard()
+	name := generateName()
+	if err := g.Clear(name); !errors.Is(err, &repositories.NotFound{Name: name}) {
+		t.Errorf("Clear(%q) = %v; want NotFound", name, err)
+	}
+	if err := g.Clear("users/alice"); err == nil {
+		t.Error("Clear(invalid name) = nil; want non-nil")
+	}
+}
+
+func TestPeerAddress(t *testing.T) {
+	gatewayPeer := &peer.Peer{Addr: bufAddr{}}
+	for _, test := range []struct {
+		desc string
+		ctx  context.Context
+		want string
+	}{
+		{
+			desc: "NoPeer",
+			ctx:  context.Background(),
+			want: "",
+		},
+		{
+			desc: "TCP",
+			ctx:  peerContext(context.Background(), "2001:db8::1"),
+			want: "2001:db8::1",
+		},
+		{
+			desc: "Gateway",
+			ctx: metadata.NewIncomingContext(
+				peer.NewContext(context.Background(), gatewayPeer),
+				metadata.Pairs("x-forwarded-for", "198.51.100.7, 192.0.2.1"),
+			),
+			want: "192.0.2.1",
+		},
+		{
+			// Callers connecting directly cannot claim other addresses.
+			desc: "ForwardedForOverTCP",
+			ctx: metadata.NewIncomingContext(
+				peerContext(context.Background(), "192.0.2.1"),
+				metadata.Pairs("x-forwarded-for", "198.51.100.7"),
+			),
+			want: "192.0.2.1",
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			if got := PeerAddress(test.ctx); got != test.want {
+				t.Errorf("PeerAddress(ctx) = %q; want %q", got, test.want)
+			}
+		})
+	}
+}
+
+// bufAddr is the address of an in-process connection.
+type bufAddr struct{}
+
+func (bufAddr) Network() string { return "bufconn" }
+func (bufAddr) String() string  { return "bufconn" }
@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"context"
+	"sync"
+)
+
+type contextKey struct{}
+
+// contextLogger is the Logger carried by a context. It is shared by all
+// contexts derived from the one created by NewContext, so that fields added
+// by AddField are seen by all of them.
+type contextLogger struct {
+	mu sync.Mutex
+	l  *Logger
+}
+
+// NewContext returns a copy of ctx carrying l.
+func NewContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, &contextLogger{l: l})
+}
+
+// FromContext returns the Logger carried by ctx. If ctx does not carry a
+// Logger, a Logger that discards all messages is returned.
+func FromContext(ctx context.Context) *Logger {
+	cl, ok := ctx.Value(contextKey{}).(*contextLogger)
+	if !ok {
+		return discard
+	}
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	return cl.l
+}
+
+// AddField adds the given key and value to the Logger carried by ctx, if any.
+// Unlike With, the field is also included in messages logged using the
+// contexts that ctx was derived from, such as the context of an interceptor
+// that called the handler with ctx.
+func AddField(ctx context.Context, key string, value interface{}) {
+	cl, ok := ctx.Value(contextKey{}).(*contextLogger)
+	if !ok {
+		return
+	}
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	cl.l = cl.l.With(key, value)
+}
@@ -0,0 +1,26 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestFromContext_NoLogger(t *testing.T) {
+	// Must not panic.
+	FromContext(context.Background()).Errorf("discarded")
+	AddField(context.Background(), "key", "value")
+}
+
+func TestAddField(t *testing.T) {
+	var buf bytes.Buffer
+	outer := NewContext(context.Background(), New(&buf, LevelInfo).With("request_id", "abc"))
+	inner, cancel := context.WithCancel(outer)
+	defer cancel()
+	AddField(inner, "principal", "kiosk-bar")
+	FromContext(outer).Infof("finished")
+	if got, want := buf.String(), "INFO finished request_id=abc principal=kiosk-bar\n"; !strings.HasSuffix(got, want) {
+		t.Errorf("output = %q; want suffix %q", got, want)
+	}
+}
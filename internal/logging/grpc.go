@@ -0,0 +1,126 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDKey is the metadata key carrying the ID of a request. Callers may
+// set it to correlate their own logs with those of the server; otherwise an
+// ID is generated. The ID is returned in the response trailers under the same
+// key.
+const RequestIDKey = "x-request-id"
+
+// maxRequestIDLength is the maximum length of request IDs set by callers.
+// Longer IDs are replaced by generated ones.
+const maxRequestIDLength = 128
+
+// requestID returns the request ID set by the caller of the RPC with the
+// given context, or a newly generated ID if the caller did not set a valid
+// one.
+func requestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ids := md.Get(RequestIDKey); len(ids) > 0 && ValidRequestID(ids[0]) {
+		return ids[0]
+	}
+	return NewRequestID()
+}
+
+// NewRequestID returns a new random request ID.
+func NewRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		// crypto/rand is not expected to fail, and an ID that is not unique
+		// only makes the logs harder to follow.
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// ValidRequestID reports whether id can be used as a request ID. Valid IDs
+// are non-empty, at most 128 characters long and consist of printable ASCII
+// characters other than space.
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// requestLogger returns a copy of ctx carrying a Logger, derived from l, with
+// the ID of the request, the method and the address of the caller as fields.
+func requestLogger(ctx context.Context, l *Logger, id, method string) context.Context {
+	l = l.With("request_id", id).With("method", method)
+	if p, ok := peer.FromContext(ctx); ok {
+		l = l.With("peer", p.Addr.String())
+	}
+	return NewContext(ctx, l)
+}
+
+// logRPC logs the completion of the RPC with the given context. RPCs failing
+// with codes that indicate a bug or an unhealthy server are logged as errors.
+func logRPC(ctx context.Context, start time.Time, err error) {
+	code := status.Code(err)
+	l := FromContext(ctx).With("code", code.String()).With("duration", time.Since(start).Seconds())
+	switch code {
+	case codes.Unknown, codes.Internal, codes.DataLoss:
+		l.Errorf("finished RPC: %v", err)
+	default:
+		l.Infof("finished RPC")
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor that gives each unary RPC a
+// request ID, returned to the caller in the trailers, and attaches a Logger
+// derived from l to the context of the RPC, which can be retrieved using
+// FromContext. The completion of each RPC is logged using that Logger.
+func UnaryServerInterceptor(l *Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		id := requestID(ctx)
+		if err := grpc.SetTrailer(ctx, metadata.Pairs(RequestIDKey, id)); err != nil {
+			return nil, err
+		}
+		ctx = requestLogger(ctx, l, id, info.FullMethod)
+		resp, err := handler(ctx, req)
+		logRPC(ctx, start, err)
+		return resp, err
+	}
+}
+
+// serverStream is a grpc.ServerStream with a different context.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamServerInterceptor is like UnaryServerInterceptor, but for streaming
+// RPCs.
+func StreamServerInterceptor(l *Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		id := requestID(ss.Context())
+		ss.SetTrailer(metadata.Pairs(RequestIDKey, id))
+		ctx := requestLogger(ss.Context(), l, id, info.FullMethod)
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		logRPC(ctx, start, err)
+		return err
+	}
+}
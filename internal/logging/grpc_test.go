@@ -0,0 +1,181 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+// fakeServer logs a message using the Logger of the context and fails GetUser
+// with an internal error and ExportStore with a not found error.
+type fakeServer struct {
+	pb.UnimplementedStreckUServer
+}
+
+func (fakeServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	AddField(ctx, "principal", "kiosk-bar")
+	FromContext(ctx).Infof("getting user")
+	return nil, status.Error(codes.Internal, "internal error")
+}
+
+func (fakeServer) ExportStore(req *pb.ExportStoreRequest, stream pb.StreckU_ExportStoreServer) error {
+	FromContext(stream.Context()).Infof("exporting store")
+	return status.Error(codes.NotFound, "not found")
+}
+
+// syncBuffer is a bytes.Buffer that is safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// entries returns the JSON log entries written to b, without their times.
+func (b *syncBuffer) entries(t *testing.T) []map[string]interface{} {
+	t.Helper()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSuffix(b.buf.String(), "\n"), "\n") {
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("json.Unmarshal(%q) = %v", line, err)
+		}
+		delete(entry, "time")
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func serveAndDial(ctx context.Context, t *testing.T, l *Logger) pb.StreckUClient {
+	t.Helper()
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryServerInterceptor(l)),
+		grpc.StreamInterceptor(StreamServerInterceptor(l)),
+	)
+	pb.RegisterStreckUServer(srv, fakeServer{})
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			t.Errorf("srv.Serve(%v) = %v; want nil", lis, err)
+		}
+	}()
+	t.Cleanup(srv.GracefulStop)
+	dial := func(context.Context, string) (net.Conn, error) { return lis.Dial() }
+	cc, err := grpc.DialContext(ctx, "bufconn", grpc.WithInsecure(), grpc.WithContextDialer(dial))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := cc.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	return pb.NewStreckUClient(cc)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	ctx := context.Background()
+	var buf syncBuffer
+	client := serveAndDial(ctx, t, NewWithFormat(&buf, LevelInfo, FormatJSON))
+
+	for _, test := range []struct {
+		desc   string
+		id     string
+		wantID func(string) bool
+	}{
+		{desc: "CallerID", id: "kiosk-1234", wantID: func(id string) bool { return id == "kiosk-1234" }},
+		{desc: "Generated", id: "", wantID: func(id string) bool { return len(id) == 32 }},
+		{desc: "InvalidCallerID", id: "two words", wantID: func(id string) bool { return len(id) == 32 }},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			buf.mu.Lock()
+			buf.buf.Reset()
+			buf.mu.Unlock()
+			callCtx := ctx
+			if test.id != "" {
+				callCtx = metadata.AppendToOutgoingContext(ctx, RequestIDKey, test.id)
+			}
+			var trailer metadata.MD
+			_, err := client.GetUser(callCtx, &pb.GetUserRequest{Name: "users/alice"}, grpc.Trailer(&trailer))
+			if got, want := status.Code(err), codes.Internal; got != want {
+				t.Fatalf("GetUser: code = %v; want %v", got, want)
+			}
+			ids := trailer.Get(RequestIDKey)
+			if len(ids) != 1 || !test.wantID(ids[0]) {
+				t.Fatalf("trailer[%q] = %q; want a single valid ID", RequestIDKey, ids)
+			}
+			entries := buf.entries(t)
+			if len(entries) != 2 {
+				t.Fatalf("got %d log entries (%v); want 2", len(entries), entries)
+			}
+			for _, entry := range entries {
+				if got := entry["request_id"]; got != ids[0] {
+					t.Errorf("entry %v: request_id = %v; want %q", entry, got, ids[0])
+				}
+				if got, want := entry["method"], "/saser.strecku.v1.StreckU/GetUser"; got != want {
+					t.Errorf("entry %v: method = %v; want %q", entry, got, want)
+				}
+			}
+			// The principal added by the handler is included in the
+			// completion entry.
+			last := entries[1]
+			for key, want := range map[string]interface{}{
+				"level":     "error",
+				"code":      "Internal",
+				"principal": "kiosk-bar",
+			} {
+				if got := last[key]; got != want {
+					t.Errorf("completion entry %v: %s = %v; want %v", last, key, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	ctx := context.Background()
+	var buf syncBuffer
+	client := serveAndDial(ctx, t, NewWithFormat(&buf, LevelInfo, FormatJSON))
+
+	stream, err := client.ExportStore(metadata.AppendToOutgoingContext(ctx, RequestIDKey, "export-1"), &pb.ExportStoreRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.NotFound {
+		t.Fatalf("stream.Recv() err = %v; want code %v", err, codes.NotFound)
+	}
+	if got, want := stream.Trailer().Get(RequestIDKey), []string{"export-1"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("trailer[%q] = %q; want %q", RequestIDKey, got, want)
+	}
+	entries := buf.entries(t)
+	if len(entries) != 2 {
+		t.Fatalf("got %d log entries (%v); want 2", len(entries), entries)
+	}
+	for _, entry := range entries {
+		if got, want := entry["request_id"], "export-1"; got != want {
+			t.Errorf("entry %v: request_id = %v; want %q", entry, got, want)
+		}
+	}
+	if got, want := entries[1]["level"], "info"; got != want {
+		t.Errorf("completion entry %v: level = %v; want %q", entries[1], got, want)
+	}
+}
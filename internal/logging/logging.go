@@ -0,0 +1,243 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is the severity of a log message. Messages below the level of a Logger
+// are discarded.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+var levelNames = []string{
+	LevelDebug: "debug",
+	LevelInfo:  "info",
+	LevelWarn:  "warn",
+	LevelError: "error",
+}
+
+func (l Level) String() string {
+	if l < 0 || int(l) >= len(levelNames) {
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+	return levelNames[l]
+}
+
+// UnknownLevelError is returned by ParseLevel for unknown level names.
+type UnknownLevelError struct {
+	Name string
+}
+
+func (e *UnknownLevelError) Error() string {
+	return fmt.Sprintf("unknown log level %q; want one of %s", e.Name, strings.Join(levelNames, ", "))
+}
+
+func (e *UnknownLevelError) Is(target error) bool {
+	other, ok := target.(*UnknownLevelError)
+	return ok && e.Name == other.Name
+}
+
+// ParseLevel returns the level with the given name, such as "info".
+func ParseLevel(name string) (Level, error) {
+	for l, n := range levelNames {
+		if strings.EqualFold(name, n) {
+			return Level(l), nil
+		}
+	}
+	return 0, &UnknownLevelError{Name: name}
+}
+
+// Format is the format that a Logger writes messages in.
+type Format int
+
+const (
+	// FormatText writes each message on a line prefixed by the time and
+	// level, followed by the fields as key=value pairs.
+	FormatText Format = iota
+	// FormatJSON writes each message as a JSON object on a line of its own,
+	// with the time, level and message in the "time", "level" and "msg" keys
+	// and the fields in keys of their own.
+	FormatJSON
+)
+
+var formatNames = []string{
+	FormatText: "text",
+	FormatJSON: "json",
+}
+
+func (f Format) String() string {
+	if f < 0 || int(f) >= len(formatNames) {
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+	return formatNames[f]
+}
+
+// UnknownFormatError is returned by ParseFormat for unknown format names.
+type UnknownFormatError struct {
+	Name string
+}
+
+func (e *UnknownFormatError) Error() string {
+	return fmt.Sprintf("unknown log format %q; want one of %s", e.Name, strings.Join(formatNames, ", "))
+}
+
+func (e *UnknownFormatError) Is(target error) bool {
+	other, ok := target.(*UnknownFormatError)
+	return ok && e.Name == other.Name
+}
+
+// ParseFormat returns the format with the given name, such as "json".
+func ParseFormat(name string) (Format, error) {
+	for f, n := range formatNames {
+		if strings.EqualFold(name, n) {
+			return Format(f), nil
+		}
+	}
+	return 0, &UnknownFormatError{Name: name}
+}
+
+// field is a key-value pair included in every message of a Logger.
+type field struct {
+	key   string
+	value interface{}
+}
+
+// output is the destination shared by a Logger and the Loggers derived from
+// it using With.
+type output struct {
+	format Format
+	l      *log.Logger // used for FormatText
+
+	mu  sync.Mutex // protects w
+	w   io.Writer  // used for FormatJSON
+	now func() time.Time
+}
+
+// Logger writes log messages at or above a minimum level.
+type Logger struct {
+	level  Level
+	out    *output
+	fields []field
+}
+
+// discard is a Logger that discards all messages.
+var discard = New(ioutil.Discard, LevelError+1)
+
+// New returns a Logger writing messages at or above level to w, in the text
+// format.
+func New(w io.Writer, level Level) *Logger {
+	return NewWithFormat(w, level, FormatText)
+}
+
+// NewWithFormat returns a Logger writing messages at or above level to w, in
+// the given format.
+func NewWithFormat(w io.Writer, level Level, format Format) *Logger {
+	return &Logger{
+		level: level,
+		out: &output{
+			format: format,
+			l:      log.New(w, "", log.LstdFlags),
+			w:      w,
+			now:    time.Now,
+		},
+	}
+}
+
+// With returns a Logger that includes the given key and value in every
+// message, in addition to the fields of l. Values that are errors are
+// written using their Error method.
+func (l *Logger) With(key string, value interface{}) *Logger {
+	fields := make([]field, len(l.fields), len(l.fields)+1)
+	copy(fields, l.fields)
+	return &Logger{
+		level:  l.level,
+		out:    l.out,
+		fields: append(fields, field{key: key, value: value}),
+	}
+}
+
+func (l *Logger) logf(level Level, format string, v ...interface{}) {
+	if level < l.level {
+		return
+	}
+	msg := fmt.Sprintf(format, v...)
+	switch l.out.format {
+	case FormatJSON:
+		l.writeJSON(level, msg)
+	default:
+		l.writeText(level, msg)
+	}
+}
+
+func (l *Logger) writeText(level Level, msg string) {
+	var b strings.Builder
+	b.WriteString(strings.ToUpper(level.String()))
+	b.WriteString(" ")
+	b.WriteString(msg)
+	for _, f := range l.fields {
+		s := fmt.Sprint(fieldValue(f.value))
+		if s == "" || strings.ContainsAny(s, " =\"\n") {
+			s = strconv.Quote(s)
+		}
+		fmt.Fprintf(&b, " %s=%s", f.key, s)
+	}
+	// The call depth of 4 makes the standard logger attribute the message
+	// to the caller of Debugf, Infof and so on.
+	_ = l.out.l.Output(4, b.String())
+}
+
+func (l *Logger) writeJSON(level Level, msg string) {
+	var b bytes.Buffer
+	b.WriteString("{")
+	writeJSONPair(&b, "time", l.out.now().Format(time.RFC3339Nano))
+	b.WriteString(",")
+	writeJSONPair(&b, "level", level.String())
+	b.WriteString(",")
+	writeJSONPair(&b, "msg", msg)
+	for _, f := range l.fields {
+		b.WriteString(",")
+		writeJSONPair(&b, f.key, fieldValue(f.value))
+	}
+	b.WriteString("}\n")
+	l.out.mu.Lock()
+	defer l.out.mu.Unlock()
+	_, _ = l.out.w.Write(b.Bytes())
+}
+
+func writeJSONPair(b *bytes.Buffer, key string, value interface{}) {
+	k, _ := json.Marshal(key)
+	v, err := json.Marshal(value)
+	if err != nil {
+		v, _ = json.Marshal(fmt.Sprint(value))
+	}
+	b.Write(k)
+	b.WriteString(":")
+	b.Write(v)
+}
+
+func fieldValue(v interface{}) interface{} {
+	if err, ok := v.(error); ok {
+		return err.Error()
+	}
+	return v
+}
+
+func (l *Logger) Debugf(format string, v ...interface{}) { l.logf(LevelDebug, format, v...) }
+func (l *Logger) Infof(format string, v ...interface{})  { l.logf(LevelInfo, format, v...) }
+func (l *Logger) Warnf(format string, v ...interface{})  { l.logf(LevelWarn, format, v...) }
+func (l *Logger) Errorf(format string, v ...interface{}) { l.logf(LevelError, format, v...) }
@@ -0,0 +1,105 @@
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParseLevel(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		want    Level
+		wantErr error
+	}{
+		{name: "debug", want: LevelDebug},
+		{name: "info", want: LevelInfo},
+		{name: "WARN", want: LevelWarn},
+		{name: "error", want: LevelError},
+		{name: "", wantErr: &UnknownLevelError{Name: ""}},
+		{name: "verbose", wantErr: &UnknownLevelError{Name: "verbose"}},
+	} {
+		got, err := ParseLevel(test.name)
+		if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+			t.Errorf("ParseLevel(%q) err = %v; want %v", test.name, err, test.wantErr)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParseLevel(%q) = %v; want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, LevelWarn)
+	l.Debugf("debug %d", 1)
+	l.Infof("info %d", 2)
+	l.Warnf("warn %d", 3)
+	l.Errorf("error %d", 4)
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines (%q); want 2", len(lines), lines)
+	}
+	for i, want := range []string{"WARN warn 3", "ERROR error 4"} {
+		if !strings.HasSuffix(lines[i], want) {
+			t.Errorf("lines[%d] = %q; want suffix %q", i, lines[i], want)
+		}
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		want    Format
+		wantErr error
+	}{
+		{name: "text", want: FormatText},
+		{name: "JSON", want: FormatJSON},
+		{name: "", wantErr: &UnknownFormatError{Name: ""}},
+		{name: "logfmt", wantErr: &UnknownFormatError{Name: "logfmt"}},
+	} {
+		got, err := ParseFormat(test.name)
+		if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+			t.Errorf("ParseFormat(%q) err = %v; want %v", test.name, err, test.wantErr)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParseFormat(%q) = %v; want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestLogger_With(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, LevelInfo)
+	l.With("request_id", "abc").With("err", errors.New("no such user")).With("empty", "").Infof("message")
+	// Fields added to derived Loggers do not affect l.
+	l.Infof("plain")
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines (%q); want 2", len(lines), lines)
+	}
+	for i, want := range []string{`INFO message request_id=abc err="no such user" empty=""`, "INFO plain"} {
+		if !strings.HasSuffix(lines[i], want) {
+			t.Errorf("lines[%d] = %q; want suffix %q", i, lines[i], want)
+		}
+	}
+}
+
+func TestLogger_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewWithFormat(&buf, LevelInfo, FormatJSON)
+	l.out.now = func() time.Time { return time.Date(2020, 11, 1, 12, 0, 0, 0, time.UTC) }
+	l.Debugf("debug")
+	l.With("request_id", "abc").With("duration", 0.5).With("err", errors.New("boom")).Errorf("failed: %d", 42)
+	want := `{"time":"2020-11-01T12:00:00Z","level":"error","msg":"failed: 42","request_id":"abc","duration":0.5,"err":"boom"}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("output = %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,179 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Message is a plain-text email message.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends email messages.
+type Mailer interface {
+	// Send sends m from the address that the Mailer was configured with.
+	Send(ctx context.Context, m Message) error
+}
+
+// format returns m as an RFC 5322 message from the given address, sent at the
+// given time.
+func format(from string, m Message, date time.Time) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", m.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(m.Body, "\r\n", "\n"), "\n", "\r\n"))
+	return b.Bytes()
+}
+
+// validate returns an error if m cannot be sent, such as when the recipient
+// contains line breaks that would inject headers.
+func validate(m Message) error {
+	if m.To == "" {
+		return errors.New("mail: no recipient")
+	}
+	if strings.ContainsAny(m.To+m.Subject, "\r\n") {
+		return errors.New("mail: line break in header")
+	}
+	return nil
+}
+
+// SMTP sends messages through an SMTP server, using STARTTLS if the server
+// supports it.
+type SMTP struct {
+	// Address is the host and port of the server, such as
+	// "smtp.example.com:587".
+	Address string
+	// From is the address that messages are sent from.
+	From string
+	// Username and Password are used for PLAIN authentication, if Username
+	// is not empty.
+	Username string
+	Password string
+}
+
+var _ Mailer = (*SMTP)(nil)
+
+func (s *SMTP) Send(ctx context.Context, m Message) error {
+	if err := validate(m); err != nil {
+		return err
+	}
+	var auth smtp.Auth
+	if s.Username != "" {
+		host, _, err := net.SplitHostPort(s.Address)
+		if err != nil {
+			return fmt.Errorf("mail: %w", err)
+		}
+		auth = smtp.PlainAuth("", s.Username, s.Password, host)
+	}
+	// smtp.SendMail does not take a context, so the message is sent in the
+	// background and abandoned if ctx is done first.
+	errc := make(chan error, 1)
+	go func() {
+		errc <- smtp.SendMail(s.Address, auth, s.From, []string{m.To}, format(s.From, m, time.Now()))
+	}()
+	select {
+	case err := <-errc:
+		if err != nil {
+			return fmt.Errorf("mail: send to %s: %w", s.Address, err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Writer writes messages to an io.Writer, such as a file or standard error,
+// separated by blank lines. It is meant for local development, where the
+// messages can be read without an SMTP server.
+type Writer struct {
+	from string
+
+	mu sync.Mutex // protects w
+	w  io.Writer
+}
+
+var _ Mailer = (*Writer)(nil)
+
+// NewWriter returns a Writer that writes messages from the given address to w.
+func NewWriter(w io.Writer, from string) *Writer {
+	return &Writer{from: from, w: w}
+}
+
+// OpenFile returns a Writer that appends messages from the given address to
+// the named file, creating it if necessary. The returned file must be closed
+// when the Writer is no longer used.
+func OpenFile(name string, from string) (*Writer, *os.File, error) {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, nil, err
+	}
+	return NewWriter(f, from), f, nil
+}
+
+func (w *Writer) Send(_ context.Context, m Message) error {
+	if err := validate(m); err != nil {
+		return err
+	}
+	data := append(format(w.from, m, time.Now()), "\r\n\r\n"...)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, err := w.w.Write(data); err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+	return nil
+}
+
+// Memory keeps sent messages in memory, so that tests can inspect them.
+type Memory struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+var _ Mailer = (*Memory)(nil)
+
+func (m *Memory) Send(_ context.Context, msg Message) error {
+	if err := validate(msg); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+// Messages returns the messages sent so far, in the order they were sent.
+func (m *Memory) Messages() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Message(nil), m.messages...)
+}
+
+// Last returns the last message sent to the given address.
+func (m *Memory) Last(to string) (Message, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := len(m.messages) - 1; i >= 0; i-- {
+		if m.messages[i].To == to {
+			return m.messages[i], true
+		}
+	}
+	return Message{}, false
+}
@@ -0,0 +1,135 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+func TestWriter_Send(t *testing.T) {
+	var b bytes.Buffer
+	w := NewWriter(&b, "strecku@example.com")
+	m := Message{
+		To:      "alice@example.com",
+		Subject: "Hej då",
+		Body:    "first line\nsecond line\n",
+	}
+	if err := w.Send(context.Background(), m); err != nil {
+		t.Fatalf("w.Send(ctx, %+v) = %v; want nil", m, err)
+	}
+	got := b.String()
+	for _, want := range []string{
+		"From: strecku@example.com\r\n",
+		"To: alice@example.com\r\n",
+		"Subject: =?utf-8?q?Hej_d=C3=A5?=\r\n",
+		"\r\n\r\nfirst line\r\nsecond line\r\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("written message %q does not contain %q", got, want)
+		}
+	}
+}
+
+func TestMailers_Invalid(t *testing.T) {
+	for _, m := range []Message{
+		{To: "", Subject: "subject"},
+		{To: "alice@example.com\r\nBcc: eve@example.com", Subject: "subject"},
+		{To: "alice@example.com", Subject: "subject\nBcc: eve@example.com"},
+	} {
+		for _, mailer := range []Mailer{
+			NewWriter(new(bytes.Buffer), "strecku@example.com"),
+			new(Memory),
+			&SMTP{Address: "localhost:0", From: "strecku@example.com"},
+		} {
+			if err := mailer.Send(context.Background(), m); err == nil {
+				t.Errorf("%T.Send(ctx, %+v) = nil; want non-nil", mailer, m)
+			}
+		}
+	}
+}
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	m := new(Memory)
+	if _, ok := m.Last("alice@example.com"); ok {
+		t.Error("m.Last(alice) ok = true before any messages; want false")
+	}
+	for _, msg := range []Message{
+		{To: "alice@example.com", Subject: "first"},
+		{To: "bob@example.com", Subject: "second"},
+		{To: "alice@example.com", Subject: "third"},
+	} {
+		if err := m.Send(ctx, msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := len(m.Messages()), 3; got != want {
+		t.Errorf("len(m.Messages()) = %d; want %d", got, want)
+	}
+	if got, ok := m.Last("alice@example.com"); !ok || got.Subject != "third" {
+		t.Errorf("m.Last(alice) = %+v, %v; want subject %q", got, ok, "third")
+	}
+}
+
+// serveSMTP accepts a single SMTP session on a new listener and sends the
+// received message data on the returned channel.
+func serveSMTP(t *testing.T) (string, <-chan string) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lis.Close() })
+	data := make(chan string, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		c := textproto.NewConn(conn)
+		_ = c.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := c.ReadLine()
+			if err != nil {
+				return
+			}
+			switch cmd := strings.ToUpper(strings.Fields(line + " ")[0]); cmd {
+			case "EHLO", "HELO":
+				_ = c.PrintfLine("250 localhost")
+			case "DATA":
+				_ = c.PrintfLine("354 go ahead")
+				lines, err := c.ReadDotLines()
+				if err != nil {
+					return
+				}
+				data <- strings.Join(lines, "\n")
+				_ = c.PrintfLine("250 ok")
+			case "QUIT":
+				_ = c.PrintfLine("221 bye")
+				return
+			default:
+				_ = c.PrintfLine("250 ok")
+			}
+		}
+	}()
+	return lis.Addr().String(), data
+}
+
+func TestSMTP_Send(t *testing.T) {
+	addr, data := serveSMTP(t)
+	s := &SMTP{Address: addr, From: "strecku@example.com"}
+	m := Message{To: "alice@example.com", Subject: "Reset your password", Body: "token"}
+	if err := s.Send(context.Background(), m); err != nil {
+		t.Fatalf("s.Send(ctx, %+v) = %v; want nil", m, err)
+	}
+	got := <-data
+	for _, want := range []string{"To: alice@example.com", "Subject: Reset your password", "\ntoken"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("received message %q does not contain %q", got, want)
+		}
+	}
+}
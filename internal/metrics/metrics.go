@@ -0,0 +1,187 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/payments"
+	"github.com/Saser/strecku/resources/stores/purchases"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "strecku"
+
+// Full names of the methods that business events are recorded for.
+const (
+	methodCreatePurchase = "/saser.strecku.v1.StreckU/CreatePurchase"
+	methodCreatePayment  = "/saser.strecku.v1.StreckU/CreatePayment"
+)
+
+// Metrics records metrics about RPCs, repository operations and business
+// events of the StreckU server.
+type Metrics struct {
+	requests           *prometheus.CounterVec
+	requestDuration    *prometheus.HistogramVec
+	repositoryDuration *prometheus.HistogramVec
+	purchases          *prometheus.CounterVec
+	revenue            *prometheus.CounterVec
+	payments           *prometheus.CounterVec
+	paymentAmount      *prometheus.CounterVec
+}
+
+// New returns Metrics whose collectors have been registered with reg.
+func New(reg prometheus.Registerer) (*Metrics, error) {
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "Number of completed RPCs, by service, method and status code.",
+		}, []string{"service", "method", "code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "Time taken to handle RPCs, by service and method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"service", "method"}),
+		repositoryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "repository",
+			Name:      "operation_duration_seconds",
+			Help:      "Time taken by repository operations, by repository and operation.",
+			Buckets:   []float64{.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		}, []string{"repository", "operation"}),
+		purchases: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "purchases_created_total",
+			Help:      "Number of purchases created, by store.",
+		}, []string{"store"}),
+		revenue: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "revenue_cents_total",
+			Help:      "Total amount of created purchases in cents, by store.",
+		}, []string{"store"}),
+		payments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "payments_registered_total",
+			Help:      "Number of payments registered, by store.",
+		}, []string{"store"}),
+		paymentAmount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "payments_cents_total",
+			Help:      "Total amount of registered payments in cents, by store.",
+		}, []string{"store"}),
+	}
+	for _, c := range []prometheus.Collector{
+		m.requests,
+		m.requestDuration,
+		m.repositoryDuration,
+		m.purchases,
+		m.revenue,
+		m.payments,
+		m.paymentAmount,
+	} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// splitMethod splits a full method name such as
+// "/saser.strecku.v1.StreckU/GetUser" into its service and method.
+func splitMethod(fullMethod string) (service, method string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+func (m *Metrics) observeRequest(fullMethod string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	m.requests.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	m.requestDuration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+}
+
+// observeEvent records the business event, if any, of a successful RPC.
+func (m *Metrics) observeEvent(fullMethod string, resp interface{}) {
+	switch fullMethod {
+	case methodCreatePurchase:
+		purchase, ok := resp.(*pb.Purchase)
+		if !ok {
+			return
+		}
+		store, err := purchases.Parent(purchase.Name)
+		if err != nil {
+			return
+		}
+		// Prices of charges are non-positive, as they are debited from the
+		// member. Deposits are paid back, so they are not revenue.
+		var cents int64
+		for _, line := range purchase.Lines {
+			switch purchases.LineKind(line) {
+			case pb.Purchase_Line_DEPOSIT, pb.Purchase_Line_DEPOSIT_RETURN:
+				continue
+			}
+			cents -= int64(line.Quantity) * line.PriceCents
+		}
+		m.purchases.WithLabelValues(store).Inc()
+		if cents > 0 {
+			m.revenue.WithLabelValues(store).Add(float64(cents))
+		}
+	case methodCreatePayment:
+		payment, ok := resp.(*pb.Payment)
+		if !ok {
+			return
+		}
+		store, err := payments.Parent(payment.Name)
+		if err != nil {
+			return
+		}
+		m.payments.WithLabelValues(store).Inc()
+		if payment.AmountCents > 0 {
+			m.paymentAmount.WithLabelValues(store).Add(float64(payment.AmountCents))
+		}
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor that records the status code
+// and latency of unary RPCs, along with the business events of successful
+// RPCs.
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.observeRequest(info.FullMethod, start, err)
+		if err == nil {
+			m.observeEvent(info.FullMethod, resp)
+		}
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns an interceptor that records the status code
+// and latency of streaming RPCs.
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		m.observeRequest(info.FullMethod, start, err)
+		return err
+	}
+}
+
+// timeRepository starts timing an operation of a repository, returning a
+// function to call when the operation has completed.
+func (m *Metrics) timeRepository(repository, operation string) func() {
+	start := time.Now()
+	return func() {
+		m.repositoryDuration.WithLabelValues(repository, operation).Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,144 @@
+package metrics
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+const bufSize = 1024 * 1024
+
+// fakeServer returns fixed resources from the Create methods, and fails
+// GetUser.
+type fakeServer struct {
+	pb.UnimplementedStreckUServer
+}
+
+func (fakeServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	return nil, status.Error(codes.NotFound, "not found")
+}
+
+func (fakeServer) CreatePurchase(ctx context.Context, req *pb.CreatePurchaseRequest) (*pb.Purchase, error) {
+	return proto.Clone(testresources.Bar_Alice_Beer2_Cocktail2).(*pb.Purchase), nil
+}
+
+func (fakeServer) UpdatePurchase(ctx context.Context, req *pb.UpdatePurchaseRequest) (*pb.Purchase, error) {
+	return proto.Clone(testresources.Bar_Alice_Beer2_Cocktail2).(*pb.Purchase), nil
+}
+
+func (fakeServer) CreatePayment(ctx context.Context, req *pb.CreatePaymentRequest) (*pb.Payment, error) {
+	return proto.Clone(testresources.Bar_Alice_Payment).(*pb.Payment), nil
+}
+
+func (fakeServer) ExportStore(req *pb.ExportStoreRequest, stream pb.StreckU_ExportStoreServer) error {
+	return stream.Send(&pb.ExportStoreResponse{Data: []byte("header\n")})
+}
+
+func serveAndDial(ctx context.Context, t *testing.T, m *Metrics) pb.StreckUClient {
+	t.Helper()
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(m.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(m.StreamServerInterceptor()),
+	)
+	pb.RegisterStreckUServer(srv, fakeServer{})
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			t.Errorf("srv.Serve(%v) = %v; want nil", lis, err)
+		}
+	}()
+	t.Cleanup(srv.GracefulStop)
+	dial := func(context.Context, string) (net.Conn, error) { return lis.Dial() }
+	cc, err := grpc.DialContext(ctx, "bufconn", grpc.WithInsecure(), grpc.WithContextDialer(dial))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := cc.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	return pb.NewStreckUClient(cc)
+}
+
+func TestNew_DuplicateRegistration(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	if _, err := New(reg); err != nil {
+		t.Fatalf("New(reg) err = %v; want nil", err)
+	}
+	if _, err := New(reg); err == nil {
+		t.Error("second New(reg) err = nil; want non-nil")
+	}
+}
+
+func TestInterceptors(t *testing.T) {
+	ctx := context.Background()
+	m, err := New(prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := serveAndDial(ctx, t, m)
+
+	if _, err := client.GetUser(ctx, &pb.GetUserRequest{}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetUser: err = %v; want code %v", err, codes.NotFound)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := client.CreatePurchase(ctx, &pb.CreatePurchaseRequest{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Updates are not business events.
+	if _, err := client.UpdatePurchase(ctx, &pb.UpdatePurchaseRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreatePayment(ctx, &pb.CreatePaymentRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	stream, err := client.ExportStore(ctx, &pb.ExportStoreRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if _, err := stream.Recv(); err != nil {
+			break
+		}
+	}
+
+	const service = "saser.strecku.v1.StreckU"
+	bar := testresources.Bar.Name
+	var revenue int64
+	for _, line := range testresources.Bar_Alice_Beer2_Cocktail2.Lines {
+		revenue -= int64(line.Quantity) * line.PriceCents
+	}
+	for _, test := range []struct {
+		desc      string
+		collector prometheus.Collector
+		want      float64
+	}{
+		{desc: "GetUser NotFound", collector: m.requests.WithLabelValues(service, "GetUser", "NotFound"), want: 1},
+		{desc: "CreatePurchase OK", collector: m.requests.WithLabelValues(service, "CreatePurchase", "OK"), want: 2},
+		{desc: "ExportStore OK", collector: m.requests.WithLabelValues(service, "ExportStore", "OK"), want: 1},
+		{desc: "purchases", collector: m.purchases.WithLabelValues(bar), want: 2},
+		{desc: "revenue", collector: m.revenue.WithLabelValues(bar), want: float64(2 * revenue)},
+		{desc: "payments", collector: m.payments.WithLabelValues(bar), want: 1},
+		{desc: "payment amount", collector: m.paymentAmount.WithLabelValues(bar), want: float64(testresources.Bar_Alice_Payment.AmountCents)},
+	} {
+		if got := testutil.ToFloat64(test.collector); got != test.want {
+			t.Errorf("%s = %v; want %v", test.desc, got, test.want)
+		}
+	}
+	// One latency series per method that has been called.
+	if got, want := testutil.CollectAndCount(m.requestDuration), 5; got != want {
+		t.Errorf("number of latency series = %d; want %d", got, want)
+	}
+}
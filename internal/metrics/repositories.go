@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"context"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/repositories"
+)
+
+// RepositoryTimer returns a function that records the latency of the
+// operations of the named repository, for use as the Timer of the repositories
+// of store resources, such as products.Repository.
+func (m *Metrics) RepositoryTimer(repository string) func(operation string) func() {
+	return func(operation string) func() {
+		return m.timeRepository(repository, operation)
+	}
+}
+
+// Users returns a repository that records the latency of each operation of r.
+func (m *Metrics) Users(r repositories.Users) repositories.Users {
+	return &users{m: m, r: r}
+}
+
+type users struct {
+	m *Metrics
+	r repositories.Users
+}
+
+func (u *users) Authenticate(ctx context.Context, name string, password string) error {
+	defer u.m.timeRepository("users", "Authenticate")()
+	return u.r.Authenticate(ctx, name, password)
+}
+
+func (u *users) Lookup(ctx context.Context, name string) (*pb.User, error) {
+	defer u.m.timeRepository("users", "Lookup")()
+	return u.r.Lookup(ctx, name)
+}
+
+func (u *users) ResolveEmail(ctx context.Context, emailAddress string) (string, error) {
+	defer u.m.timeRepository("users", "ResolveEmail")()
+	return u.r.ResolveEmail(ctx, emailAddress)
+}
+
+func (u *users) List(ctx context.Context) ([]*pb.User, error) {
+	defer u.m.timeRepository("users", "List")()
+	return u.r.List(ctx)
+}
+
+func (u *users) Create(ctx context.Context, user *pb.User, password string) error {
+	defer u.m.timeRepository("users", "Create")()
+	return u.r.Create(ctx, user, password)
+}
+
+func (u *users) Update(ctx context.Context, user *pb.User) error {
+	defer u.m.timeRepository("users", "Update")()
+	return u.r.Update(ctx, user)
+}
+
+func (u *users) SetPassword(ctx context.Context, name string, password string) error {
+	defer u.m.timeRepository("users", "SetPassword")()
+	return u.r.SetPassword(ctx, name, password)
+}
+
+func (u *users) Delete(ctx context.Context, name string) error {
+	defer u.m.timeRepository("users", "Delete")()
+	return u.r.Delete(ctx, name)
+}
+
+// Stores returns a repository that records the latency of each operation of
+// r.
+func (m *Metrics) Stores(r repositories.Stores) repositories.Stores {
+	return &stores{m: m, r: r}
+}
+
+type stores struct {
+	m *Metrics
+	r repositories.Stores
+}
+
+func (s *stores) Lookup(ctx context.Context, name string) (*pb.Store, error) {
+	defer s.m.timeRepository("stores", "Lookup")()
+	return s.r.Lookup(ctx, name)
+}
+
+func (s *stores) List(ctx context.Context) ([]*pb.Store, error) {
+	defer s.m.timeRepository("stores", "List")()
+	return s.r.List(ctx)
+}
+
+func (s *stores) Create(ctx context.Context, store *pb.Store) error {
+	defer s.m.timeRepository("stores", "Create")()
+	return s.r.Create(ctx, store)
+}
+
+func (s *stores) Update(ctx context.Context, store *pb.Store) error {
+	defer s.m.timeRepository("stores", "Update")()
+	return s.r.Update(ctx, store)
+}
+
+func (s *stores) Delete(ctx context.Context, name string) error {
+	defer s.m.timeRepository("stores", "Delete")()
+	return s.r.Delete(ctx, name)
+}
@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/resources/stores/products"
+	"github.com/Saser/strecku/testresources"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRepositories(t *testing.T) {
+	ctx := context.Background()
+	m, err := New(prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	userRepo := m.Users(repositories.NewInMemoryUsers())
+	if err := userRepo.Create(ctx, testresources.Alice, testresources.AlicePassword); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := userRepo.Lookup(ctx, testresources.Alice.Name); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := userRepo.Lookup(ctx, testresources.Bob.Name); err == nil {
+		t.Error("Lookup of missing user: err = nil; want non-nil")
+	}
+	storeRepo := m.Stores(repositories.NewInMemoryStores())
+	if _, err := storeRepo.List(ctx); err != nil {
+		t.Fatal(err)
+	}
+	productRepo := products.NewRepository()
+	productRepo.Timer = m.RepositoryTimer("products")
+	if _, err := productRepo.LookupProduct(ctx, testresources.Beer.Name); err == nil {
+		t.Error("LookupProduct of missing product: err = nil; want non-nil")
+	}
+	// Listing is timed as a single operation.
+	if _, err := productRepo.ListProducts(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// Each operation is timed, regardless of whether it succeeded.
+	if got, want := testutil.CollectAndCount(m.repositoryDuration), 5; got != want {
+		t.Errorf("number of latency series = %d; want %d", got, want)
+	}
+}
@@ -0,0 +1,65 @@
+package mux
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"google.golang.org/grpc"
+)
+
+// Handler serves gRPC and gRPC-Web requests using a gRPC server and all other
+// requests using a fallback handler, so that they can be served on the same
+// port.
+type Handler struct {
+	grpcServer *grpc.Server
+	grpcWeb    *grpcweb.WrappedGrpcServer
+	fallback   http.Handler
+	// active counts the gRPC and gRPC-Web requests being served.
+	active sync.WaitGroup
+}
+
+// New returns a Handler serving gRPC requests using grpcServer and all other
+// requests using fallback. gRPC-Web requests, in both the binary and the text
+// format, are translated into gRPC requests, and are configured using
+// webOptions. Server-streaming RPCs are supported over gRPC-Web.
+func New(grpcServer *grpc.Server, fallback http.Handler, webOptions ...grpcweb.Option) *Handler {
+	return &Handler{
+		grpcServer: grpcServer,
+		grpcWeb:    grpcweb.WrapServer(grpcServer, webOptions...),
+		fallback:   fallback,
+	}
+}
+
+// isGRPC reports whether r is a native gRPC request.
+func isGRPC(r *http.Request) bool {
+	return r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc")
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var serve http.Handler
+	switch {
+	// gRPC-Web requests also have a content type starting with
+	// "application/grpc", so they must be checked for first.
+	case h.grpcWeb.IsGrpcWebRequest(r) || h.grpcWeb.IsAcceptableGrpcCorsRequest(r):
+		serve = h.grpcWeb
+	case isGRPC(r):
+		serve = h.grpcServer
+	default:
+		h.fallback.ServeHTTP(w, r)
+		return
+	}
+	h.active.Add(1)
+	defer h.active.Done()
+	serve.ServeHTTP(w, r)
+}
+
+// Wait waits until all gRPC and gRPC-Web requests being served by h have
+// completed. The gRPC server does not support draining requests served through
+// ServeHTTP, so Wait must return before GracefulStop is called on the gRPC
+// server. New requests must no longer be accepted when Wait is called, which is
+// the case after the http.Server serving h has been shut down.
+func (h *Handler) Wait() {
+	h.active.Wait()
+}
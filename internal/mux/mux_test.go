@@ -0,0 +1,306 @@
+package mux
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// blockingServer responds to GetUser once release is closed.
+type blockingServer struct {
+	pb.UnimplementedStreckUServer
+	started chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	close(s.started)
+	<-s.release
+	return &pb.User{Name: req.Name}, nil
+}
+
+func TestHandler(t *testing.T) {
+	ctx := context.Background()
+	fake := &blockingServer{
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	srv := grpc.NewServer()
+	pb.RegisterStreckUServer(srv, fake)
+	fallback := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("fallback"))
+	})
+	h := New(srv, fallback)
+	ts := httptest.NewUnstartedServer(h)
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	t.Cleanup(ts.Close)
+
+	// Non-gRPC requests are served by the fallback handler, using either
+	// HTTP/1.1 or HTTP/2.
+	resp, err := ts.Client().Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(body), "fallback"; got != want {
+		t.Errorf("body = %q; want %q", got, want)
+	}
+
+	// gRPC requests are served by the gRPC server, and Wait waits for them.
+	roots := x509.NewCertPool()
+	roots.AddCert(ts.Certificate())
+	creds := credentials.NewTLS(&tls.Config{RootCAs: roots})
+	cc, err := grpc.DialContext(ctx, ts.Listener.Addr().String(), grpc.WithTransportCredentials(creds))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := cc.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	errc := make(chan error, 1)
+	go func() {
+		_, err := pb.NewStreckUClient(cc).GetUser(ctx, &pb.GetUserRequest{Name: "users/alice"})
+		errc <- err
+	}()
+	<-fake.started
+	waited := make(chan struct{})
+	go func() {
+		h.Wait()
+		close(waited)
+	}()
+	select {
+	case <-waited:
+		t.Fatal("Wait returned while a request was being served")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(fake.release)
+	if err := <-errc; err != nil {
+		t.Errorf("GetUser(...) err = %v; want nil", err)
+	}
+	<-waited
+}
+
+// exportServer streams a fixed export from ExportStore, and fails GetUser.
+type exportServer struct {
+	pb.UnimplementedStreckUServer
+}
+
+func (exportServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	return nil, status.Errorf(codes.NotFound, "user %q not found", req.Name)
+}
+
+func (exportServer) ExportStore(req *pb.ExportStoreRequest, stream pb.StreckU_ExportStoreServer) error {
+	for _, data := range []string{"header\n", "record\n"} {
+		if err := stream.Send(&pb.ExportStoreResponse{Data: []byte(data)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serveWeb starts a plaintext HTTP/1.1 server, which is what browsers
+// commonly use for gRPC-Web, allowing cross-origin requests from
+// https://kiosk.example.com.
+func serveWeb(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := grpc.NewServer()
+	pb.RegisterStreckUServer(srv, exportServer{})
+	fallback := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	h := New(srv, fallback, grpcweb.WithOriginFunc(func(origin string) bool {
+		return origin == "https://kiosk.example.com"
+	}))
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// webCall makes a unary or server-streaming gRPC-Web call, returning the
+// messages in the response along with the gRPC status.
+func webCall(t *testing.T, ts *httptest.Server, method string, contentType string, req proto.Message, newResp func() proto.Message) ([]proto.Message, codes.Code, string) {
+	t.Helper()
+	msg, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame := make([]byte, 5+len(msg))
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(msg)))
+	copy(frame[5:], msg)
+	text := strings.HasPrefix(contentType, "application/grpc-web-text")
+	if text {
+		frame = []byte(base64.StdEncoding.EncodeToString(frame))
+	}
+	httpReq, err := http.NewRequest(http.MethodPost, ts.URL+method, bytes.NewReader(frame))
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+	httpReq.Header.Set("X-Grpc-Web", "1")
+	httpResp, err := ts.Client().Do(httpReq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer httpResp.Body.Close()
+	body, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text {
+		body = decodeText(t, string(body))
+	}
+	var (
+		msgs     []proto.Message
+		trailers = make(http.Header)
+	)
+	for k, v := range httpResp.Header {
+		trailers[k] = v
+	}
+	for len(body) > 0 {
+		if len(body) < 5 {
+			t.Fatalf("truncated frame header: %q", body)
+		}
+		flags, n := body[0], binary.BigEndian.Uint32(body[1:5])
+		payload := body[5 : 5+n]
+		body = body[5+n:]
+		if flags&0x80 != 0 {
+			for _, line := range strings.Split(string(payload), "\r\n") {
+				if kv := strings.SplitN(line, ":", 2); len(kv) == 2 {
+					trailers.Set(kv[0], strings.TrimSpace(kv[1]))
+				}
+			}
+			continue
+		}
+		resp := newResp()
+		if err := proto.Unmarshal(payload, resp); err != nil {
+			t.Fatal(err)
+		}
+		msgs = append(msgs, resp)
+	}
+	code, err := strconv.Atoi(trailers.Get("Grpc-Status"))
+	if err != nil {
+		t.Fatalf("invalid grpc-status: %v", err)
+	}
+	return msgs, codes.Code(code), trailers.Get("Grpc-Message")
+}
+
+// decodeText decodes a gRPC-Web text response, which may consist of several
+// separately padded base64 strings.
+func decodeText(t *testing.T, s string) []byte {
+	t.Helper()
+	var out []byte
+	for len(s) > 0 {
+		end := len(s)
+		if i := strings.Index(s, "="); i >= 0 {
+			end = i
+			for end < len(s) && s[end] == '=' {
+				end++
+			}
+		}
+		b, err := base64.StdEncoding.DecodeString(s[:end])
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, b...)
+		s = s[end:]
+	}
+	return out
+}
+
+func TestHandler_GRPCWeb(t *testing.T) {
+	ts := serveWeb(t)
+	for _, contentType := range []string{
+		"application/grpc-web+proto",
+		"application/grpc-web-text+proto",
+	} {
+		contentType := contentType
+		t.Run(contentType, func(t *testing.T) {
+			t.Run("ServerStreaming", func(t *testing.T) {
+				msgs, code, message := webCall(
+					t, ts, "/saser.strecku.v1.StreckU/ExportStore", contentType,
+					&pb.ExportStoreRequest{Name: "stores/bar"},
+					func() proto.Message { return new(pb.ExportStoreResponse) },
+				)
+				if code != codes.OK {
+					t.Fatalf("code = %v (%q); want %v", code, message, codes.OK)
+				}
+				var got []string
+				for _, msg := range msgs {
+					got = append(got, string(msg.(*pb.ExportStoreResponse).Data))
+				}
+				if diff := cmp.Diff(got, []string{"header\n", "record\n"}); diff != "" {
+					t.Errorf("unexpected chunks (-got +want)\n%s", diff)
+				}
+			})
+			t.Run("Error", func(t *testing.T) {
+				msgs, code, message := webCall(
+					t, ts, "/saser.strecku.v1.StreckU/GetUser", contentType,
+					&pb.GetUserRequest{Name: "users/alice"},
+					func() proto.Message { return new(pb.User) },
+				)
+				if len(msgs) != 0 {
+					t.Errorf("got %d messages; want none", len(msgs))
+				}
+				if code != codes.NotFound {
+					t.Errorf("code = %v; want %v", code, codes.NotFound)
+				}
+				if want := `user "users/alice" not found`; message != want {
+					t.Errorf("message = %q; want %q", message, want)
+				}
+			})
+		})
+	}
+}
+
+func TestHandler_GRPCWebCORS(t *testing.T) {
+	ts := serveWeb(t)
+	for _, test := range []struct {
+		origin    string
+		wantAllow string
+	}{
+		{origin: "https://kiosk.example.com", wantAllow: "https://kiosk.example.com"},
+		{origin: "https://evil.example.com", wantAllow: ""},
+	} {
+		req, err := http.NewRequest(http.MethodOptions, ts.URL+"/saser.strecku.v1.StreckU/GetUser", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Origin", test.origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+		resp, err := ts.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if got := resp.Header.Get("Access-Control-Allow-Origin"); got != test.wantAllow {
+			t.Errorf("preflight from %q: Access-Control-Allow-Origin = %q; want %q", test.origin, got, test.wantAllow)
+		}
+	}
+}
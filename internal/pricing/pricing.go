@@ -0,0 +1,164 @@
+package pricing
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/purchases"
+	"google.golang.org/protobuf/proto"
+)
+
+// Applies reports whether rule applies to line of a purchase made at t by a
+// user with membership, which may be nil if the user is not a member of the
+// store. Only charge lines for products can have rules applied.
+func Applies(rule *pb.PricingRule, membership *pb.Membership, t time.Time, line *pb.Purchase_Line) bool {
+	if purchases.LineKind(line) != pb.Purchase_Line_CHARGE || line.Product == "" {
+		return false
+	}
+	if len(rule.Products) > 0 && !contains(rule.Products, line.Product) {
+		return false
+	}
+	if rule.StartTime != nil && t.Before(rule.StartTime.AsTime()) {
+		return false
+	}
+	if rule.EndTime != nil && !t.Before(rule.EndTime.AsTime()) {
+		return false
+	}
+	loc, err := time.LoadLocation(rule.TimeZone)
+	if err != nil {
+		return false
+	}
+	local := t.In(loc)
+	if len(rule.Weekdays) > 0 && !containsWeekday(rule.Weekdays, weekday(local.Weekday())) {
+		return false
+	}
+	if !inDailyWindow(rule.DailyStartMinute, rule.DailyEndMinute, int32(local.Hour()*60+local.Minute())) {
+		return false
+	}
+	if line.Quantity < rule.MinQuantity {
+		return false
+	}
+	if rule.DiscountMembersOnly && (membership == nil || !membership.Discount) {
+		return false
+	}
+	if rule.AdministratorsOnly && (membership == nil || !membership.Administrator) {
+		return false
+	}
+	return true
+}
+
+// Apply returns lines with the rule among rules that saves the most applied
+// to each line, as decided by Applies. Rules that save equally much are
+// ordered by name. A line whose rule applies to only some of its units is
+// split into one line at the original price and one line at the rule's price.
+// Lines are never made more expensive.
+func Apply(rules []*pb.PricingRule, membership *pb.Membership, t time.Time, lines []*pb.Purchase_Line) []*pb.Purchase_Line {
+	sorted := make([]*pb.PricingRule, len(rules))
+	copy(sorted, rules)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	var priced []*pb.Purchase_Line
+	for _, line := range lines {
+		priced = append(priced, applyBest(sorted, membership, t, line)...)
+	}
+	return priced
+}
+
+func applyBest(rules []*pb.PricingRule, membership *pb.Membership, t time.Time, line *pb.Purchase_Line) []*pb.Purchase_Line {
+	var (
+		best       *pb.PricingRule
+		bestPrice  int64
+		bestUnits  int32
+		bestSaving int64
+	)
+	for _, rule := range rules {
+		if !Applies(rule, membership, t, line) {
+			continue
+		}
+		price := Price(rule, line.PriceCents)
+		units := Units(rule, line.Quantity)
+		if saving := (price - line.PriceCents) * int64(units); saving > bestSaving {
+			best, bestPrice, bestUnits, bestSaving = rule, price, units, saving
+		}
+	}
+	if best == nil {
+		return []*pb.Purchase_Line{line}
+	}
+	var split []*pb.Purchase_Line
+	if rest := line.Quantity - bestUnits; rest > 0 {
+		full := proto.Clone(line).(*pb.Purchase_Line)
+		full.Quantity = rest
+		split = append(split, full)
+	}
+	discounted := proto.Clone(line).(*pb.Purchase_Line)
+	discounted.Description = fmt.Sprintf("%s (%s)", line.Description, best.DisplayName)
+	discounted.Quantity = bestUnits
+	discounted.PriceCents = bestPrice
+	discounted.PricingRule = best.Name
+	return append(split, discounted)
+}
+
+// Price returns the price in cents of a unit with priceCents after applying
+// rule to it. The price is never made more expensive, and percentages are
+// rounded towards zero.
+func Price(rule *pb.PricingRule, priceCents int64) int64 {
+	switch effect := rule.Effect.(type) {
+	case *pb.PricingRule_PercentOff:
+		return priceCents * int64(100-effect.PercentOff) / 100
+	case *pb.PricingRule_FixedPriceCents:
+		if effect.FixedPriceCents > priceCents {
+			return effect.FixedPriceCents
+		}
+	}
+	return priceCents
+}
+
+// Units returns how many of quantity units rule applies to.
+func Units(rule *pb.PricingRule, quantity int32) int32 {
+	if rule.UnitInterval > 0 {
+		return quantity / rule.UnitInterval
+	}
+	return quantity
+}
+
+// weekday converts a time.Weekday, where the week starts on Sunday, to a
+// PricingRule_Weekday, where it starts on Monday.
+func weekday(d time.Weekday) pb.PricingRule_Weekday {
+	if d == time.Sunday {
+		return pb.PricingRule_SUNDAY
+	}
+	return pb.PricingRule_Weekday(d)
+}
+
+// inDailyWindow reports whether minute is in the window from start until end,
+// which spans midnight if end is before start. A window with equal start and
+// end covers the whole day.
+func inDailyWindow(start, end, minute int32) bool {
+	switch {
+	case start == end:
+		return true
+	case start < end:
+		return start <= minute && minute < end
+	default:
+		return start <= minute || minute < end
+	}
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsWeekday(days []pb.PricingRule_Weekday, day pb.PricingRule_Weekday) bool {
+	for _, d := range days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
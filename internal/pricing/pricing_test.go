@@ -0,0 +1,338 @@
+package pricing
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// friday is a Friday evening in Stockholm, during happy hour.
+var friday = time.Date(2021, time.January, 8, 17, 30, 0, 0, mustLoadLocation("Europe/Stockholm"))
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+func beerLine(quantity int32) *pb.Purchase_Line {
+	return &pb.Purchase_Line{
+		Description: "Beer",
+		Quantity:    quantity,
+		PriceCents:  testresources.Beer.FullPriceCents,
+		Product:     testresources.Beer.Name,
+	}
+}
+
+func TestApplies(t *testing.T) {
+	admin := &pb.Membership{Administrator: true}
+	discount := &pb.Membership{Discount: true}
+	for _, test := range []struct {
+		desc       string
+		rule       *pb.PricingRule
+		membership *pb.Membership
+		t          time.Time
+		line       *pb.Purchase_Line
+		want       bool
+	}{
+		{
+			desc: "HappyHour",
+			rule: testresources.Bar_HappyHour,
+			t:    friday,
+			line: beerLine(1),
+			want: true,
+		},
+		{
+			desc: "HappyHour_WrongProduct",
+			rule: testresources.Bar_HappyHour,
+			t:    friday,
+			line: &pb.Purchase_Line{Description: "Cocktail", Quantity: 1, PriceCents: -10000, Product: testresources.Cocktail.Name},
+			want: false,
+		},
+		{
+			desc: "HappyHour_NoProduct",
+			rule: testresources.Bar_HappyHour,
+			t:    friday,
+			line: &pb.Purchase_Line{Description: "Beer", Quantity: 1, PriceCents: -5000},
+			want: false,
+		},
+		{
+			desc: "HappyHour_Refund",
+			rule: testresources.Bar_HappyHour,
+			t:    friday,
+			line: &pb.Purchase_Line{
+				Description:      "Beer",
+				Quantity:         1,
+				PriceCents:       5000,
+				Product:          testresources.Beer.Name,
+				Kind:             pb.Purchase_Line_REFUND,
+				RefundedPurchase: testresources.Bar_Alice_Beer1.Name,
+			},
+			want: false,
+		},
+		{
+			desc: "HappyHour_WrongWeekday",
+			rule: testresources.Bar_HappyHour,
+			t:    friday.AddDate(0, 0, 1),
+			line: beerLine(1),
+			want: false,
+		},
+		{
+			desc: "HappyHour_BeforeWindow",
+			rule: testresources.Bar_HappyHour,
+			t:    friday.Add(-2 * time.Hour),
+			line: beerLine(1),
+			want: false,
+		},
+		{
+			desc: "HappyHour_EndOfWindow",
+			rule: testresources.Bar_HappyHour,
+			t:    friday.Add(90 * time.Minute),
+			line: beerLine(1),
+			want: false,
+		},
+		{
+			desc: "HappyHour_UTC",
+			rule: testresources.Bar_HappyHour,
+			// 18:30 in UTC is 19:30 in Stockholm, after happy hour.
+			t:    time.Date(2021, time.January, 8, 18, 30, 0, 0, time.UTC),
+			line: beerLine(1),
+			want: false,
+		},
+		{
+			desc: "SpansMidnight",
+			rule: &pb.PricingRule{
+				DailyStartMinute: 22 * 60,
+				DailyEndMinute:   2 * 60,
+				Effect:           &pb.PricingRule_PercentOff{PercentOff: 10},
+			},
+			t:    time.Date(2021, time.January, 9, 1, 0, 0, 0, time.UTC),
+			line: beerLine(1),
+			want: true,
+		},
+		{
+			desc: "JeansSale",
+			rule: testresources.Mall_JeansSale,
+			t:    friday,
+			line: &pb.Purchase_Line{Description: "Jeans", Quantity: 2, PriceCents: -50000, Product: testresources.Jeans.Name},
+			want: true,
+		},
+		{
+			desc: "JeansSale_BelowMinQuantity",
+			rule: testresources.Mall_JeansSale,
+			t:    friday,
+			line: &pb.Purchase_Line{Description: "Jeans", Quantity: 1, PriceCents: -50000, Product: testresources.Jeans.Name},
+			want: false,
+		},
+		{
+			desc: "JeansSale_Ended",
+			rule: testresources.Mall_JeansSale,
+			t:    testresources.Mall_JeansSale.EndTime.AsTime(),
+			line: &pb.Purchase_Line{Description: "Jeans", Quantity: 2, PriceCents: -50000, Product: testresources.Jeans.Name},
+			want: false,
+		},
+		{
+			desc: "JeansSale_NotStarted",
+			rule: testresources.Mall_JeansSale,
+			t:    testresources.Mall_JeansSale.StartTime.AsTime().Add(-time.Second),
+			line: &pb.Purchase_Line{Description: "Jeans", Quantity: 2, PriceCents: -50000, Product: testresources.Jeans.Name},
+			want: false,
+		},
+		{
+			desc:       "StaffDiscount_Administrator",
+			rule:       testresources.Mall_StaffDiscount,
+			membership: admin,
+			t:          friday,
+			line:       &pb.Purchase_Line{Description: "Jeans", Quantity: 1, PriceCents: -50000, Product: testresources.Jeans.Name},
+			want:       true,
+		},
+		{
+			desc:       "StaffDiscount_NotAdministrator",
+			rule:       testresources.Mall_StaffDiscount,
+			membership: discount,
+			t:          friday,
+			line:       &pb.Purchase_Line{Description: "Jeans", Quantity: 1, PriceCents: -50000, Product: testresources.Jeans.Name},
+			want:       false,
+		},
+		{
+			desc:       "StaffDiscount_NotMember",
+			rule:       testresources.Mall_StaffDiscount,
+			membership: nil,
+			t:          friday,
+			line:       &pb.Purchase_Line{Description: "Jeans", Quantity: 1, PriceCents: -50000, Product: testresources.Jeans.Name},
+			want:       false,
+		},
+		{
+			desc: "DiscountMembersOnly",
+			rule: &pb.PricingRule{
+				DiscountMembersOnly: true,
+				Effect:              &pb.PricingRule_PercentOff{PercentOff: 10},
+			},
+			membership: discount,
+			t:          friday,
+			line:       beerLine(1),
+			want:       true,
+		},
+		{
+			desc: "DiscountMembersOnly_NoDiscount",
+			rule: &pb.PricingRule{
+				DiscountMembersOnly: true,
+				Effect:              &pb.PricingRule_PercentOff{PercentOff: 10},
+			},
+			membership: admin,
+			t:          friday,
+			line:       beerLine(1),
+			want:       false,
+		},
+	} {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			if got := Applies(test.rule, test.membership, test.t, test.line); got != test.want {
+				t.Errorf("Applies(%v, %v, %v, %v) = %v; want %v", test.rule, test.membership, test.t, test.line, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPrice(t *testing.T) {
+	for _, test := range []struct {
+		desc       string
+		rule       *pb.PricingRule
+		priceCents int64
+		want       int64
+	}{
+		{desc: "PercentOff", rule: &pb.PricingRule{Effect: &pb.PricingRule_PercentOff{PercentOff: 20}}, priceCents: -5000, want: -4000},
+		{desc: "PercentOff_Rounded", rule: &pb.PricingRule{Effect: &pb.PricingRule_PercentOff{PercentOff: 33}}, priceCents: -1001, want: -670},
+		{desc: "PercentOff_Free", rule: &pb.PricingRule{Effect: &pb.PricingRule_PercentOff{PercentOff: 100}}, priceCents: -5000, want: 0},
+		{desc: "FixedPrice", rule: &pb.PricingRule{Effect: &pb.PricingRule_FixedPriceCents{FixedPriceCents: -3000}}, priceCents: -5000, want: -3000},
+		{desc: "FixedPrice_MoreExpensive", rule: &pb.PricingRule{Effect: &pb.PricingRule_FixedPriceCents{FixedPriceCents: -3000}}, priceCents: -2500, want: -2500},
+		{desc: "NoEffect", rule: &pb.PricingRule{}, priceCents: -5000, want: -5000},
+	} {
+		if got := Price(test.rule, test.priceCents); got != test.want {
+			t.Errorf("%s: Price(%v, %d) = %d; want %d", test.desc, test.rule, test.priceCents, got, test.want)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	cocktails := &pb.Purchase_Line{
+		Description: "Cocktail",
+		Quantity:    3,
+		PriceCents:  testresources.Cocktail.FullPriceCents,
+		Product:     testresources.Cocktail.Name,
+	}
+	rules := []*pb.PricingRule{
+		testresources.Bar_HappyHour,
+		testresources.Bar_SecondCocktailHalfPrice,
+	}
+	for _, test := range []struct {
+		desc  string
+		rules []*pb.PricingRule
+		t     time.Time
+		lines []*pb.Purchase_Line
+		want  []*pb.Purchase_Line
+	}{
+		{
+			desc:  "NoRules",
+			rules: nil,
+			t:     friday,
+			lines: []*pb.Purchase_Line{beerLine(2)},
+			want:  []*pb.Purchase_Line{beerLine(2)},
+		},
+		{
+			desc:  "AllUnits",
+			rules: rules,
+			t:     friday,
+			lines: []*pb.Purchase_Line{beerLine(2)},
+			want: []*pb.Purchase_Line{
+				{
+					Description: "Beer (Happy hour)",
+					Quantity:    2,
+					PriceCents:  -3000,
+					Product:     testresources.Beer.Name,
+					PricingRule: testresources.Bar_HappyHour.Name,
+				},
+			},
+		},
+		{
+			desc:  "NotApplicable",
+			rules: rules,
+			t:     friday.AddDate(0, 0, 1),
+			lines: []*pb.Purchase_Line{beerLine(2)},
+			want:  []*pb.Purchase_Line{beerLine(2)},
+		},
+		{
+			desc:  "SomeUnits",
+			rules: rules,
+			t:     friday,
+			lines: []*pb.Purchase_Line{cocktails},
+			want: []*pb.Purchase_Line{
+				{
+					Description: "Cocktail",
+					Quantity:    2,
+					PriceCents:  -10000,
+					Product:     testresources.Cocktail.Name,
+				},
+				{
+					Description: "Cocktail (Second cocktail half price)",
+					Quantity:    1,
+					PriceCents:  -5000,
+					Product:     testresources.Cocktail.Name,
+					PricingRule: testresources.Bar_SecondCocktailHalfPrice.Name,
+				},
+			},
+		},
+		{
+			desc: "BestRule",
+			rules: []*pb.PricingRule{
+				{Name: "b", DisplayName: "Ten percent", Effect: &pb.PricingRule_PercentOff{PercentOff: 10}},
+				testresources.Bar_HappyHour,
+				{Name: "a", DisplayName: "Every second free", UnitInterval: 2, Effect: &pb.PricingRule_PercentOff{PercentOff: 100}},
+			},
+			t:     friday,
+			lines: []*pb.Purchase_Line{beerLine(4)},
+			want: []*pb.Purchase_Line{
+				beerLine(2),
+				{
+					Description: "Beer (Every second free)",
+					Quantity:    2,
+					PriceCents:  0,
+					Product:     testresources.Beer.Name,
+					PricingRule: "a",
+				},
+			},
+		},
+		{
+			desc: "TieOrderedByName",
+			rules: []*pb.PricingRule{
+				{Name: "b", DisplayName: "B", Effect: &pb.PricingRule_PercentOff{PercentOff: 50}},
+				{Name: "a", DisplayName: "A", Effect: &pb.PricingRule_FixedPriceCents{FixedPriceCents: -2500}},
+			},
+			t:     friday,
+			lines: []*pb.Purchase_Line{beerLine(1)},
+			want: []*pb.Purchase_Line{
+				{
+					Description: "Beer (A)",
+					Quantity:    1,
+					PriceCents:  -2500,
+					Product:     testresources.Beer.Name,
+					PricingRule: "a",
+				},
+			},
+		},
+	} {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			got := Apply(test.rules, nil, test.t, test.lines)
+			if diff := cmp.Diff(got, test.want, protocmp.Transform()); diff != "" {
+				t.Errorf("Apply(%v, nil, %v, %v) = unexpected diff (-got +want)\n%s", test.rules, test.t, test.lines, diff)
+			}
+		})
+	}
+}
@@ -100,6 +100,20 @@ func (u *InMemoryUsers) Update(ctx context.Context, user *pb.User) error {
 	return nil
 }
 
+func (u *InMemoryUsers) SetPassword(ctx context.Context, name string, password string) error {
+	if err := users.ValidateName(name); err != nil {
+		return err
+	}
+	if err := users.ValidatePassword(password); err != nil {
+		return err
+	}
+	if _, exists := u.users[name]; !exists {
+		return &NotFound{Name: name}
+	}
+	u.passwords[name] = password
+	return nil
+}
+
 func (u *InMemoryUsers) Delete(ctx context.Context, name string) error {
 	user, err := u.Lookup(ctx, name)
 	if err != nil {
@@ -107,5 +121,6 @@ func (u *InMemoryUsers) Delete(ctx context.Context, name string) error {
 	}
 	delete(u.names, user.EmailAddress)
 	delete(u.users, user.Name)
+	delete(u.passwords, user.Name)
 	return nil
 }
@@ -47,6 +47,10 @@ func (u *PostgresUsers) Update(ctx context.Context, user *pb.User) error {
 	return u.inmemory.Update(ctx, user)
 }
 
+func (u *PostgresUsers) SetPassword(ctx context.Context, name string, password string) error {
+	return u.inmemory.SetPassword(ctx, name, password)
+}
+
 func (u *PostgresUsers) Delete(ctx context.Context, name string) error {
 	return u.inmemory.Delete(ctx, name)
 }
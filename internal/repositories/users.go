@@ -72,6 +72,12 @@ type Users interface {
 	// user with that name exists, a NotFound error will be returned.
 	Update(ctx context.Context, user *pb.User) error
 
+	// SetPassword replaces the password of the user corresponding to the
+	// given name. The name and the password will be validated using package
+	// users. If no user with that name exists, a NotFound error will be
+	// returned.
+	SetPassword(ctx context.Context, name string, password string) error
+
 	// Delete deletes the user corresponding to the given name. The name
 	// will be validated using package users. If no user with that name
 	// exists, a NotFound error will be returned.
@@ -334,6 +334,59 @@ func (s *UsersTestSuite) TestUpdate() {
 	})
 }
 
+func (s *UsersTestSuite) TestSetPassword() {
+	t := s.T()
+	ctx := context.Background()
+	t.Run("OK", func(t *testing.T) {
+		r := s.seedAlice(ctx, t)
+		const newPassword = "new alice password"
+		if err := r.SetPassword(ctx, testresources.Alice.Name, newPassword); err != nil {
+			t.Fatalf("r.SetPassword(ctx, %q, %q) = %v; want nil", testresources.Alice.Name, newPassword, err)
+		}
+		if err := r.Authenticate(ctx, testresources.Alice.Name, newPassword); err != nil {
+			t.Errorf("r.Authenticate(ctx, %q, %q) = %v; want nil", testresources.Alice.Name, newPassword, err)
+		}
+		if err := r.Authenticate(ctx, testresources.Alice.Name, testresources.AlicePassword); !cmp.Equal(err, ErrUnauthenticated, cmpopts.EquateErrors()) {
+			t.Errorf("r.Authenticate(ctx, %q, %q) = %v; want %v", testresources.Alice.Name, testresources.AlicePassword, err, ErrUnauthenticated)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		r := s.seedAlice(ctx, t)
+		for _, test := range []struct {
+			desc     string
+			name     string
+			password string
+			want     error
+		}{
+			{
+				desc:     "EmptyName",
+				name:     "",
+				password: "password",
+				want:     resourcename.ErrInvalidName,
+			},
+			{
+				desc:     "EmptyPassword",
+				name:     testresources.Alice.Name,
+				password: "",
+				want:     users.ErrPasswordEmpty,
+			},
+			{
+				desc:     "NotFound",
+				name:     testresources.Bob.Name,
+				password: testresources.BobPassword,
+				want:     &NotFound{Name: testresources.Bob.Name},
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				if err := r.SetPassword(ctx, test.name, test.password); !cmp.Equal(err, test.want, cmpopts.EquateErrors()) {
+					t.Errorf("r.SetPassword(ctx, %q, %q) = %v; want %v", test.name, test.password, err, test.want)
+				}
+			})
+		}
+	})
+}
+
 func (s *UsersTestSuite) TestDelete() {
 	t := s.T()
 	ctx := context.Background()
@@ -1,27 +1,121 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"sync"
+
 	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/authn"
+	"github.com/Saser/strecku/internal/lockout"
+	"github.com/Saser/strecku/internal/logging"
+	"github.com/Saser/strecku/internal/mail"
 	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/internal/statistics"
+	"github.com/Saser/strecku/internal/tokens"
+	"github.com/Saser/strecku/resources/stores/devices"
+	"github.com/Saser/strecku/resources/stores/devices/apikeys"
+	"github.com/Saser/strecku/resources/stores/invitations"
+	"github.com/Saser/strecku/resources/stores/joinrequests"
 	"github.com/Saser/strecku/resources/stores/memberships"
 	"github.com/Saser/strecku/resources/stores/payments"
+	"github.com/Saser/strecku/resources/stores/pricingrules"
 	"github.com/Saser/strecku/resources/stores/products"
 	"github.com/Saser/strecku/resources/stores/purchases"
+	"github.com/Saser/strecku/resources/users/cards"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-var internalError = status.Error(codes.Internal, "internal error")
+// internalError logs err, which must not be shown to the caller, using the
+// Logger carried by ctx, and returns the error to return to the caller
+// instead.
+func internalError(ctx context.Context, err error) error {
+	logging.FromContext(ctx).Errorf("internal error: %v", err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+// callerUser returns the resource name of the user that the caller is
+// authenticated as, if any.
+func callerUser(ctx context.Context) (string, bool) {
+	id, ok := authn.FromContext(ctx)
+	if !ok || id.User == "" {
+		return "", false
+	}
+	return id.User, true
+}
+
+// checkStoreAdministrator returns an error unless the caller is one of the
+// Administrators of the server, or is authenticated as a user that is an
+// administrator of store. Callers that are not authenticated at all get an
+// Unauthenticated error, and other callers, such as devices, get a
+// PermissionDenied error.
+func (s *Service) checkStoreAdministrator(ctx context.Context, store string) error {
+	if s.isAdministrator(ctx) {
+		return nil
+	}
+	if _, ok := authn.FromContext(ctx); !ok {
+		return status.Error(codes.Unauthenticated, "authentication is required")
+	}
+	user, ok := callerUser(ctx)
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "only administrators of %q are allowed", store)
+	}
+	membership, err := s.membershipRepo.LookupMembershipIn(ctx, store, user)
+	if err != nil {
+		if notFound := new(memberships.NotFoundError); errors.As(err, &notFound) {
+			return status.Errorf(codes.PermissionDenied, "%q is not an administrator of %q", user, store)
+		}
+		return internalError(ctx, err)
+	}
+	if !membership.Administrator {
+		return status.Errorf(codes.PermissionDenied, "%q is not an administrator of %q", user, store)
+	}
+	return nil
+}
+
+// isAdministrator reports whether the caller is authenticated as one of the
+// Administrators.
+func (s *Service) isAdministrator(ctx context.Context) bool {
+	id, ok := authn.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, name := range s.Administrators {
+		if id.Name == name {
+			return true
+		}
+	}
+	return false
+}
 
 type Service struct {
 	pb.UnimplementedStreckUServer
 
-	userRepo       repositories.Users
-	storeRepo      repositories.Stores
-	membershipRepo *memberships.Repository
-	productRepo    *products.Repository
-	purchaseRepo   *purchases.Repository
-	paymentRepo    *payments.Repository
+	// Administrators lists the names of the identities, such as those of
+	// client certificates, that may administer the server, such as listing
+	// and clearing lockouts.
+	Administrators []string
+
+	userRepo        repositories.Users
+	storeRepo       repositories.Stores
+	membershipRepo  *memberships.Repository
+	productRepo     *products.Repository
+	purchaseRepo    *purchases.Repository
+	paymentRepo     *payments.Repository
+	invitationRepo  *invitations.Repository
+	joinRequestRepo *joinrequests.Repository
+	deviceRepo      *devices.Repository
+	apiKeyRepo      *apikeys.Repository
+	cardRepo        *cards.Repository
+	pricingRuleRepo *pricingrules.Repository
+	guard           *lockout.Guard
+	statistics      *statistics.Aggregator
+	mailer          mail.Mailer
+	tokens          *tokens.Store
+
+	stopWatchesOnce sync.Once
+	watchesStopped  chan struct{} // closed by StopWatches
 }
 
 func New(
@@ -31,13 +125,34 @@ func New(
 	productRepo *products.Repository,
 	purchaseRepo *purchases.Repository,
 	paymentRepo *payments.Repository,
+	invitationRepo *invitations.Repository,
+	joinRequestRepo *joinrequests.Repository,
+	deviceRepo *devices.Repository,
+	apiKeyRepo *apikeys.Repository,
+	cardRepo *cards.Repository,
+	pricingRuleRepo *pricingrules.Repository,
+	guard *lockout.Guard,
+	statistics *statistics.Aggregator,
+	mailer mail.Mailer,
+	tokenStore *tokens.Store,
 ) *Service {
 	return &Service{
-		userRepo:       userRepo,
-		storeRepo:      storeRepo,
-		membershipRepo: membershipRepo,
-		productRepo:    productRepo,
-		purchaseRepo:   purchaseRepo,
-		paymentRepo:    paymentRepo,
+		userRepo:        userRepo,
+		storeRepo:       storeRepo,
+		membershipRepo:  membershipRepo,
+		productRepo:     productRepo,
+		purchaseRepo:    purchaseRepo,
+		paymentRepo:     paymentRepo,
+		invitationRepo:  invitationRepo,
+		joinRequestRepo: joinRequestRepo,
+		deviceRepo:      deviceRepo,
+		apiKeyRepo:      apiKeyRepo,
+		cardRepo:        cardRepo,
+		pricingRuleRepo: pricingRuleRepo,
+		guard:           guard,
+		statistics:      statistics,
+		mailer:          mailer,
+		tokens:          tokenStore,
+		watchesStopped:  make(chan struct{}),
 	}
 }
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/lockout"
+	"github.com/Saser/strecku/internal/logging"
+	"github.com/Saser/strecku/internal/mail"
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/internal/tokens"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/resources/users"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// passwordResetTTL is how long the tokens sent by RequestPasswordReset
+	// are valid.
+	passwordResetTTL = time.Hour
+	// emailVerificationTTL is how long the tokens sent by
+	// SendVerificationEmail are valid.
+	emailVerificationTTL = 24 * time.Hour
+)
+
+// sendToken issues a token for the given purpose to user, and emails it to the
+// email address of user.
+func (s *Service) sendToken(ctx context.Context, purpose tokens.Purpose, user *pb.User) error {
+	var (
+		ttl     time.Duration
+		subject string
+		body    string
+	)
+	switch purpose {
+	case tokens.PasswordReset:
+		ttl = passwordResetTTL
+		subject = "Reset your StreckU password"
+		body = "Someone, hopefully you, asked to reset the password of your StreckU account.\n\n" +
+			"Use this token to choose a new password:\n\n%s\n\n" +
+			"The token can be used once, and expires in %v. If you did not ask to reset your password, you can ignore this email.\n"
+	case tokens.EmailVerification:
+		ttl = emailVerificationTTL
+		subject = "Verify your StreckU email address"
+		body = "Please verify that this is the email address of your StreckU account.\n\n" +
+			"Use this token to verify it:\n\n%s\n\n" +
+			"The token can be used once, and expires in %v.\n"
+	default:
+		return fmt.Errorf("send token: unknown purpose %v", purpose)
+	}
+	token, err := s.tokens.Issue(tokens.Grant{
+		Purpose:      purpose,
+		User:         user.Name,
+		EmailAddress: user.EmailAddress,
+	}, ttl)
+	if err != nil {
+		return err
+	}
+	return s.mailer.Send(ctx, mail.Message{
+		To:      user.EmailAddress,
+		Subject: subject,
+		Body:    fmt.Sprintf("Hi %s,\n\n"+body, user.DisplayName, token, ttl),
+	})
+}
+
+// sendVerificationEmail is like sendToken for email verification, but only
+// logs errors. It is used after creating or updating users, which should not
+// fail just because the email could not be sent; the user can ask for another
+// one using SendVerificationEmail.
+func (s *Service) sendVerificationEmail(ctx context.Context, user *pb.User) {
+	if err := s.sendToken(ctx, tokens.EmailVerification, user); err != nil {
+		logging.FromContext(ctx).With("user", user.Name).Warnf("send verification email: %v", err)
+	}
+}
+
+func (s *Service) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*emptypb.Empty, error) {
+	if err := users.ValidateName(req.User); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if err := users.ValidatePassword(req.NewPassword); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid new password: %v", err)
+	}
+	if err := s.userRepo.Authenticate(ctx, req.User, req.CurrentPassword); err != nil {
+		if notFound := new(repositories.NotFound); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		if blocked := new(lockout.Blocked); errors.As(err, &blocked) {
+			return nil, status.Error(codes.ResourceExhausted, blocked.Error())
+		}
+		if errors.Is(err, repositories.ErrUnauthenticated) {
+			return nil, status.Error(codes.PermissionDenied, "incorrect current password")
+		}
+		return nil, internalError(ctx, err)
+	}
+	if err := s.userRepo.SetPassword(ctx, req.User, req.NewPassword); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	s.tokens.Revoke(tokens.PasswordReset, req.User)
+	return new(emptypb.Empty), nil
+}
+
+func (s *Service) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	if req.EmailAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "email address is empty")
+	}
+	name, err := s.userRepo.ResolveEmail(ctx, req.EmailAddress)
+	if err != nil {
+		if notFound := new(repositories.EmailAddressNotFound); errors.As(err, &notFound) {
+			return new(emptypb.Empty), nil
+		}
+		return nil, internalError(ctx, err)
+	}
+	user, err := s.userRepo.Lookup(ctx, name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	if err := s.sendToken(ctx, tokens.PasswordReset, user); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return new(emptypb.Empty), nil
+}
+
+// redeem redeems the token for the given purpose, and returns the user that
+// it was issued to.
+func (s *Service) redeem(ctx context.Context, purpose tokens.Purpose, token string) (*pb.User, tokens.Grant, error) {
+	g, err := s.tokens.Redeem(purpose, token)
+	if err != nil {
+		if errors.Is(err, tokens.ErrInvalidToken) {
+			return nil, tokens.Grant{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, tokens.Grant{}, internalError(ctx, err)
+	}
+	user, err := s.userRepo.Lookup(ctx, g.User)
+	if err != nil {
+		// The user has been deleted since the token was issued.
+		if notFound := new(repositories.NotFound); errors.As(err, &notFound) {
+			return nil, tokens.Grant{}, status.Error(codes.InvalidArgument, tokens.ErrInvalidToken.Error())
+		}
+		return nil, tokens.Grant{}, internalError(ctx, err)
+	}
+	return user, g, nil
+}
+
+// markVerified marks the email address of user as verified, if it is still
+// the email address that g was sent to.
+func (s *Service) markVerified(ctx context.Context, user *pb.User, g tokens.Grant) (bool, error) {
+	if user.EmailAddress != g.EmailAddress {
+		return false, nil
+	}
+	if user.EmailAddressVerified {
+		return true, nil
+	}
+	user.EmailAddressVerified = true
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *Service) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if err := users.ValidatePassword(req.NewPassword); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid new password: %v", err)
+	}
+	user, g, err := s.redeem(ctx, tokens.PasswordReset, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.userRepo.SetPassword(ctx, user.Name, req.NewPassword); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	s.tokens.Revoke(tokens.PasswordReset, user.Name)
+	if _, err := s.markVerified(ctx, user, g); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return new(emptypb.Empty), nil
+}
+
+func (s *Service) SendVerificationEmail(ctx context.Context, req *pb.SendVerificationEmailRequest) (*emptypb.Empty, error) {
+	user, err := s.GetUser(ctx, &pb.GetUserRequest{Name: req.User})
+	if err != nil {
+		return nil, err
+	}
+	if user.EmailAddressVerified {
+		return nil, status.Errorf(codes.FailedPrecondition, "email address of %q is already verified", user.Name)
+	}
+	if err := s.sendToken(ctx, tokens.EmailVerification, user); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return new(emptypb.Empty), nil
+}
+
+func (s *Service) VerifyEmailAddress(ctx context.Context, req *pb.VerifyEmailAddressRequest) (*pb.User, error) {
+	user, g, err := s.redeem(ctx, tokens.EmailVerification, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	verified, err := s.markVerified(ctx, user, g)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	if !verified {
+		return nil, status.Errorf(codes.FailedPrecondition, "email address of %q has changed since the token was sent", user.Name)
+	}
+	return user, nil
+}
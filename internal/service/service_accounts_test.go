@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/mail"
+	"github.com/Saser/strecku/resources/users"
+	"github.com/Saser/strecku/testresources"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var tokenPattern = regexp.MustCompile(`(?m)^[A-Za-z0-9_-]{43}$`)
+
+// lastToken returns the token in the last message sent to the given email
+// address.
+func lastToken(t *testing.T, svc *Service, to string) string {
+	t.Helper()
+	m, ok := svc.mailer.(*mail.Memory).Last(to)
+	if !ok {
+		t.Fatalf("no message sent to %q", to)
+	}
+	token := tokenPattern.FindString(m.Body)
+	if token == "" {
+		t.Fatalf("no token in message to %q: %q", to, m.Body)
+	}
+	return token
+}
+
+func messageCount(svc *Service) int {
+	return len(svc.mailer.(*mail.Memory).Messages())
+}
+
+func TestService_ChangePassword(t *testing.T) {
+	ctx := context.Background()
+	for _, test := range []struct {
+		desc     string
+		req      *pb.ChangePasswordRequest
+		wantCode codes.Code
+	}{
+		{
+			desc: "OK",
+			req: &pb.ChangePasswordRequest{
+				User:            testresources.Alice.Name,
+				CurrentPassword: testresources.AlicePassword,
+				NewPassword:     "new alice password",
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "WrongPassword",
+			req: &pb.ChangePasswordRequest{
+				User:            testresources.Alice.Name,
+				CurrentPassword: testresources.BobPassword,
+				NewPassword:     "new alice password",
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc: "EmptyNewPassword",
+			req: &pb.ChangePasswordRequest{
+				User:            testresources.Alice.Name,
+				CurrentPassword: testresources.AlicePassword,
+				NewPassword:     "",
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "InvalidName",
+			req: &pb.ChangePasswordRequest{
+				User:            testresources.Bar.Name, // name of a store
+				CurrentPassword: testresources.AlicePassword,
+				NewPassword:     "new alice password",
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "NotFound",
+			req: &pb.ChangePasswordRequest{
+				User:            testresources.Carol.Name,
+				CurrentPassword: testresources.CarolPassword,
+				NewPassword:     "new carol password",
+			},
+			wantCode: codes.NotFound,
+		},
+	} {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			svc := seed(ctx, t)
+			c := serveAndDial(ctx, t, svc)
+			_, err := c.ChangePassword(ctx, test.req)
+			if got := status.Code(err); got != test.wantCode {
+				t.Fatalf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+			if test.wantCode != codes.OK {
+				return
+			}
+			if err := svc.userRepo.Authenticate(ctx, test.req.User, test.req.NewPassword); err != nil {
+				t.Errorf("Authenticate with new password = %v; want nil", err)
+			}
+			if err := svc.userRepo.Authenticate(ctx, test.req.User, test.req.CurrentPassword); err == nil {
+				t.Error("Authenticate with old password = nil; want non-nil")
+			}
+		})
+	}
+}
+
+func TestService_ResetPassword(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	c := serveAndDial(ctx, t, svc)
+	const newPassword = "new alice password"
+
+	// Unknown email addresses are not revealed.
+	if _, err := c.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{EmailAddress: testresources.Carol.EmailAddress}); err != nil {
+		t.Fatalf("c.RequestPasswordReset(unknown) err = %v; want nil", err)
+	}
+	if got := messageCount(svc); got != 0 {
+		t.Errorf("%d messages sent for unknown email address; want 0", got)
+	}
+
+	if _, err := c.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{EmailAddress: testresources.Alice.EmailAddress}); err != nil {
+		t.Fatalf("c.RequestPasswordReset(alice) err = %v; want nil", err)
+	}
+	token := lastToken(t, svc, testresources.Alice.EmailAddress)
+
+	for _, test := range []struct {
+		desc     string
+		req      *pb.ResetPasswordRequest
+		wantCode codes.Code
+	}{
+		{
+			desc:     "EmptyNewPassword",
+			req:      &pb.ResetPasswordRequest{Token: token, NewPassword: ""},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "InvalidToken",
+			req:      &pb.ResetPasswordRequest{Token: "not a token", NewPassword: newPassword},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "OK",
+			req:      &pb.ResetPasswordRequest{Token: token, NewPassword: newPassword},
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "TokenAlreadyUsed",
+			req:      &pb.ResetPasswordRequest{Token: token, NewPassword: "another password"},
+			wantCode: codes.InvalidArgument,
+		},
+	} {
+		_, err := c.ResetPassword(ctx, test.req)
+		if got := status.Code(err); got != test.wantCode {
+			t.Errorf("%s: status.Code(%v) = %v; want %v", test.desc, err, got, test.wantCode)
+		}
+	}
+	if err := svc.userRepo.Authenticate(ctx, testresources.Alice.Name, newPassword); err != nil {
+		t.Errorf("Authenticate with new password = %v; want nil", err)
+	}
+	user, err := c.GetUser(ctx, &pb.GetUserRequest{Name: testresources.Alice.Name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !user.EmailAddressVerified {
+		t.Error("email address not verified after resetting password")
+	}
+}
+
+func TestService_ChangePassword_RevokesResetTokens(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	c := serveAndDial(ctx, t, svc)
+	if _, err := c.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{EmailAddress: testresources.Alice.EmailAddress}); err != nil {
+		t.Fatal(err)
+	}
+	token := lastToken(t, svc, testresources.Alice.EmailAddress)
+	if _, err := c.ChangePassword(ctx, &pb.ChangePasswordRequest{
+		User:            testresources.Alice.Name,
+		CurrentPassword: testresources.AlicePassword,
+		NewPassword:     "new alice password",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := c.ResetPassword(ctx, &pb.ResetPasswordRequest{Token: token, NewPassword: "reset password"})
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+	}
+}
+
+func TestService_VerifyEmailAddress(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	c := serveAndDial(ctx, t, svc)
+
+	created, err := c.CreateUser(ctx, &pb.CreateUserRequest{
+		User:     users.Clone(testresources.Carol),
+		Password: testresources.CarolPassword,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.EmailAddressVerified {
+		t.Error("created user has a verified email address")
+	}
+	token := lastToken(t, svc, testresources.Carol.EmailAddress)
+
+	verified, err := c.VerifyEmailAddress(ctx, &pb.VerifyEmailAddressRequest{Token: token})
+	if err != nil {
+		t.Fatalf("c.VerifyEmailAddress(token) err = %v; want nil", err)
+	}
+	if verified.Name != created.Name || !verified.EmailAddressVerified {
+		t.Errorf("c.VerifyEmailAddress(token) = %v; want verified %q", verified, created.Name)
+	}
+	_, err = c.VerifyEmailAddress(ctx, &pb.VerifyEmailAddressRequest{Token: token})
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("second c.VerifyEmailAddress(token): status.Code(%v) = %v; want %v", err, got, want)
+	}
+	_, err = c.SendVerificationEmail(ctx, &pb.SendVerificationEmailRequest{User: created.Name})
+	if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+		t.Errorf("c.SendVerificationEmail(verified): status.Code(%v) = %v; want %v", err, got, want)
+	}
+
+	// Updating other fields keeps the email address verified.
+	updated, err := c.UpdateUser(ctx, &pb.UpdateUserRequest{
+		User:       &pb.User{Name: created.Name, DisplayName: "Caroline"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !updated.EmailAddressVerified {
+		t.Error("email address not verified after updating display name")
+	}
+	_, err = c.UpdateUser(ctx, &pb.UpdateUserRequest{
+		User:       &pb.User{Name: created.Name, EmailAddressVerified: false},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email_address_verified"}},
+	})
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("c.UpdateUser(email_address_verified): status.Code(%v) = %v; want %v", err, got, want)
+	}
+
+	// Updating the email address makes it unverified, and sends a token to
+	// the new email address. Tokens sent to the old email address can no
+	// longer be used.
+	const newEmailAddress = "caroline@example.com"
+	updated, err = c.UpdateUser(ctx, &pb.UpdateUserRequest{
+		User:       &pb.User{Name: created.Name, EmailAddress: newEmailAddress, EmailAddressVerified: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email_address"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.EmailAddressVerified {
+		t.Error("email address verified after updating it")
+	}
+	newToken := lastToken(t, svc, newEmailAddress)
+	if _, err := c.SendVerificationEmail(ctx, &pb.SendVerificationEmailRequest{User: created.Name}); err != nil {
+		t.Fatalf("c.SendVerificationEmail err = %v; want nil", err)
+	}
+	if _, err := c.UpdateUser(ctx, &pb.UpdateUserRequest{
+		User:       &pb.User{Name: created.Name, EmailAddress: "carol.again@example.com"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email_address"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.VerifyEmailAddress(ctx, &pb.VerifyEmailAddressRequest{Token: newToken})
+	if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+		t.Errorf("c.VerifyEmailAddress(token for old address): status.Code(%v) = %v; want %v", err, got, want)
+	}
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/authn"
+	"github.com/Saser/strecku/internal/tokens"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/resources/stores"
+	"github.com/Saser/strecku/resources/stores/devices"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"github.com/Saser/strecku/resources/users"
+	"github.com/Saser/strecku/resources/users/cards"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// kioskPurchaseTTL is how long the tokens returned by IdentifyCard are valid.
+// It is long enough to pick a few products, but short enough that the next
+// person at the kiosk is unlikely to use the token by mistake.
+const kioskPurchaseTTL = 2 * time.Minute
+
+// errUnknownCardOrPin is returned by IdentifyCard for every failure that the
+// caller could use to probe for cards or PINs.
+var errUnknownCardOrPin = status.Error(codes.PermissionDenied, "unknown card or wrong PIN")
+
+// authorizeCardOwner fails if the caller is authenticated as another user than
+// the one that owns the cards.
+func authorizeCardOwner(ctx context.Context, user string) error {
+	if caller, ok := callerUser(ctx); ok && caller != user {
+		return status.Error(codes.PermissionDenied, "users can only manage their own cards")
+	}
+	return nil
+}
+
+func (s *Service) GetCard(ctx context.Context, req *pb.GetCardRequest) (*pb.Card, error) {
+	name := req.Name
+	if err := cards.ValidateName(name); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	user, err := cards.Parent(name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	if err := authorizeCardOwner(ctx, user); err != nil {
+		return nil, err
+	}
+	card, err := s.cardRepo.LookupCard(ctx, name)
+	if err != nil {
+		if notFound := new(cards.NotFoundError); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	return card, nil
+}
+
+func (s *Service) ListCards(ctx context.Context, req *pb.ListCardsRequest) (*pb.ListCardsResponse, error) {
+	if err := users.ValidateName(req.Parent); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if req.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative page size: %d", req.PageSize)
+	}
+	if req.PageSize > 0 || req.PageToken != "" {
+		return nil, status.Error(codes.Unimplemented, "pagination is not implemented")
+	}
+	if err := authorizeCardOwner(ctx, req.Parent); err != nil {
+		return nil, err
+	}
+	filtered, err := s.cardsOf(ctx, req.Parent)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return &pb.ListCardsResponse{
+		Cards:         filtered,
+		NextPageToken: "",
+	}, nil
+}
+
+// cardsOf returns the cards of the given user.
+func (s *Service) cardsOf(ctx context.Context, user string) ([]*pb.Card, error) {
+	return s.cardRepo.FilterCards(ctx, func(card *pb.Card) bool {
+		parent, err := cards.Parent(card.Name)
+		if err != nil {
+			return false
+		}
+		return parent == user
+	})
+}
+
+func (s *Service) CreateCard(ctx context.Context, req *pb.CreateCardRequest) (*pb.Card, error) {
+	if err := users.ValidateName(req.Parent); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if err := authorizeCardOwner(ctx, req.Parent); err != nil {
+		return nil, err
+	}
+	if _, err := s.GetUser(ctx, &pb.GetUserRequest{Name: req.Parent}); err != nil {
+		return nil, err
+	}
+	card := req.Card
+	card.Name = cards.GenerateName(req.Parent)
+	card.Uid = cards.NormalizeUID(card.Uid)
+	card.CreateTime = timestamppb.Now()
+	if err := cards.Validate(card); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid card: %v", err)
+	}
+	if err := s.cardRepo.CreateCard(ctx, card); err != nil {
+		if exists := new(cards.ExistsError); errors.As(err, &exists) {
+			return nil, status.Error(codes.AlreadyExists, exists.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	return card, nil
+}
+
+func (s *Service) DeleteCard(ctx context.Context, req *pb.DeleteCardRequest) (*emptypb.Empty, error) {
+	if err := cards.ValidateName(req.Name); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	user, err := cards.Parent(req.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	if err := authorizeCardOwner(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := s.cardRepo.DeleteCard(ctx, req.Name); err != nil {
+		if notFound := new(cards.NotFoundError); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	return new(emptypb.Empty), nil
+}
+
+func (s *Service) IdentifyCard(ctx context.Context, req *pb.IdentifyCardRequest) (*pb.IdentifyCardResponse, error) {
+	if err := stores.ValidateName(req.Store); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid store: %v", err)
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	id, _ := authn.FromContext(ctx)
+	if id.Device == "" {
+		return nil, status.Error(codes.PermissionDenied, "cards can only be identified by devices")
+	}
+	if store, err := devices.Parent(id.Device); err != nil || store != req.Store {
+		return nil, status.Errorf(codes.PermissionDenied, "device %q cannot identify cards in %q", id.Device, req.Store)
+	}
+	uid := cards.NormalizeUID(req.CardUid)
+	if err := cards.ValidateUID(uid); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid card UID: %v", err)
+	}
+	// Attempts are rate limited per kiosk before anything is looked up, and
+	// per membership once it is known, so that neither cards nor PINs can
+	// be guessed.
+	if err := s.guard.Check(ctx, ""); err != nil {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	card, err := s.cardRepo.LookupCardByUID(ctx, uid)
+	if err != nil {
+		if errors.As(err, new(*cards.NotFoundError)) {
+			s.guard.Failed(ctx, "")
+			return nil, errUnknownCardOrPin
+		}
+		return nil, internalError(ctx, err)
+	}
+	user, err := cards.Parent(card.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	membership, err := s.membershipRepo.LookupMembershipIn(ctx, req.Store, user)
+	if err != nil {
+		if errors.As(err, new(*memberships.NotFoundError)) {
+			s.guard.Failed(ctx, "")
+			return nil, errUnknownCardOrPin
+		}
+		return nil, internalError(ctx, err)
+	}
+	if err := s.guard.Check(ctx, membership.Name); err != nil {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err := s.membershipRepo.CheckPin(ctx, membership.Name, req.Pin); err != nil {
+		if errors.Is(err, memberships.ErrPinMismatch) || errors.Is(err, memberships.ErrPinNotSet) {
+			s.guard.Failed(ctx, membership.Name)
+			return nil, errUnknownCardOrPin
+		}
+		return nil, internalError(ctx, err)
+	}
+	s.guard.Succeeded(ctx, membership.Name)
+	expireTime := time.Now().Add(kioskPurchaseTTL)
+	token, err := s.tokens.Issue(tokens.Grant{
+		Purpose:    tokens.KioskPurchase,
+		User:       user,
+		Device:     id.Device,
+		Membership: membership.Name,
+	}, kioskPurchaseTTL)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return &pb.IdentifyCardResponse{
+		Membership: membership,
+		Token:      token,
+		ExpireTime: timestamppb.New(expireTime),
+	}, nil
+}
@@ -0,0 +1,430 @@
+package service
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/authn"
+	"github.com/Saser/strecku/internal/tokens"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func asUser(ctx context.Context, user string) context.Context {
+	return authn.NewContext(ctx, authn.Identity{Name: user, User: user})
+}
+
+func asDevice(ctx context.Context, device *pb.Device) context.Context {
+	return authn.NewContext(ctx, authn.Identity{Name: device.Name, Device: device.Name, Scopes: device.Scopes})
+}
+
+func TestService_GetCard(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	for _, test := range []struct {
+		desc     string
+		ctx      context.Context
+		req      *pb.GetCardRequest
+		wantCard *pb.Card
+		wantCode codes.Code
+	}{
+		{
+			desc:     "OK",
+			ctx:      ctx,
+			req:      &pb.GetCardRequest{Name: testresources.Alice_Card.Name},
+			wantCard: testresources.Alice_Card,
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "OK_Owner",
+			ctx:      asUser(ctx, testresources.Alice.Name),
+			req:      &pb.GetCardRequest{Name: testresources.Alice_Card.Name},
+			wantCard: testresources.Alice_Card,
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "OtherUser",
+			ctx:      asUser(ctx, testresources.Bob.Name),
+			req:      &pb.GetCardRequest{Name: testresources.Alice_Card.Name},
+			wantCard: nil,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "InvalidName",
+			ctx:      ctx,
+			req:      &pb.GetCardRequest{Name: testresources.Alice.Name},
+			wantCard: nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "NotFound",
+			ctx:      ctx,
+			req:      &pb.GetCardRequest{Name: testresources.Alice_Keyring.Name},
+			wantCard: nil,
+			wantCode: codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			card, err := svc.GetCard(test.ctx, test.req)
+			if diff := cmp.Diff(card, test.wantCard, protocmp.Transform()); diff != "" {
+				t.Errorf("svc.GetCard(ctx, %v) card != test.wantCard (-got +want)\n%s", test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_ListCards(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	for _, test := range []struct {
+		desc      string
+		ctx       context.Context
+		req       *pb.ListCardsRequest
+		wantCards []*pb.Card
+		wantCode  codes.Code
+	}{
+		{
+			desc:      "OK",
+			ctx:       asUser(ctx, testresources.Alice.Name),
+			req:       &pb.ListCardsRequest{Parent: testresources.Alice.Name},
+			wantCards: []*pb.Card{testresources.Alice_Card},
+			wantCode:  codes.OK,
+		},
+		{
+			desc:      "OtherUser",
+			ctx:       asUser(ctx, testresources.Bob.Name),
+			req:       &pb.ListCardsRequest{Parent: testresources.Alice.Name},
+			wantCards: nil,
+			wantCode:  codes.PermissionDenied,
+		},
+		{
+			desc:      "InvalidParent",
+			ctx:       ctx,
+			req:       &pb.ListCardsRequest{Parent: testresources.Bar.Name},
+			wantCards: nil,
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			desc:      "NegativePageSize",
+			ctx:       ctx,
+			req:       &pb.ListCardsRequest{Parent: testresources.Alice.Name, PageSize: -1},
+			wantCards: nil,
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			desc:      "PaginationUnimplemented",
+			ctx:       ctx,
+			req:       &pb.ListCardsRequest{Parent: testresources.Alice.Name, PageToken: "token"},
+			wantCards: nil,
+			wantCode:  codes.Unimplemented,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			res, err := svc.ListCards(test.ctx, test.req)
+			if diff := cmp.Diff(res.GetCards(), test.wantCards, protocmp.Transform(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("svc.ListCards(ctx, %v) cards != test.wantCards (-got +want)\n%s", test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_CreateCard(t *testing.T) {
+	ctx := context.Background()
+	for _, test := range []struct {
+		desc     string
+		ctx      context.Context
+		req      *pb.CreateCardRequest
+		wantUID  string
+		wantCode codes.Code
+	}{
+		{
+			desc: "OK",
+			ctx:  asUser(ctx, testresources.Alice.Name),
+			req: &pb.CreateCardRequest{
+				Parent: testresources.Alice.Name,
+				Card:   &pb.Card{Uid: "5f:2e:9a:11", DisplayName: "Keyring tag"},
+			},
+			wantUID:  "5F2E9A11",
+			wantCode: codes.OK,
+		},
+		{
+			desc: "OtherUser",
+			ctx:  asUser(ctx, testresources.Bob.Name),
+			req: &pb.CreateCardRequest{
+				Parent: testresources.Alice.Name,
+				Card:   &pb.Card{Uid: "5F2E9A11"},
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc: "DuplicateUID",
+			ctx:  ctx,
+			req: &pb.CreateCardRequest{
+				Parent: testresources.Alice.Name,
+				Card:   &pb.Card{Uid: "04-f1-e2-d3-c4-b5-a6"},
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			desc: "EmptyUID",
+			ctx:  ctx,
+			req: &pb.CreateCardRequest{
+				Parent: testresources.Alice.Name,
+				Card:   &pb.Card{},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "InvalidUID",
+			ctx:  ctx,
+			req: &pb.CreateCardRequest{
+				Parent: testresources.Alice.Name,
+				Card:   &pb.Card{Uid: "not hex"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "InvalidParent",
+			ctx:  ctx,
+			req: &pb.CreateCardRequest{
+				Parent: testresources.Bar.Name,
+				Card:   &pb.Card{Uid: "5F2E9A11"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "UserNotFound",
+			ctx:  ctx,
+			req: &pb.CreateCardRequest{
+				Parent: testresources.Carol.Name,
+				Card:   &pb.Card{Uid: "5F2E9A11"},
+			},
+			wantCode: codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			svc := seed(ctx, t)
+			card, err := svc.CreateCard(test.ctx, test.req)
+			if got := status.Code(err); got != test.wantCode {
+				t.Fatalf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			if got := card.Uid; got != test.wantUID {
+				t.Errorf("card.Uid = %q; want %q", got, test.wantUID)
+			}
+			got, err := svc.GetCard(ctx, &pb.GetCardRequest{Name: card.Name})
+			if err != nil {
+				t.Fatalf("svc.GetCard(ctx, %q) err = %v; want nil", card.Name, err)
+			}
+			if diff := cmp.Diff(got, card, protocmp.Transform()); diff != "" {
+				t.Errorf("svc.GetCard(ctx, %q) != card (-got +want)\n%s", card.Name, diff)
+			}
+		})
+	}
+}
+
+func TestService_DeleteCard(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	name := testresources.Alice_Card.Name
+	_, err := svc.DeleteCard(asUser(ctx, testresources.Bob.Name), &pb.DeleteCardRequest{Name: name})
+	if got, want := status.Code(err), codes.PermissionDenied; got != want {
+		t.Errorf("svc.DeleteCard(as Bob, %q): status.Code(%v) = %v; want %v", name, err, got, want)
+	}
+	if _, err := svc.DeleteCard(asUser(ctx, testresources.Alice.Name), &pb.DeleteCardRequest{Name: name}); err != nil {
+		t.Fatalf("svc.DeleteCard(as Alice, %q) err = %v; want nil", name, err)
+	}
+	_, err = svc.GetCard(ctx, &pb.GetCardRequest{Name: name})
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Errorf("svc.GetCard(deleted card): status.Code(%v) = %v; want %v", err, got, want)
+	}
+	_, err = svc.DeleteCard(ctx, &pb.DeleteCardRequest{Name: name})
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Errorf("second svc.DeleteCard(%q): status.Code(%v) = %v; want %v", name, err, got, want)
+	}
+	_, err = svc.DeleteCard(ctx, &pb.DeleteCardRequest{Name: testresources.Alice.Name})
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("svc.DeleteCard(invalid name): status.Code(%v) = %v; want %v", err, got, want)
+	}
+}
+
+// alicePin is the PIN of Alice in Bar, set by setAlicePin.
+const alicePin = "1234"
+
+func setAlicePin(ctx context.Context, t *testing.T, svc *Service) {
+	t.Helper()
+	if err := svc.membershipRepo.SetPin(ctx, testresources.Bar_Alice.Name, alicePin); err != nil {
+		t.Fatalf("svc.membershipRepo.SetPin(ctx, %q, %q) = %v; want nil", testresources.Bar_Alice.Name, alicePin, err)
+	}
+}
+
+func TestService_IdentifyCard(t *testing.T) {
+	ctx := context.Background()
+	kiosk := asDevice(ctx, testresources.Bar_Kiosk)
+	t.Run("OK", func(t *testing.T) {
+		svc := seed(ctx, t)
+		setAlicePin(ctx, t, svc)
+		req := &pb.IdentifyCardRequest{
+			Store:   testresources.Bar.Name,
+			CardUid: "04:a3:b2:c1:d2:e3:80",
+			Pin:     alicePin,
+		}
+		res, err := svc.IdentifyCard(kiosk, req)
+		if err != nil {
+			t.Fatalf("svc.IdentifyCard(kiosk, %v) err = %v; want nil", req, err)
+		}
+		wantMembership := memberships.Clone(testresources.Bar_Alice)
+		wantMembership.PinSet = true
+		if diff := cmp.Diff(res.Membership, wantMembership, protocmp.Transform()); diff != "" {
+			t.Errorf("res.Membership != wantMembership (-got +want)\n%s", diff)
+		}
+		grant, err := svc.tokens.Lookup(tokens.KioskPurchase, res.Token)
+		if err != nil {
+			t.Fatalf("svc.tokens.Lookup(KioskPurchase, res.Token) err = %v; want nil", err)
+		}
+		wantGrant := tokens.Grant{
+			Purpose:    tokens.KioskPurchase,
+			User:       testresources.Alice.Name,
+			Device:     testresources.Bar_Kiosk.Name,
+			Membership: testresources.Bar_Alice.Name,
+		}
+		if grant != wantGrant {
+			t.Errorf("grant = %+v; want %+v", grant, wantGrant)
+		}
+		if res.ExpireTime == nil {
+			t.Error("res.ExpireTime = nil; want non-nil")
+		}
+	})
+
+	for _, test := range []struct {
+		desc     string
+		ctx      context.Context
+		req      *pb.IdentifyCardRequest
+		wantCode codes.Code
+	}{
+		{
+			desc:     "NotDevice",
+			ctx:      asUser(ctx, testresources.Alice.Name),
+			req:      &pb.IdentifyCardRequest{Store: testresources.Bar.Name, CardUid: testresources.Alice_Card.Uid, Pin: alicePin},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "OtherStore",
+			ctx:      kiosk,
+			req:      &pb.IdentifyCardRequest{Store: testresources.Mall.Name, CardUid: testresources.Alice_Card.Uid, Pin: alicePin},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "UnknownCard",
+			ctx:      kiosk,
+			req:      &pb.IdentifyCardRequest{Store: testresources.Bar.Name, CardUid: testresources.Alice_Keyring.Uid, Pin: alicePin},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "NotMember",
+			ctx:      asDevice(ctx, testresources.Mall_Kiosk),
+			req:      &pb.IdentifyCardRequest{Store: testresources.Mall.Name, CardUid: testresources.Bob_Card.Uid, Pin: alicePin},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "WrongPin",
+			ctx:      kiosk,
+			req:      &pb.IdentifyCardRequest{Store: testresources.Bar.Name, CardUid: testresources.Alice_Card.Uid, Pin: "4321"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "PinNotSet",
+			ctx:      kiosk,
+			req:      &pb.IdentifyCardRequest{Store: testresources.Bar.Name, CardUid: testresources.Bob_Card.Uid, Pin: alicePin},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc:     "InvalidUID",
+			ctx:      kiosk,
+			req:      &pb.IdentifyCardRequest{Store: testresources.Bar.Name, CardUid: "not hex", Pin: alicePin},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "InvalidStore",
+			ctx:      kiosk,
+			req:      &pb.IdentifyCardRequest{Store: testresources.Bar_Kiosk.Name, CardUid: testresources.Alice_Card.Uid, Pin: alicePin},
+			wantCode: codes.InvalidArgument,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			svc := seed(ctx, t)
+			setAlicePin(ctx, t, svc)
+			_, err := svc.IdentifyCard(test.ctx, test.req)
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_IdentifyCard_RateLimit(t *testing.T) {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 50000}})
+	kiosk := asDevice(ctx, testresources.Bar_Kiosk)
+	identify := func(svc *Service, uid, pin string) error {
+		_, err := svc.IdentifyCard(kiosk, &pb.IdentifyCardRequest{
+			Store:   testresources.Bar.Name,
+			CardUid: uid,
+			Pin:     pin,
+		})
+		return err
+	}
+
+	t.Run("UnknownCard", func(t *testing.T) {
+		svc := seed(ctx, t)
+		setAlicePin(ctx, t, svc)
+		if got, want := status.Code(identify(svc, testresources.Alice_Keyring.Uid, alicePin)), codes.PermissionDenied; got != want {
+			t.Errorf("unknown card: code = %v; want %v", got, want)
+		}
+		// The kiosk has to wait before trying again, even with a known card.
+		if got, want := status.Code(identify(svc, testresources.Alice_Card.Uid, alicePin)), codes.ResourceExhausted; got != want {
+			t.Errorf("right PIN after unknown card: code = %v; want %v", got, want)
+		}
+	})
+
+	t.Run("WrongPin", func(t *testing.T) {
+		svc := seed(ctx, t)
+		setAlicePin(ctx, t, svc)
+		// Attempts made while blocked do not count, so the delays between
+		// failures are disabled.
+		svc.guard.Account.BaseDelay = 0
+		svc.guard.Account.MaxDelay = 0
+		svc.guard.Peer.BaseDelay = 0
+		svc.guard.Peer.MaxDelay = 0
+		for i := 0; i < svc.guard.Account.MaxFailures; i++ {
+			if got, want := status.Code(identify(svc, testresources.Alice_Card.Uid, "4321")), codes.PermissionDenied; got != want {
+				t.Fatalf("failure %d: code = %v; want %v", i, got, want)
+			}
+		}
+		if got, want := status.Code(identify(svc, testresources.Alice_Card.Uid, alicePin)), codes.ResourceExhausted; got != want {
+			t.Errorf("right PIN after lockout: code = %v; want %v", got, want)
+		}
+		// Other members can still identify themselves at the kiosk.
+		if err := svc.membershipRepo.SetPin(ctx, testresources.Bar_Bob.Name, "5678"); err != nil {
+			t.Fatal(err)
+		}
+		if err := identify(svc, testresources.Bob_Card.Uid, "5678"); err != nil {
+			t.Errorf("other member: err = %v; want nil", err)
+		}
+	})
+}
@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/resources/stores"
+	"github.com/Saser/strecku/resources/stores/devices"
+	"github.com/Saser/strecku/resources/stores/devices/apikeys"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) GetDevice(ctx context.Context, req *pb.GetDeviceRequest) (*pb.Device, error) {
+	name := req.Name
+	if err := devices.ValidateName(name); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	device, err := s.deviceRepo.LookupDevice(ctx, name)
+	if err != nil {
+		if notFound := new(devices.NotFoundError); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	return device, nil
+}
+
+func (s *Service) ListDevices(ctx context.Context, req *pb.ListDevicesRequest) (*pb.ListDevicesResponse, error) {
+	if err := stores.ValidateName(req.Parent); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if req.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative page size: %d", req.PageSize)
+	}
+	if req.PageSize > 0 || req.PageToken != "" {
+		return nil, status.Error(codes.Unimplemented, "pagination is not implemented")
+	}
+	filtered, err := s.deviceRepo.FilterDevices(ctx, func(device *pb.Device) bool {
+		parent, err := devices.Parent(device.Name)
+		if err != nil {
+			return false
+		}
+		return parent == req.Parent
+	})
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return &pb.ListDevicesResponse{
+		Devices:       filtered,
+		NextPageToken: "",
+	}, nil
+}
+
+func (s *Service) CreateDevice(ctx context.Context, req *pb.CreateDeviceRequest) (*pb.Device, error) {
+	if err := stores.ValidateName(req.Parent); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if _, err := s.GetStore(ctx, &pb.GetStoreRequest{Name: req.Parent}); err != nil {
+		return nil, err
+	}
+	if err := s.checkStoreAdministrator(ctx, req.Parent); err != nil {
+		return nil, err
+	}
+	device := req.Device
+	device.Name = devices.GenerateName(req.Parent)
+	device.CreateTime = timestamppb.Now()
+	if err := devices.Validate(device); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid device: %v", err)
+	}
+	if err := s.deviceRepo.CreateDevice(ctx, device); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return device, nil
+}
+
+func (s *Service) UpdateDevice(ctx context.Context, req *pb.UpdateDeviceRequest) (*pb.Device, error) {
+	src := req.Device
+	dst, err := s.GetDevice(ctx, &pb.GetDeviceRequest{Name: src.Name})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkDeviceAdministrator(ctx, dst.Name); err != nil {
+		return nil, err
+	}
+	createTime := dst.CreateTime
+	mask := req.UpdateMask
+	if mask == nil {
+		dst = src
+	} else {
+		if !mask.IsValid(dst) {
+			return nil, status.Error(codes.InvalidArgument, "invalid update mask")
+		}
+		for _, path := range mask.Paths {
+			switch path {
+			case "display_name":
+				dst.DisplayName = src.DisplayName
+			case "scopes":
+				dst.Scopes = src.Scopes
+			case "create_time":
+				return nil, status.Errorf(codes.InvalidArgument, `field "create_time" cannot be updated`)
+			default:
+				return nil, status.Errorf(codes.Internal, "update not implemented for path %q", path)
+			}
+		}
+	}
+	dst.CreateTime = createTime
+	if err := devices.Validate(dst); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid device: %v", err)
+	}
+	if err := s.deviceRepo.UpdateDevice(ctx, dst); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return dst, nil
+}
+
+func (s *Service) DeleteDevice(ctx context.Context, req *pb.DeleteDeviceRequest) (*emptypb.Empty, error) {
+	if err := devices.ValidateName(req.Name); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if err := s.checkDeviceAdministrator(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	if err := s.deviceRepo.DeleteDevice(ctx, req.Name); err != nil {
+		if notFound := new(devices.NotFoundError); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	// API keys of deleted devices are already rejected by authn.APIKeys,
+	// but they are revoked as well.
+	keys, err := s.apiKeysOf(ctx, req.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	for _, key := range keys {
+		if err := s.apiKeyRepo.DeleteApiKey(ctx, key.Name); err != nil {
+			return nil, internalError(ctx, err)
+		}
+	}
+	return new(emptypb.Empty), nil
+}
+
+// checkDeviceAdministrator is like checkStoreAdministrator, for the store of
+// device.
+func (s *Service) checkDeviceAdministrator(ctx context.Context, device string) error {
+	store, err := devices.Parent(device)
+	if err != nil {
+		return internalError(ctx, err)
+	}
+	return s.checkStoreAdministrator(ctx, store)
+}
+
+// apiKeysOf returns the API keys of the given device.
+func (s *Service) apiKeysOf(ctx context.Context, device string) ([]*pb.ApiKey, error) {
+	return s.apiKeyRepo.FilterApiKeys(ctx, func(apiKey *pb.ApiKey) bool {
+		parent, err := apikeys.Parent(apiKey.Name)
+		if err != nil {
+			return false
+		}
+		return parent == device
+	})
+}
+
+func (s *Service) GetApiKey(ctx context.Context, req *pb.GetApiKeyRequest) (*pb.ApiKey, error) {
+	name := req.Name
+	if err := apikeys.ValidateName(name); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	apiKey, err := s.apiKeyRepo.LookupApiKey(ctx, name)
+	if err != nil {
+		if notFound := new(apikeys.NotFoundError); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	return apiKey, nil
+}
+
+func (s *Service) ListApiKeys(ctx context.Context, req *pb.ListApiKeysRequest) (*pb.ListApiKeysResponse, error) {
+	if err := devices.ValidateName(req.Parent); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if req.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative page size: %d", req.PageSize)
+	}
+	if req.PageSize > 0 || req.PageToken != "" {
+		return nil, status.Error(codes.Unimplemented, "pagination is not implemented")
+	}
+	if err := s.checkDeviceAdministrator(ctx, req.Parent); err != nil {
+		return nil, err
+	}
+	filtered, err := s.apiKeysOf(ctx, req.Parent)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return &pb.ListApiKeysResponse{
+		ApiKeys:       filtered,
+		NextPageToken: "",
+	}, nil
+}
+
+func (s *Service) CreateApiKey(ctx context.Context, req *pb.CreateApiKeyRequest) (*pb.ApiKey, error) {
+	if err := devices.ValidateName(req.Parent); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if _, err := s.GetDevice(ctx, &pb.GetDeviceRequest{Name: req.Parent}); err != nil {
+		return nil, err
+	}
+	if err := s.checkDeviceAdministrator(ctx, req.Parent); err != nil {
+		return nil, err
+	}
+	secret, err := apikeys.GenerateSecret()
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	apiKey := &pb.ApiKey{
+		Name:       apikeys.GenerateName(req.Parent),
+		Secret:     secret,
+		CreateTime: timestamppb.Now(),
+	}
+	if err := s.apiKeyRepo.CreateApiKey(ctx, apiKey); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return apiKey, nil
+}
+
+func (s *Service) DeleteApiKey(ctx context.Context, req *pb.DeleteApiKeyRequest) (*emptypb.Empty, error) {
+	if err := apikeys.ValidateName(req.Name); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	device, err := apikeys.Parent(req.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	if err := s.checkDeviceAdministrator(ctx, device); err != nil {
+		return nil, err
+	}
+	if err := s.apiKeyRepo.DeleteApiKey(ctx, req.Name); err != nil {
+		if notFound := new(apikeys.NotFoundError); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	return new(emptypb.Empty), nil
+}
+
+func (s *Service) RotateApiKey(ctx context.Context, req *pb.RotateApiKeyRequest) (*pb.ApiKey, error) {
+	apiKey, err := s.GetApiKey(ctx, &pb.GetApiKeyRequest{Name: req.Name})
+	if err != nil {
+		return nil, err
+	}
+	device, err := apikeys.Parent(apiKey.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	if err := s.checkDeviceAdministrator(ctx, device); err != nil {
+		return nil, err
+	}
+	secret, err := apikeys.GenerateSecret()
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	apiKey.Secret = secret
+	apiKey.RotateTime = timestamppb.Now()
+	if err := s.apiKeyRepo.UpdateApiKey(ctx, apiKey); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return apiKey, nil
+}
@@ -0,0 +1,561 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/devices"
+	"github.com/Saser/strecku/resources/stores/devices/apikeys"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestService_GetDevice(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	for _, test := range []struct {
+		desc       string
+		req        *pb.GetDeviceRequest
+		wantDevice *pb.Device
+		wantCode   codes.Code
+	}{
+		{
+			desc:       "OK",
+			req:        &pb.GetDeviceRequest{Name: testresources.Bar_Kiosk.Name},
+			wantDevice: testresources.Bar_Kiosk,
+			wantCode:   codes.OK,
+		},
+		{
+			desc:       "InvalidName",
+			req:        &pb.GetDeviceRequest{Name: testresources.Bar.Name},
+			wantDevice: nil,
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			desc:       "NotFound",
+			req:        &pb.GetDeviceRequest{Name: testresources.Bar_Register.Name},
+			wantDevice: nil,
+			wantCode:   codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			device, err := c.GetDevice(ctx, test.req)
+			if diff := cmp.Diff(device, test.wantDevice, protocmp.Transform()); diff != "" {
+				t.Errorf("c.GetDevice(%v, %v) device != test.wantDevice (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_ListDevices(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	for _, test := range []struct {
+		desc     string
+		req      *pb.ListDevicesRequest
+		wantResp *pb.ListDevicesResponse
+		wantCode codes.Code
+	}{
+		{
+			desc: "OK",
+			req:  &pb.ListDevicesRequest{Parent: testresources.Bar.Name},
+			wantResp: &pb.ListDevicesResponse{
+				Devices: []*pb.Device{testresources.Bar_Kiosk},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "InvalidParent",
+			req:      &pb.ListDevicesRequest{Parent: testresources.Alice.Name},
+			wantResp: nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "Pagination",
+			req:      &pb.ListDevicesRequest{Parent: testresources.Bar.Name, PageSize: 1},
+			wantResp: nil,
+			wantCode: codes.Unimplemented,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			resp, err := c.ListDevices(ctx, test.req)
+			if diff := cmp.Diff(resp, test.wantResp, protocmp.Transform()); diff != "" {
+				t.Errorf("c.ListDevices(%v, %v) resp != test.wantResp (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_CreateDevice(t *testing.T) {
+	ctx := asOperator(context.Background())
+	for _, test := range []struct {
+		desc     string
+		req      *pb.CreateDeviceRequest
+		wantCode codes.Code
+	}{
+		{
+			desc: "OK",
+			req: &pb.CreateDeviceRequest{
+				Parent: testresources.Bar.Name,
+				Device: &pb.Device{
+					DisplayName: "Register",
+					Scopes:      []string{devices.ScopePaymentsCreate},
+				},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "UnknownScope",
+			req: &pb.CreateDeviceRequest{
+				Parent: testresources.Bar.Name,
+				Device: &pb.Device{
+					DisplayName: "Register",
+					Scopes:      []string{"products.write"},
+				},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "NoScopes",
+			req: &pb.CreateDeviceRequest{
+				Parent: testresources.Bar.Name,
+				Device: &pb.Device{DisplayName: "Register"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "InvalidParent",
+			req: &pb.CreateDeviceRequest{
+				Parent: testresources.Alice.Name,
+				Device: &pb.Device{
+					DisplayName: "Register",
+					Scopes:      []string{devices.ScopePaymentsCreate},
+				},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "StoreNotFound",
+			req: &pb.CreateDeviceRequest{
+				Parent: testresources.Pharmacy.Name,
+				Device: &pb.Device{
+					DisplayName: "Register",
+					Scopes:      []string{devices.ScopePaymentsCreate},
+				},
+			},
+			wantCode: codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			svc := seed(ctx, t)
+			device, err := svc.CreateDevice(ctx, test.req)
+			if got := status.Code(err); got != test.wantCode {
+				t.Fatalf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+			if test.wantCode != codes.OK {
+				return
+			}
+			if err := devices.Validate(device); err != nil {
+				t.Errorf("devices.Validate(%v) = %v; want nil", device, err)
+			}
+			if parent, _ := devices.Parent(device.Name); parent != test.req.Parent {
+				t.Errorf("devices.Parent(%q) = %q; want %q", device.Name, parent, test.req.Parent)
+			}
+			got, err := svc.GetDevice(ctx, &pb.GetDeviceRequest{Name: device.Name})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, device, protocmp.Transform()); diff != "" {
+				t.Errorf("svc.GetDevice(%q) != created device (-got +want)\n%s", device.Name, diff)
+			}
+		})
+	}
+}
+
+func TestService_UpdateDevice(t *testing.T) {
+	ctx := asOperator(context.Background())
+	for _, test := range []struct {
+		desc       string
+		req        *pb.UpdateDeviceRequest
+		wantDevice *pb.Device
+		wantCode   codes.Code
+	}{
+		{
+			desc: "OK_Scopes",
+			req: &pb.UpdateDeviceRequest{
+				Device: &pb.Device{
+					Name:   testresources.Bar_Kiosk.Name,
+					Scopes: []string{devices.ScopeProductsRead},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"scopes"}},
+			},
+			wantDevice: func() *pb.Device {
+				device := devices.Clone(testresources.Bar_Kiosk)
+				device.Scopes = []string{devices.ScopeProductsRead}
+				return device
+			}(),
+			wantCode: codes.OK,
+		},
+		{
+			desc: "OK_FullUpdate",
+			req: &pb.UpdateDeviceRequest{
+				Device: &pb.Device{
+					Name:        testresources.Bar_Kiosk.Name,
+					DisplayName: "Kiosk by the door",
+					Scopes:      []string{devices.ScopeStoresRead},
+				},
+			},
+			wantDevice: func() *pb.Device {
+				device := devices.Clone(testresources.Bar_Kiosk)
+				device.DisplayName = "Kiosk by the door"
+				device.Scopes = []string{devices.ScopeStoresRead}
+				return device
+			}(),
+			wantCode: codes.OK,
+		},
+		{
+			desc: "UnknownScope",
+			req: &pb.UpdateDeviceRequest{
+				Device: &pb.Device{
+					Name:   testresources.Bar_Kiosk.Name,
+					Scopes: []string{"products.write"},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"scopes"}},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "CreateTime",
+			req: &pb.UpdateDeviceRequest{
+				Device:     &pb.Device{Name: testresources.Bar_Kiosk.Name},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"create_time"}},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "NotFound",
+			req: &pb.UpdateDeviceRequest{
+				Device: &pb.Device{
+					Name:        testresources.Bar_Register.Name,
+					DisplayName: "Register",
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+			},
+			wantCode: codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			svc := seed(ctx, t)
+			device, err := svc.UpdateDevice(ctx, test.req)
+			if diff := cmp.Diff(device, test.wantDevice, protocmp.Transform()); diff != "" {
+				t.Errorf("svc.UpdateDevice(%v, %v) device != test.wantDevice (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_DeleteDevice(t *testing.T) {
+	ctx := asOperator(context.Background())
+	svc := seed(ctx, t)
+	name := testresources.Bar_Kiosk.Name
+	if _, err := svc.DeleteDevice(ctx, &pb.DeleteDeviceRequest{Name: name}); err != nil {
+		t.Fatalf("svc.DeleteDevice(%q) err = %v; want nil", name, err)
+	}
+	_, err := svc.GetApiKey(ctx, &pb.GetApiKeyRequest{Name: testresources.Bar_Kiosk_ApiKey.Name})
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Errorf("svc.GetApiKey(key of deleted device): status.Code(%v) = %v; want %v", err, got, want)
+	}
+	if _, err := svc.apiKeyRepo.Authenticate(ctx, testresources.Bar_Kiosk_ApiKeySecret); err != apikeys.ErrUnauthenticated {
+		t.Errorf("svc.apiKeyRepo.Authenticate(key of deleted device) err = %v; want %v", err, apikeys.ErrUnauthenticated)
+	}
+	if _, err := svc.apiKeyRepo.Authenticate(ctx, testresources.Mall_Kiosk_ApiKeySecret); err != nil {
+		t.Errorf("svc.apiKeyRepo.Authenticate(key of other device) err = %v; want nil", err)
+	}
+	_, err = svc.DeleteDevice(ctx, &pb.DeleteDeviceRequest{Name: name})
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Errorf("second svc.DeleteDevice(%q): status.Code(%v) = %v; want %v", name, err, got, want)
+	}
+}
+
+func TestService_GetApiKey(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	for _, test := range []struct {
+		desc       string
+		req        *pb.GetApiKeyRequest
+		wantAPIKey *pb.ApiKey
+		wantCode   codes.Code
+	}{
+		{
+			desc:       "OK",
+			req:        &pb.GetApiKeyRequest{Name: testresources.Bar_Kiosk_ApiKey.Name},
+			wantAPIKey: testresources.Bar_Kiosk_ApiKey,
+			wantCode:   codes.OK,
+		},
+		{
+			desc:       "InvalidName",
+			req:        &pb.GetApiKeyRequest{Name: testresources.Bar_Kiosk.Name},
+			wantAPIKey: nil,
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			desc:       "NotFound",
+			req:        &pb.GetApiKeyRequest{Name: testresources.Bar_Kiosk.Name + "/apikeys/00000000-0000-0000-0000-000000000000"},
+			wantAPIKey: nil,
+			wantCode:   codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			apiKey, err := c.GetApiKey(ctx, test.req)
+			if diff := cmp.Diff(apiKey, test.wantAPIKey, protocmp.Transform()); diff != "" {
+				t.Errorf("c.GetApiKey(%v, %v) apiKey != test.wantAPIKey (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_ListApiKeys(t *testing.T) {
+	ctx := asOperator(context.Background())
+	svc := seed(ctx, t)
+	req := &pb.ListApiKeysRequest{Parent: testresources.Mall_Kiosk.Name}
+	resp, err := svc.ListApiKeys(ctx, req)
+	if err != nil {
+		t.Fatalf("svc.ListApiKeys(%v) err = %v; want nil", req, err)
+	}
+	want := &pb.ListApiKeysResponse{ApiKeys: []*pb.ApiKey{testresources.Mall_Kiosk_ApiKey}}
+	if diff := cmp.Diff(resp, want, protocmp.Transform()); diff != "" {
+		t.Errorf("svc.ListApiKeys(%v) resp != want (-got +want)\n%s", req, diff)
+	}
+	_, err = svc.ListApiKeys(ctx, &pb.ListApiKeysRequest{Parent: testresources.Mall.Name})
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("svc.ListApiKeys(store): status.Code(%v) = %v; want %v", err, got, want)
+	}
+}
+
+func TestService_CreateApiKey(t *testing.T) {
+	ctx := asOperator(context.Background())
+	svc := seed(ctx, t)
+	req := &pb.CreateApiKeyRequest{Parent: testresources.Bar_Kiosk.Name}
+	apiKey, err := svc.CreateApiKey(ctx, req)
+	if err != nil {
+		t.Fatalf("svc.CreateApiKey(%v) err = %v; want nil", req, err)
+	}
+	if err := apikeys.Validate(apiKey); err != nil {
+		t.Errorf("apikeys.Validate(%v) = %v; want nil", apiKey, err)
+	}
+	if apiKey.Secret == "" {
+		t.Fatalf("svc.CreateApiKey(%v) returned no secret", req)
+	}
+	authenticated, err := svc.apiKeyRepo.Authenticate(ctx, apiKey.Secret)
+	if err != nil {
+		t.Errorf("svc.apiKeyRepo.Authenticate(new secret) err = %v; want nil", err)
+	}
+	if got, want := authenticated.GetName(), apiKey.Name; got != want {
+		t.Errorf("svc.apiKeyRepo.Authenticate(new secret) name = %q; want %q", got, want)
+	}
+	// The secret is only shown once.
+	got, err := svc.GetApiKey(ctx, &pb.GetApiKeyRequest{Name: apiKey.Name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Secret != "" {
+		t.Errorf("svc.GetApiKey(%q) returned secret %q; want none", apiKey.Name, got.Secret)
+	}
+
+	for _, test := range []struct {
+		desc     string
+		req      *pb.CreateApiKeyRequest
+		wantCode codes.Code
+	}{
+		{
+			desc:     "InvalidParent",
+			req:      &pb.CreateApiKeyRequest{Parent: testresources.Bar.Name},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "DeviceNotFound",
+			req:      &pb.CreateApiKeyRequest{Parent: testresources.Bar_Register.Name},
+			wantCode: codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := svc.CreateApiKey(ctx, test.req)
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_RotateApiKey(t *testing.T) {
+	ctx := asOperator(context.Background())
+	svc := seed(ctx, t)
+	req := &pb.RotateApiKeyRequest{Name: testresources.Bar_Kiosk_ApiKey.Name}
+	apiKey, err := svc.RotateApiKey(ctx, req)
+	if err != nil {
+		t.Fatalf("svc.RotateApiKey(%v) err = %v; want nil", req, err)
+	}
+	if apiKey.Secret == "" || apiKey.Secret == testresources.Bar_Kiosk_ApiKeySecret {
+		t.Errorf("svc.RotateApiKey(%v) secret = %q; want a new secret", req, apiKey.Secret)
+	}
+	if apiKey.RotateTime == nil {
+		t.Errorf("svc.RotateApiKey(%v) rotate time is unset", req)
+	}
+	if _, err := svc.apiKeyRepo.Authenticate(ctx, testresources.Bar_Kiosk_ApiKeySecret); err != apikeys.ErrUnauthenticated {
+		t.Errorf("svc.apiKeyRepo.Authenticate(old secret) err = %v; want %v", err, apikeys.ErrUnauthenticated)
+	}
+	if _, err := svc.apiKeyRepo.Authenticate(ctx, apiKey.Secret); err != nil {
+		t.Errorf("svc.apiKeyRepo.Authenticate(new secret) err = %v; want nil", err)
+	}
+	_, err = svc.RotateApiKey(ctx, &pb.RotateApiKeyRequest{Name: testresources.Bar_Kiosk.Name})
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("svc.RotateApiKey(device): status.Code(%v) = %v; want %v", err, got, want)
+	}
+}
+
+func TestService_DeleteApiKey(t *testing.T) {
+	ctx := asOperator(context.Background())
+	svc := seed(ctx, t)
+	name := testresources.Bar_Kiosk_ApiKey.Name
+	if _, err := svc.DeleteApiKey(ctx, &pb.DeleteApiKeyRequest{Name: name}); err != nil {
+		t.Fatalf("svc.DeleteApiKey(%q) err = %v; want nil", name, err)
+	}
+	if _, err := svc.apiKeyRepo.Authenticate(ctx, testresources.Bar_Kiosk_ApiKeySecret); err != apikeys.ErrUnauthenticated {
+		t.Errorf("svc.apiKeyRepo.Authenticate(revoked secret) err = %v; want %v", err, apikeys.ErrUnauthenticated)
+	}
+	_, err := svc.DeleteApiKey(ctx, &pb.DeleteApiKeyRequest{Name: name})
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Errorf("second svc.DeleteApiKey(%q): status.Code(%v) = %v; want %v", name, err, got, want)
+	}
+}
+
+func TestService_Devices_Administrator(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	makeAdministrator(ctx, t, svc, testresources.Bar_Alice)
+	alice := asUser(ctx, testresources.Alice.Name)
+	bob := asUser(ctx, testresources.Bob.Name)
+	updateDevice := &pb.UpdateDeviceRequest{
+		Device:     &pb.Device{Name: testresources.Bar_Kiosk.Name, DisplayName: "Register"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	}
+	// Bob is a member of Bar, but not an administrator, and not a member of
+	// Mall. Neither anonymous callers nor devices may manage devices.
+	for _, test := range []struct {
+		desc string
+		call func(context.Context) error
+	}{
+		{
+			desc: "CreateDevice_NotAdministrator",
+			call: func(ctx context.Context) error {
+				_, err := svc.CreateDevice(ctx, &pb.CreateDeviceRequest{
+					Parent: testresources.Bar.Name,
+					Device: &pb.Device{DisplayName: "Register"},
+				})
+				return err
+			},
+		},
+		{
+			desc: "CreateDevice_NotMember",
+			call: func(ctx context.Context) error {
+				_, err := svc.CreateDevice(ctx, &pb.CreateDeviceRequest{
+					Parent: testresources.Mall.Name,
+					Device: &pb.Device{DisplayName: "Register"},
+				})
+				return err
+			},
+		},
+		{
+			desc: "UpdateDevice",
+			call: func(ctx context.Context) error {
+				_, err := svc.UpdateDevice(ctx, updateDevice)
+				return err
+			},
+		},
+		{
+			desc: "DeleteDevice",
+			call: func(ctx context.Context) error {
+				_, err := svc.DeleteDevice(ctx, &pb.DeleteDeviceRequest{Name: testresources.Bar_Kiosk.Name})
+				return err
+			},
+		},
+		{
+			desc: "ListApiKeys",
+			call: func(ctx context.Context) error {
+				_, err := svc.ListApiKeys(ctx, &pb.ListApiKeysRequest{Parent: testresources.Bar_Kiosk.Name})
+				return err
+			},
+		},
+		{
+			desc: "CreateApiKey",
+			call: func(ctx context.Context) error {
+				_, err := svc.CreateApiKey(ctx, &pb.CreateApiKeyRequest{Parent: testresources.Bar_Kiosk.Name})
+				return err
+			},
+		},
+		{
+			desc: "RotateApiKey",
+			call: func(ctx context.Context) error {
+				_, err := svc.RotateApiKey(ctx, &pb.RotateApiKeyRequest{Name: testresources.Bar_Kiosk_ApiKey.Name})
+				return err
+			},
+		},
+		{
+			desc: "DeleteApiKey",
+			call: func(ctx context.Context) error {
+				_, err := svc.DeleteApiKey(ctx, &pb.DeleteApiKeyRequest{Name: testresources.Bar_Kiosk_ApiKey.Name})
+				return err
+			},
+		},
+	} {
+		for _, caller := range []struct {
+			desc string
+			ctx  context.Context
+			want codes.Code
+		}{
+			{desc: "Bob", ctx: bob, want: codes.PermissionDenied},
+			{desc: "Anonymous", ctx: ctx, want: codes.Unauthenticated},
+			{desc: "Device", ctx: asDevice(ctx, testresources.Bar_Kiosk), want: codes.PermissionDenied},
+		} {
+			t.Run(test.desc+"_"+caller.desc, func(t *testing.T) {
+				err := test.call(caller.ctx)
+				if got := status.Code(err); got != caller.want {
+					t.Errorf("status.Code(%v) = %v; want %v", err, got, caller.want)
+				}
+			})
+		}
+	}
+	if _, err := svc.apiKeyRepo.Authenticate(ctx, testresources.Bar_Kiosk_ApiKeySecret); err != nil {
+		t.Errorf("svc.apiKeyRepo.Authenticate(secret) err = %v; want nil", err)
+	}
+	// Alice is an administrator of Bar.
+	if _, err := svc.UpdateDevice(alice, updateDevice); err != nil {
+		t.Errorf("UpdateDevice as administrator: err = %v; want nil", err)
+	}
+	if _, err := svc.ListApiKeys(alice, &pb.ListApiKeysRequest{Parent: testresources.Bar_Kiosk.Name}); err != nil {
+		t.Errorf("ListApiKeys as administrator: err = %v; want nil", err)
+	}
+	if _, err := svc.RotateApiKey(alice, &pb.RotateApiKeyRequest{Name: testresources.Bar_Kiosk_ApiKey.Name}); err != nil {
+		t.Errorf("RotateApiKey as administrator: err = %v; want nil", err)
+	}
+	if _, err := svc.DeleteDevice(alice, &pb.DeleteDeviceRequest{Name: testresources.Bar_Kiosk.Name}); err != nil {
+		t.Errorf("DeleteDevice as administrator: err = %v; want nil", err)
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"bufio"
+	"errors"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/export"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize is the maximum size of each chunk sent by ExportStore.
+const exportChunkSize = 64 * 1024
+
+// chunkSender is an io.Writer that sends everything written to it as a chunk
+// on an ExportStore stream.
+type chunkSender struct {
+	stream pb.StreckU_ExportStoreServer
+}
+
+func (c chunkSender) Write(p []byte) (int, error) {
+	if err := c.stream.Send(&pb.ExportStoreResponse{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *Service) ExportStore(req *pb.ExportStoreRequest, stream pb.StreckU_ExportStoreServer) error {
+	ctx := stream.Context()
+	if _, err := s.GetStore(ctx, &pb.GetStoreRequest{Name: req.Name}); err != nil {
+		return err
+	}
+	var start, end time.Time
+	if req.StartTime != nil {
+		if err := req.StartTime.CheckValid(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid start time: %v", err)
+		}
+		start = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		if err := req.EndTime.CheckValid(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid end time: %v", err)
+		}
+		end = req.EndTime.AsTime()
+	} else {
+		end = time.Now()
+	}
+	if err := export.ValidateColumns(req.Columns); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid columns: %v", err)
+	}
+	exporter := export.NewExporter(s.userRepo, s.productRepo, s.purchaseRepo, s.paymentRepo)
+	rows, err := exporter.Rows(ctx, req.Name, start, end)
+	if err != nil {
+		if errors.Is(err, export.ErrEndBeforeStart) {
+			return status.Errorf(codes.InvalidArgument, "invalid period: %v", err)
+		}
+		return internalError(ctx, err)
+	}
+	w := bufio.NewWriterSize(chunkSender{stream: stream}, exportChunkSize)
+	if err := export.WriteCSV(w, rows, req.Columns); err != nil {
+		return internalError(ctx, err)
+	}
+	if err := w.Flush(); err != nil {
+		return internalError(ctx, err)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestService_ExportStore(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	for _, test := range []struct {
+		desc     string
+		req      *pb.ExportStoreRequest
+		wantData string
+		wantCode codes.Code
+	}{
+		{
+			desc: "OK",
+			req: &pb.ExportStoreRequest{
+				Name:    testresources.Bar.Name,
+				Columns: []string{"type", "name", "user_email_address", "amount_cents"},
+			},
+			wantData: strings.Join([]string{
+				"type,name,user_email_address,amount_cents",
+				"purchase," + testresources.Bar_Alice_Beer1.Name + ",alice@example.com,-5000",
+				"payment," + testresources.Bar_Alice_Payment.Name + ",alice@example.com,10000",
+				"",
+			}, "\r\n"),
+			wantCode: codes.OK,
+		},
+		{
+			desc: "InvalidName",
+			req: &pb.ExportStoreRequest{
+				Name: testresources.Alice.Name, // name of a user
+			},
+			wantData: "",
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "NotFound",
+			req: &pb.ExportStoreRequest{
+				Name: testresources.Pharmacy.Name,
+			},
+			wantData: "",
+			wantCode: codes.NotFound,
+		},
+		{
+			desc: "UnknownColumn",
+			req: &pb.ExportStoreRequest{
+				Name:    testresources.Bar.Name,
+				Columns: []string{"type", "invalid"},
+			},
+			wantData: "",
+			wantCode: codes.InvalidArgument,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			stream, err := c.ExportStore(ctx, test.req)
+			if err != nil {
+				t.Fatalf("c.ExportStore(%v, %v) err = %v; want nil", ctx, test.req, err)
+			}
+			var data strings.Builder
+			for {
+				var resp *pb.ExportStoreResponse
+				resp, err = stream.Recv()
+				if err != nil {
+					break
+				}
+				data.Write(resp.Data)
+			}
+			if err == io.EOF {
+				err = nil
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+			if diff := cmp.Diff(data.String(), test.wantData); diff != "" {
+				t.Errorf("data != test.wantData (-got +want)\n%s", diff)
+			}
+		})
+	}
+}
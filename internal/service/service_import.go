@@ -0,0 +1,51 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/bulkimport"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) ImportStore(ctx context.Context, req *pb.ImportStoreRequest) (*pb.ImportStoreResponse, error) {
+	if _, err := s.GetStore(ctx, &pb.GetStoreRequest{Name: req.Name}); err != nil {
+		return nil, err
+	}
+	var productsCSV, membershipsCSV io.Reader
+	if len(req.ProductsCsv) > 0 {
+		productsCSV = bytes.NewReader(req.ProductsCsv)
+	}
+	if len(req.MembershipsCsv) > 0 {
+		membershipsCSV = bytes.NewReader(req.MembershipsCsv)
+	}
+	importer := bulkimport.NewImporter(s.userRepo, s.membershipRepo, s.productRepo)
+	plan, err := importer.Plan(ctx, req.Name, productsCSV, membershipsCSV)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	resp := &pb.ImportStoreResponse{
+		Products:    plan.Products,
+		Memberships: plan.Memberships,
+	}
+	if req.ValidateOnly {
+		for _, rowErr := range plan.Errors {
+			resp.RowErrors = append(resp.RowErrors, &pb.ImportStoreResponse_RowError{
+				File:    rowErr.File,
+				Record:  int32(rowErr.Record),
+				Message: rowErr.Err.Error(),
+			})
+		}
+		return resp, nil
+	}
+	if len(plan.Errors) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%d rows could not be imported, first error: %v", len(plan.Errors), plan.Errors[0])
+	}
+	if err := importer.Apply(ctx, plan); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestService_ImportStore(t *testing.T) {
+	ctx := context.Background()
+	validProducts := []byte("display_name,full_price,discount_price\nCider,30,25\n")
+	validMemberships := []byte("email_address,discount\nbob@example.com,true\n")
+	invalidProducts := []byte("display_name,full_price\nCider,thirty\n")
+	wantProducts := []*pb.Product{
+		{DisplayName: "Cider", FullPriceCents: -3000, DiscountPriceCents: -2500},
+	}
+	for _, test := range []struct {
+		desc     string
+		req      *pb.ImportStoreRequest
+		wantResp *pb.ImportStoreResponse
+		wantCode codes.Code
+	}{
+		{
+			desc: "OK",
+			req: &pb.ImportStoreRequest{
+				Name:           testresources.Mall.Name,
+				ProductsCsv:    validProducts,
+				MembershipsCsv: validMemberships,
+			},
+			wantResp: &pb.ImportStoreResponse{
+				Products: wantProducts,
+				Memberships: []*pb.Membership{
+					{User: testresources.Bob.Name, Discount: true},
+				},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "ValidateOnly",
+			req: &pb.ImportStoreRequest{
+				Name:           testresources.Mall.Name,
+				ProductsCsv:    invalidProducts,
+				MembershipsCsv: validMemberships,
+				ValidateOnly:   true,
+			},
+			wantResp: &pb.ImportStoreResponse{
+				Memberships: []*pb.Membership{
+					{User: testresources.Bob.Name, Discount: true},
+				},
+				RowErrors: []*pb.ImportStoreResponse_RowError{
+					{
+						File:    "products",
+						Record:  2,
+						Message: "full price: price is not a non-negative decimal number with at most two fractional digits",
+					},
+				},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "RowErrors",
+			req: &pb.ImportStoreRequest{
+				Name:           testresources.Mall.Name,
+				ProductsCsv:    invalidProducts,
+				MembershipsCsv: validMemberships,
+			},
+			wantResp: nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "InvalidName",
+			req: &pb.ImportStoreRequest{
+				Name:        testresources.Alice.Name, // name of a user
+				ProductsCsv: validProducts,
+			},
+			wantResp: nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "NotFound",
+			req: &pb.ImportStoreRequest{
+				Name:        testresources.Pharmacy.Name,
+				ProductsCsv: validProducts,
+			},
+			wantResp: nil,
+			wantCode: codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			s := seed(ctx, t)
+			c := serveAndDial(ctx, t, s)
+			resp, err := c.ImportStore(ctx, test.req)
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+			if diff := cmp.Diff(
+				resp, test.wantResp, protocmp.Transform(),
+				protocmp.IgnoreFields(&pb.Product{}, "name"),
+				protocmp.IgnoreFields(&pb.Membership{}, "name", "payment_reference"),
+			); diff != "" {
+				t.Errorf("c.ImportStore(%v, %v) resp != test.wantResp (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if test.wantCode != codes.OK || test.req.ValidateOnly {
+				// Nothing should have been created.
+				products, err := s.productRepo.ListProducts(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(products, []*pb.Product{testresources.Beer, testresources.Jeans}, protocmp.Transform(), cmpopts.SortSlices(func(a, b *pb.Product) bool { return a.Name < b.Name })); diff != "" {
+					t.Errorf("products != want (-got +want)\n%s", diff)
+				}
+				return
+			}
+			for _, product := range resp.Products {
+				if _, err := c.GetProduct(ctx, &pb.GetProductRequest{Name: product.Name}); err != nil {
+					t.Errorf("c.GetProduct(ctx, %q) err = %v; want nil", product.Name, err)
+				}
+			}
+			for _, membership := range resp.Memberships {
+				if _, err := c.GetMembership(ctx, &pb.GetMembershipRequest{Name: membership.Name}); err != nil {
+					t.Errorf("c.GetMembership(ctx, %q) err = %v; want nil", membership.Name, err)
+				}
+			}
+		})
+	}
+}
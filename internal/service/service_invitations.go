@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/logging"
+	"github.com/Saser/strecku/internal/mail"
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/resources/stores"
+	"github.com/Saser/strecku/resources/stores/invitations"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) GetInvitation(ctx context.Context, req *pb.GetInvitationRequest) (*pb.Invitation, error) {
+	name := req.Name
+	if err := invitations.ValidateName(name); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	invitation, err := s.invitationRepo.LookupInvitation(ctx, name)
+	if err != nil {
+		if notFound := new(invitations.NotFoundError); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	invitation.State = invitations.State(invitation, time.Now())
+	return invitation, nil
+}
+
+func (s *Service) ListInvitations(ctx context.Context, req *pb.ListInvitationsRequest) (*pb.ListInvitationsResponse, error) {
+	if err := stores.ValidateName(req.Parent); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if req.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative page size: %d", req.PageSize)
+	}
+	if req.PageSize > 0 || req.PageToken != "" {
+		return nil, status.Error(codes.Unimplemented, "pagination is not implemented")
+	}
+	if err := s.checkStoreAdministrator(ctx, req.Parent); err != nil {
+		return nil, err
+	}
+	filtered, err := s.invitationsIn(ctx, req.Parent)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	now := time.Now()
+	for _, invitation := range filtered {
+		invitation.State = invitations.State(invitation, now)
+	}
+	return &pb.ListInvitationsResponse{
+		Invitations:   filtered,
+		NextPageToken: "",
+	}, nil
+}
+
+// invitationsIn returns the invitations to the given store.
+func (s *Service) invitationsIn(ctx context.Context, store string) ([]*pb.Invitation, error) {
+	return s.invitationRepo.FilterInvitations(ctx, func(invitation *pb.Invitation) bool {
+		parent, err := invitations.Parent(invitation.Name)
+		if err != nil {
+			return false
+		}
+		return parent == store
+	})
+}
+
+func (s *Service) CreateInvitation(ctx context.Context, req *pb.CreateInvitationRequest) (*pb.Invitation, error) {
+	if err := stores.ValidateName(req.Parent); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	store, err := s.GetStore(ctx, &pb.GetStoreRequest{Name: req.Parent})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkStoreAdministrator(ctx, req.Parent); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	invitation := req.Invitation
+	invitation.Name = invitations.GenerateName(req.Parent)
+	invitation.CreateTime = timestamppb.New(now)
+	if invitation.ExpireTime == nil {
+		invitation.ExpireTime = timestamppb.New(now.Add(invitations.DefaultTTL))
+	} else if !invitation.ExpireTime.AsTime().After(now) {
+		return nil, status.Error(codes.InvalidArgument, "invalid invitation: expire time has already passed")
+	}
+	invitation.State = pb.Invitation_PENDING
+	invitation.Membership = ""
+	if err := invitations.Validate(invitation); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation: %v", err)
+	}
+
+	// There can only be one pending invitation for each email address, and
+	// members cannot be invited again.
+	existing, err := s.invitationsIn(ctx, req.Parent)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	for _, other := range existing {
+		if strings.EqualFold(other.EmailAddress, invitation.EmailAddress) && invitations.State(other, now) == pb.Invitation_PENDING {
+			return nil, status.Errorf(codes.AlreadyExists, "%q is already invited by %q", invitation.EmailAddress, other.Name)
+		}
+	}
+	if user, err := s.userRepo.ResolveEmail(ctx, invitation.EmailAddress); err == nil {
+		_, err := s.membershipRepo.LookupMembershipIn(ctx, req.Parent, user)
+		if err == nil {
+			return nil, status.Errorf(codes.AlreadyExists, "%q is already a member of %q", invitation.EmailAddress, req.Parent)
+		}
+		if notFound := new(memberships.NotFoundError); !errors.As(err, &notFound) {
+			return nil, internalError(ctx, err)
+		}
+	} else if notFound := new(repositories.EmailAddressNotFound); !errors.As(err, &notFound) {
+		return nil, internalError(ctx, err)
+	}
+
+	if err := s.invitationRepo.CreateInvitation(ctx, invitation); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	s.sendInvitation(ctx, store, invitation)
+	return invitation, nil
+}
+
+// sendInvitation emails invitation to the invited email address. Errors are
+// only logged, as the invitation can be found by the invitee by other means,
+// such as by the administrator sharing its name.
+func (s *Service) sendInvitation(ctx context.Context, store *pb.Store, invitation *pb.Invitation) {
+	err := s.mailer.Send(ctx, mail.Message{
+		To:      invitation.EmailAddress,
+		Subject: fmt.Sprintf("You are invited to %s on StreckU", store.DisplayName),
+		Body: fmt.Sprintf("Hi,\n\n"+
+			"You have been invited to become a member of %s on StreckU.\n\n"+
+			"If you do not have a StreckU account, register one using this email address first. "+
+			"Then, accept or decline the invitation using its name:\n\n%s\n\n"+
+			"The invitation expires at %s.\n",
+			store.DisplayName, invitation.Name, invitation.ExpireTime.AsTime().Format(time.RFC1123)),
+	})
+	if err != nil {
+		logging.FromContext(ctx).With("invitation", invitation.Name).Warnf("send invitation: %v", err)
+	}
+}
+
+func (s *Service) DeleteInvitation(ctx context.Context, req *pb.DeleteInvitationRequest) (*emptypb.Empty, error) {
+	if err := invitations.ValidateName(req.Name); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	parent, err := invitations.Parent(req.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	if err := s.checkStoreAdministrator(ctx, parent); err != nil {
+		return nil, err
+	}
+	if err := s.invitationRepo.DeleteInvitation(ctx, req.Name); err != nil {
+		if notFound := new(invitations.NotFoundError); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	return new(emptypb.Empty), nil
+}
+
+// pendingInvitation gets the invitation with the given name, and the resource
+// name of the invited user. It fails if the invitation is not pending, if no
+// user has the invited email address, or if the caller is authenticated as
+// another user.
+func (s *Service) pendingInvitation(ctx context.Context, name string) (*pb.Invitation, string, error) {
+	invitation, err := s.GetInvitation(ctx, &pb.GetInvitationRequest{Name: name})
+	if err != nil {
+		return nil, "", err
+	}
+	if invitation.State != pb.Invitation_PENDING {
+		return nil, "", status.Errorf(codes.FailedPrecondition, "invitation %q is %s", name, strings.ToLower(invitation.State.String()))
+	}
+	user, err := s.userRepo.ResolveEmail(ctx, invitation.EmailAddress)
+	if err != nil {
+		if notFound := new(repositories.EmailAddressNotFound); errors.As(err, &notFound) {
+			return nil, "", status.Errorf(codes.FailedPrecondition, "no user has the invited email address %q; register first", invitation.EmailAddress)
+		}
+		return nil, "", internalError(ctx, err)
+	}
+	if caller, ok := callerUser(ctx); ok && caller != user {
+		return nil, "", status.Errorf(codes.PermissionDenied, "invitation %q is for another user", name)
+	}
+	return invitation, user, nil
+}
+
+func (s *Service) AcceptInvitation(ctx context.Context, req *pb.AcceptInvitationRequest) (*pb.Membership, error) {
+	invitation, user, err := s.pendingInvitation(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	parent, err := invitations.Parent(invitation.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	membership, err := s.CreateMembership(ctx, &pb.CreateMembershipRequest{
+		Parent: parent,
+		Membership: &pb.Membership{
+			User:          user,
+			Administrator: invitation.Administrator,
+			Discount:      invitation.Discount,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	invitation.State = pb.Invitation_ACCEPTED
+	invitation.Membership = membership.Name
+	if err := s.invitationRepo.UpdateInvitation(ctx, invitation); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return membership, nil
+}
+
+func (s *Service) DeclineInvitation(ctx context.Context, req *pb.DeclineInvitationRequest) (*pb.Invitation, error) {
+	invitation, _, err := s.pendingInvitation(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	invitation.State = pb.Invitation_DECLINED
+	if err := s.invitationRepo.UpdateInvitation(ctx, invitation); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return invitation, nil
+}
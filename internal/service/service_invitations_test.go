@@ -0,0 +1,536 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/authn"
+	"github.com/Saser/strecku/internal/mail"
+	"github.com/Saser/strecku/resources/stores/invitations"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"github.com/Saser/strecku/resources/users"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func invitationLess(i1, i2 *pb.Invitation) bool {
+	return i1.Name < i2.Name
+}
+
+// expired returns a copy of invitation that is expired.
+func expired(invitation *pb.Invitation) *pb.Invitation {
+	invitation = invitations.Clone(invitation)
+	invitation.State = pb.Invitation_EXPIRED
+	return invitation
+}
+
+func TestService_GetInvitation(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	for _, test := range []struct {
+		desc           string
+		req            *pb.GetInvitationRequest
+		wantInvitation *pb.Invitation
+		wantCode       codes.Code
+	}{
+		{
+			desc:           "OK",
+			req:            &pb.GetInvitationRequest{Name: testresources.Bar_Carol_Invitation.Name},
+			wantInvitation: testresources.Bar_Carol_Invitation,
+			wantCode:       codes.OK,
+		},
+		{
+			desc:           "OK_Expired",
+			req:            &pb.GetInvitationRequest{Name: testresources.Bar_Expired_Invitation.Name},
+			wantInvitation: expired(testresources.Bar_Expired_Invitation),
+			wantCode:       codes.OK,
+		},
+		{
+			desc:           "InvalidName",
+			req:            &pb.GetInvitationRequest{Name: testresources.Bar.Name}, // name of a store
+			wantInvitation: nil,
+			wantCode:       codes.InvalidArgument,
+		},
+		{
+			desc:           "NotFound",
+			req:            &pb.GetInvitationRequest{Name: testresources.Bar.Name + "/invitations/00000000-0000-0000-0000-000000000000"},
+			wantInvitation: nil,
+			wantCode:       codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			invitation, err := c.GetInvitation(ctx, test.req)
+			if diff := cmp.Diff(invitation, test.wantInvitation, protocmp.Transform()); diff != "" {
+				t.Errorf("c.GetInvitation(%v, %v) invitation != test.wantInvitation (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_ListInvitations(t *testing.T) {
+	ctx := asOperator(context.Background())
+	svc := seed(ctx, t)
+	for _, test := range []struct {
+		desc     string
+		req      *pb.ListInvitationsRequest
+		wantResp *pb.ListInvitationsResponse
+		wantCode codes.Code
+	}{
+		{
+			desc: "OK",
+			req:  &pb.ListInvitationsRequest{Parent: testresources.Bar.Name},
+			wantResp: &pb.ListInvitationsResponse{
+				Invitations: []*pb.Invitation{
+					testresources.Bar_Carol_Invitation,
+					expired(testresources.Bar_Expired_Invitation),
+				},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "InvalidParent",
+			req:      &pb.ListInvitationsRequest{Parent: testresources.Alice.Name},
+			wantResp: nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "Pagination",
+			req:      &pb.ListInvitationsRequest{Parent: testresources.Bar.Name, PageSize: 1},
+			wantResp: nil,
+			wantCode: codes.Unimplemented,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			resp, err := svc.ListInvitations(ctx, test.req)
+			if diff := cmp.Diff(
+				resp, test.wantResp, protocmp.Transform(),
+				protocmp.SortRepeated(invitationLess),
+			); diff != "" {
+				t.Errorf("svc.ListInvitations(%v, %v) resp != test.wantResp (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_CreateInvitation(t *testing.T) {
+	ctx := asOperator(context.Background())
+	const emailAddress = "dave@example.com"
+	expireTime := timestamppb.New(time.Now().Add(time.Hour).Truncate(time.Second))
+	for _, test := range []struct {
+		desc           string
+		req            *pb.CreateInvitationRequest
+		wantInvitation *pb.Invitation // name and create time are ignored
+		wantCode       codes.Code
+	}{
+		{
+			desc: "OK",
+			req: &pb.CreateInvitationRequest{
+				Parent:     testresources.Bar.Name,
+				Invitation: &pb.Invitation{EmailAddress: emailAddress, Administrator: true},
+			},
+			wantInvitation: &pb.Invitation{
+				EmailAddress:  emailAddress,
+				Administrator: true,
+				State:         pb.Invitation_PENDING,
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "OK_ExpireTime",
+			req: &pb.CreateInvitationRequest{
+				Parent:     testresources.Bar.Name,
+				Invitation: &pb.Invitation{EmailAddress: emailAddress, ExpireTime: expireTime},
+			},
+			wantInvitation: &pb.Invitation{
+				EmailAddress: emailAddress,
+				ExpireTime:   expireTime,
+				State:        pb.Invitation_PENDING,
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "OK_ExpiredInvitation",
+			req: &pb.CreateInvitationRequest{
+				Parent:     testresources.Bar.Name,
+				Invitation: &pb.Invitation{EmailAddress: testresources.Bar_Expired_Invitation.EmailAddress},
+			},
+			wantInvitation: &pb.Invitation{
+				EmailAddress: testresources.Bar_Expired_Invitation.EmailAddress,
+				State:        pb.Invitation_PENDING,
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "OK_StateAndMembershipIgnored",
+			req: &pb.CreateInvitationRequest{
+				Parent: testresources.Bar.Name,
+				Invitation: &pb.Invitation{
+					EmailAddress: emailAddress,
+					State:        pb.Invitation_ACCEPTED,
+					Membership:   testresources.Bar_Alice.Name,
+				},
+			},
+			wantInvitation: &pb.Invitation{
+				EmailAddress: emailAddress,
+				State:        pb.Invitation_PENDING,
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "EmptyEmailAddress",
+			req: &pb.CreateInvitationRequest{
+				Parent:     testresources.Bar.Name,
+				Invitation: &pb.Invitation{},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "ExpireTimePassed",
+			req: &pb.CreateInvitationRequest{
+				Parent:     testresources.Bar.Name,
+				Invitation: &pb.Invitation{EmailAddress: emailAddress, ExpireTime: timestamppb.New(time.Now().Add(-time.Hour))},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "InvalidParent",
+			req: &pb.CreateInvitationRequest{
+				Parent:     testresources.Alice.Name,
+				Invitation: &pb.Invitation{EmailAddress: emailAddress},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "StoreNotFound",
+			req: &pb.CreateInvitationRequest{
+				Parent:     "stores/00000000-0000-0000-0000-000000000000",
+				Invitation: &pb.Invitation{EmailAddress: emailAddress},
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			desc: "AlreadyInvited",
+			req: &pb.CreateInvitationRequest{
+				Parent:     testresources.Bar.Name,
+				Invitation: &pb.Invitation{EmailAddress: strings.ToUpper(testresources.Carol.EmailAddress)},
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			desc: "AlreadyMember",
+			req: &pb.CreateInvitationRequest{
+				Parent:     testresources.Bar.Name,
+				Invitation: &pb.Invitation{EmailAddress: testresources.Alice.EmailAddress},
+			},
+			wantCode: codes.AlreadyExists,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			svc := seed(ctx, t)
+			invitation, err := svc.CreateInvitation(ctx, test.req)
+			if got := status.Code(err); got != test.wantCode {
+				t.Fatalf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+			if test.wantCode != codes.OK {
+				if got := messageCount(svc); got != 0 {
+					t.Errorf("%d messages sent; want 0", got)
+				}
+				return
+			}
+			if err := invitations.Validate(invitation); err != nil {
+				t.Errorf("invitations.Validate(%v) = %v; want nil", invitation, err)
+			}
+			want := invitations.Clone(test.wantInvitation)
+			if want.ExpireTime == nil {
+				want.ExpireTime = timestamppb.New(invitation.CreateTime.AsTime().Add(invitations.DefaultTTL))
+			}
+			if diff := cmp.Diff(
+				invitation, want, protocmp.Transform(),
+				protocmp.IgnoreFields(new(pb.Invitation), "name", "create_time"),
+			); diff != "" {
+				t.Errorf("svc.CreateInvitation(%v, %v) invitation != want (-got +want)\n%s", ctx, test.req, diff)
+			}
+			m, ok := svc.mailer.(*mail.Memory).Last(invitation.EmailAddress)
+			if !ok {
+				t.Fatalf("no message sent to %q", invitation.EmailAddress)
+			}
+			if !strings.Contains(m.Body, invitation.Name) {
+				t.Errorf("message body %q does not contain %q", m.Body, invitation.Name)
+			}
+		})
+	}
+}
+
+func TestService_DeleteInvitation(t *testing.T) {
+	ctx := asOperator(context.Background())
+	svc := seed(ctx, t)
+	name := testresources.Bar_Carol_Invitation.Name
+	if _, err := svc.DeleteInvitation(ctx, &pb.DeleteInvitationRequest{Name: name}); err != nil {
+		t.Fatalf("svc.DeleteInvitation(%q) err = %v; want nil", name, err)
+	}
+	for _, req := range []*pb.DeleteInvitationRequest{
+		{Name: name},
+		{Name: testresources.Bar.Name + "/invitations/00000000-0000-0000-0000-000000000000"},
+	} {
+		_, err := svc.DeleteInvitation(ctx, req)
+		if got, want := status.Code(err), codes.NotFound; got != want {
+			t.Errorf("svc.DeleteInvitation(%v): status.Code(%v) = %v; want %v", req, err, got, want)
+		}
+	}
+	_, err := svc.DeleteInvitation(ctx, &pb.DeleteInvitationRequest{Name: testresources.Bar.Name})
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("svc.DeleteInvitation(store): status.Code(%v) = %v; want %v", err, got, want)
+	}
+}
+
+func TestService_Invitations_Administrator(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	makeAdministrator(ctx, t, svc, testresources.Bar_Alice)
+	alice := asUser(ctx, testresources.Alice.Name)
+	bob := asUser(ctx, testresources.Bob.Name)
+	newInvitation := func(parent string) *pb.CreateInvitationRequest {
+		return &pb.CreateInvitationRequest{
+			Parent:     parent,
+			Invitation: &pb.Invitation{EmailAddress: "dave@example.com", Administrator: true},
+		}
+	}
+	// Bob is a member of Bar, but not an administrator, and not a member of
+	// Mall. Neither anonymous callers nor devices may manage invitations.
+	for _, test := range []struct {
+		desc string
+		call func(context.Context) error
+	}{
+		{
+			desc: "CreateInvitation_NotAdministrator",
+			call: func(ctx context.Context) error {
+				_, err := svc.CreateInvitation(ctx, newInvitation(testresources.Bar.Name))
+				return err
+			},
+		},
+		{
+			desc: "CreateInvitation_NotMember",
+			call: func(ctx context.Context) error {
+				_, err := svc.CreateInvitation(ctx, newInvitation(testresources.Mall.Name))
+				return err
+			},
+		},
+		{
+			desc: "ListInvitations",
+			call: func(ctx context.Context) error {
+				_, err := svc.ListInvitations(ctx, &pb.ListInvitationsRequest{Parent: testresources.Bar.Name})
+				return err
+			},
+		},
+		{
+			desc: "DeleteInvitation",
+			call: func(ctx context.Context) error {
+				_, err := svc.DeleteInvitation(ctx, &pb.DeleteInvitationRequest{Name: testresources.Bar_Carol_Invitation.Name})
+				return err
+			},
+		},
+	} {
+		for _, caller := range []struct {
+			desc string
+			ctx  context.Context
+			want codes.Code
+		}{
+			{desc: "Bob", ctx: bob, want: codes.PermissionDenied},
+			{desc: "Anonymous", ctx: ctx, want: codes.Unauthenticated},
+			{desc: "Device", ctx: asDevice(ctx, testresources.Bar_Kiosk), want: codes.PermissionDenied},
+		} {
+			t.Run(test.desc+"_"+caller.desc, func(t *testing.T) {
+				err := test.call(caller.ctx)
+				if got := status.Code(err); got != caller.want {
+					t.Errorf("status.Code(%v) = %v; want %v", err, got, caller.want)
+				}
+			})
+		}
+	}
+	if got := messageCount(svc); got != 0 {
+		t.Errorf("%d messages sent; want 0", got)
+	}
+	// Alice is an administrator of Bar.
+	if _, err := svc.ListInvitations(alice, &pb.ListInvitationsRequest{Parent: testresources.Bar.Name}); err != nil {
+		t.Errorf("ListInvitations as administrator: err = %v; want nil", err)
+	}
+	if _, err := svc.CreateInvitation(alice, newInvitation(testresources.Bar.Name)); err != nil {
+		t.Errorf("CreateInvitation as administrator: err = %v; want nil", err)
+	}
+	if _, err := svc.DeleteInvitation(alice, &pb.DeleteInvitationRequest{Name: testresources.Bar_Carol_Invitation.Name}); err != nil {
+		t.Errorf("DeleteInvitation as administrator: err = %v; want nil", err)
+	}
+}
+
+func TestService_AcceptInvitation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("OK", func(t *testing.T) {
+		svc := seed(ctx, t)
+		c := serveAndDial(ctx, t, svc)
+		req := &pb.AcceptInvitationRequest{Name: testresources.Mall_Bob_Invitation.Name}
+		membership, err := c.AcceptInvitation(ctx, req)
+		if err != nil {
+			t.Fatalf("c.AcceptInvitation(%v) err = %v; want nil", req, err)
+		}
+		want := &pb.Membership{
+			User:          testresources.Bob.Name,
+			Administrator: testresources.Mall_Bob_Invitation.Administrator,
+			Discount:      testresources.Mall_Bob_Invitation.Discount,
+		}
+		if diff := cmp.Diff(
+			membership, want, protocmp.Transform(),
+			protocmp.IgnoreFields(new(pb.Membership), "name", "payment_reference"),
+		); diff != "" {
+			t.Errorf("c.AcceptInvitation(%v) membership != want (-got +want)\n%s", req, diff)
+		}
+		if parent, _ := memberships.Parent(membership.Name); parent != testresources.Mall.Name {
+			t.Errorf("membership %q is not in %q", membership.Name, testresources.Mall.Name)
+		}
+		if _, err := c.GetMembership(ctx, &pb.GetMembershipRequest{Name: membership.Name}); err != nil {
+			t.Errorf("c.GetMembership(%q) err = %v; want nil", membership.Name, err)
+		}
+		invitation, err := c.GetInvitation(ctx, &pb.GetInvitationRequest{Name: req.Name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if invitation.State != pb.Invitation_ACCEPTED || invitation.Membership != membership.Name {
+			t.Errorf("invitation = %v; want accepted with membership %q", invitation, membership.Name)
+		}
+		_, err = c.AcceptInvitation(ctx, req)
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("second c.AcceptInvitation(%v): status.Code(%v) = %v; want %v", req, err, got, want)
+		}
+	})
+
+	t.Run("OK_AfterRegistering", func(t *testing.T) {
+		c := serveAndDial(ctx, t, seed(ctx, t))
+		req := &pb.AcceptInvitationRequest{Name: testresources.Bar_Carol_Invitation.Name}
+		_, err := c.AcceptInvitation(ctx, req)
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("c.AcceptInvitation(%v) before registering: status.Code(%v) = %v; want %v", req, err, got, want)
+		}
+		carol, err := c.CreateUser(ctx, &pb.CreateUserRequest{
+			User:     users.Clone(testresources.Carol),
+			Password: testresources.CarolPassword,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		membership, err := c.AcceptInvitation(ctx, req)
+		if err != nil {
+			t.Fatalf("c.AcceptInvitation(%v) err = %v; want nil", req, err)
+		}
+		if membership.User != carol.Name || !membership.Discount {
+			t.Errorf("c.AcceptInvitation(%v) = %v; want discount membership of %q", req, membership, carol.Name)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, test := range []struct {
+			desc     string
+			ctx      context.Context
+			name     string
+			wantCode codes.Code
+		}{
+			{
+				desc:     "InvalidName",
+				ctx:      ctx,
+				name:     testresources.Bar.Name,
+				wantCode: codes.InvalidArgument,
+			},
+			{
+				desc:     "NotFound",
+				ctx:      ctx,
+				name:     testresources.Bar.Name + "/invitations/00000000-0000-0000-0000-000000000000",
+				wantCode: codes.NotFound,
+			},
+			{
+				desc:     "Expired",
+				ctx:      ctx,
+				name:     testresources.Bar_Expired_Invitation.Name,
+				wantCode: codes.FailedPrecondition,
+			},
+			{
+				desc:     "OtherUser",
+				ctx:      authn.NewContext(ctx, authn.Identity{Name: testresources.Alice.Name, User: testresources.Alice.Name}),
+				name:     testresources.Mall_Bob_Invitation.Name,
+				wantCode: codes.PermissionDenied,
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				svc := seed(ctx, t)
+				req := &pb.AcceptInvitationRequest{Name: test.name}
+				_, err := svc.AcceptInvitation(test.ctx, req)
+				if got := status.Code(err); got != test.wantCode {
+					t.Errorf("svc.AcceptInvitation(%v): status.Code(%v) = %v; want %v", req, err, got, test.wantCode)
+				}
+			})
+		}
+	})
+
+	// Bob became a member after being invited.
+	t.Run("AlreadyMember", func(t *testing.T) {
+		svc := seed(ctx, t)
+		if err := svc.membershipRepo.CreateMembership(ctx, testresources.Mall_Bob); err != nil {
+			t.Fatal(err)
+		}
+		req := &pb.AcceptInvitationRequest{Name: testresources.Mall_Bob_Invitation.Name}
+		_, err := svc.AcceptInvitation(ctx, req)
+		if got, want := status.Code(err), codes.AlreadyExists; got != want {
+			t.Errorf("svc.AcceptInvitation(%v): status.Code(%v) = %v; want %v", req, err, got, want)
+		}
+	})
+}
+
+func TestService_DeclineInvitation(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	c := serveAndDial(ctx, t, svc)
+	req := &pb.DeclineInvitationRequest{Name: testresources.Mall_Bob_Invitation.Name}
+	invitation, err := c.DeclineInvitation(ctx, req)
+	if err != nil {
+		t.Fatalf("c.DeclineInvitation(%v) err = %v; want nil", req, err)
+	}
+	want := invitations.Clone(testresources.Mall_Bob_Invitation)
+	want.State = pb.Invitation_DECLINED
+	if diff := cmp.Diff(invitation, want, protocmp.Transform()); diff != "" {
+		t.Errorf("c.DeclineInvitation(%v) invitation != want (-got +want)\n%s", req, diff)
+	}
+	for _, name := range []string{
+		testresources.Mall_Bob_Invitation.Name,
+		testresources.Bar_Expired_Invitation.Name,
+	} {
+		_, err := c.AcceptInvitation(ctx, &pb.AcceptInvitationRequest{Name: name})
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("c.AcceptInvitation(%q): status.Code(%v) = %v; want %v", name, err, got, want)
+		}
+		_, err = c.DeclineInvitation(ctx, &pb.DeclineInvitationRequest{Name: name})
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("c.DeclineInvitation(%q): status.Code(%v) = %v; want %v", name, err, got, want)
+		}
+	}
+	mallMembers, err := svc.membershipRepo.FilterMemberships(ctx, func(membership *pb.Membership) bool {
+		return membership.User == testresources.Bob.Name
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(mallMembers, []*pb.Membership{testresources.Bar_Bob}, protocmp.Transform(), cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("memberships of Bob changed after declining (-got +want)\n%s", diff)
+	}
+}
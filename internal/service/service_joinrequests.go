@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/resources/stores"
+	"github.com/Saser/strecku/resources/stores/joinrequests"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) GetJoinRequest(ctx context.Context, req *pb.GetJoinRequestRequest) (*pb.JoinRequest, error) {
+	name := req.Name
+	if err := joinrequests.ValidateName(name); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	joinRequest, err := s.joinRequestRepo.LookupJoinRequest(ctx, name)
+	if err != nil {
+		if notFound := new(joinrequests.NotFoundError); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	return joinRequest, nil
+}
+
+func (s *Service) ListJoinRequests(ctx context.Context, req *pb.ListJoinRequestsRequest) (*pb.ListJoinRequestsResponse, error) {
+	if err := stores.ValidateName(req.Parent); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if req.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative page size: %d", req.PageSize)
+	}
+	if req.PageSize > 0 || req.PageToken != "" {
+		return nil, status.Error(codes.Unimplemented, "pagination is not implemented")
+	}
+	filtered, err := s.joinRequestsIn(ctx, req.Parent)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return &pb.ListJoinRequestsResponse{
+		JoinRequests:  filtered,
+		NextPageToken: "",
+	}, nil
+}
+
+// joinRequestsIn returns the join requests to the given store.
+func (s *Service) joinRequestsIn(ctx context.Context, store string) ([]*pb.JoinRequest, error) {
+	return s.joinRequestRepo.FilterJoinRequests(ctx, func(joinRequest *pb.JoinRequest) bool {
+		parent, err := joinrequests.Parent(joinRequest.Name)
+		if err != nil {
+			return false
+		}
+		return parent == store
+	})
+}
+
+func (s *Service) CreateJoinRequest(ctx context.Context, req *pb.CreateJoinRequestRequest) (*pb.JoinRequest, error) {
+	if err := stores.ValidateName(req.Parent); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	store, err := s.GetStore(ctx, &pb.GetStoreRequest{Name: req.Parent})
+	if err != nil {
+		return nil, err
+	}
+	joinRequest := req.JoinRequest
+	joinRequest.Name = joinrequests.GenerateName(req.Parent)
+	joinRequest.CreateTime = timestamppb.Now()
+	joinRequest.State = pb.JoinRequest_PENDING
+	joinRequest.Membership = ""
+	if err := joinrequests.Validate(joinRequest); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid join request: %v", err)
+	}
+	if caller, ok := callerUser(ctx); ok && caller != joinRequest.User {
+		return nil, status.Error(codes.PermissionDenied, "join requests can only be made for yourself")
+	}
+	if stores.JoinPolicy(store) == pb.Store_INVITE_ONLY {
+		return nil, status.Errorf(codes.FailedPrecondition, "%q can only be joined by invitation", store.Name)
+	}
+	if _, err := s.GetUser(ctx, &pb.GetUserRequest{Name: joinRequest.User}); err != nil {
+		return nil, err
+	}
+	member, err := s.isMember(ctx, store.Name, joinRequest.User)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	if member {
+		return nil, status.Errorf(codes.AlreadyExists, "%q is already a member of %q", joinRequest.User, store.Name)
+	}
+	existing, err := s.joinRequestsIn(ctx, store.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	for _, other := range existing {
+		if other.User == joinRequest.User && other.State == pb.JoinRequest_PENDING {
+			return nil, status.Errorf(codes.AlreadyExists, "%q has already requested to join by %q", joinRequest.User, other.Name)
+		}
+	}
+
+	if stores.JoinPolicy(store) == pb.Store_OPEN {
+		membership, err := s.createJoinedMembership(ctx, store, joinRequest)
+		if err != nil {
+			return nil, err
+		}
+		joinRequest.State = pb.JoinRequest_APPROVED
+		joinRequest.Membership = membership.Name
+	}
+	if err := s.joinRequestRepo.CreateJoinRequest(ctx, joinRequest); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return joinRequest, nil
+}
+
+// createJoinedMembership creates the membership for the user of joinRequest
+// in store, using the default discount of store.
+func (s *Service) createJoinedMembership(ctx context.Context, store *pb.Store, joinRequest *pb.JoinRequest) (*pb.Membership, error) {
+	return s.CreateMembership(ctx, &pb.CreateMembershipRequest{
+		Parent: store.Name,
+		Membership: &pb.Membership{
+			User:     joinRequest.User,
+			Discount: store.DefaultDiscount,
+		},
+	})
+}
+
+func (s *Service) DeleteJoinRequest(ctx context.Context, req *pb.DeleteJoinRequestRequest) (*emptypb.Empty, error) {
+	if err := joinrequests.ValidateName(req.Name); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if err := s.joinRequestRepo.DeleteJoinRequest(ctx, req.Name); err != nil {
+		if notFound := new(joinrequests.NotFoundError); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	return new(emptypb.Empty), nil
+}
+
+// pendingJoinRequest gets the join request with the given name, and fails if
+// it is not pending.
+func (s *Service) pendingJoinRequest(ctx context.Context, name string) (*pb.JoinRequest, error) {
+	joinRequest, err := s.GetJoinRequest(ctx, &pb.GetJoinRequestRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	if joinRequest.State != pb.JoinRequest_PENDING {
+		return nil, status.Errorf(codes.FailedPrecondition, "join request %q is %s", name, strings.ToLower(joinRequest.State.String()))
+	}
+	return joinRequest, nil
+}
+
+func (s *Service) ApproveJoinRequest(ctx context.Context, req *pb.ApproveJoinRequestRequest) (*pb.Membership, error) {
+	joinRequest, err := s.pendingJoinRequest(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	parent, err := joinrequests.Parent(joinRequest.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	if err := s.checkStoreAdministrator(ctx, parent); err != nil {
+		return nil, err
+	}
+	store, err := s.GetStore(ctx, &pb.GetStoreRequest{Name: parent})
+	if err != nil {
+		return nil, err
+	}
+	membership, err := s.createJoinedMembership(ctx, store, joinRequest)
+	if err != nil {
+		return nil, err
+	}
+	joinRequest.State = pb.JoinRequest_APPROVED
+	joinRequest.Membership = membership.Name
+	if err := s.joinRequestRepo.UpdateJoinRequest(ctx, joinRequest); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return membership, nil
+}
+
+func (s *Service) RejectJoinRequest(ctx context.Context, req *pb.RejectJoinRequestRequest) (*pb.JoinRequest, error) {
+	joinRequest, err := s.pendingJoinRequest(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	parent, err := joinrequests.Parent(joinRequest.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	if err := s.checkStoreAdministrator(ctx, parent); err != nil {
+		return nil, err
+	}
+	joinRequest.State = pb.JoinRequest_REJECTED
+	if err := s.joinRequestRepo.UpdateJoinRequest(ctx, joinRequest); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return joinRequest, nil
+}
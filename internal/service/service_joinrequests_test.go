@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/authn"
+	"github.com/Saser/strecku/resources/stores/joinrequests"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// seedCarol seeds svc with Carol, who is not a member of any store, and
+// Pharmacy, which is invite-only.
+func seedCarol(ctx context.Context, t *testing.T, svc *Service) {
+	t.Helper()
+	if err := svc.userRepo.Create(ctx, testresources.Carol, testresources.CarolPassword); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.storeRepo.Create(ctx, testresources.Pharmacy); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_GetJoinRequest(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	for _, test := range []struct {
+		desc            string
+		req             *pb.GetJoinRequestRequest
+		wantJoinRequest *pb.JoinRequest
+		wantCode        codes.Code
+	}{
+		{
+			desc:            "OK",
+			req:             &pb.GetJoinRequestRequest{Name: testresources.Mall_Bob_JoinRequest.Name},
+			wantJoinRequest: testresources.Mall_Bob_JoinRequest,
+			wantCode:        codes.OK,
+		},
+		{
+			desc:            "InvalidName",
+			req:             &pb.GetJoinRequestRequest{Name: testresources.Bar.Name}, // name of a store
+			wantJoinRequest: nil,
+			wantCode:        codes.InvalidArgument,
+		},
+		{
+			desc:            "NotFound",
+			req:             &pb.GetJoinRequestRequest{Name: testresources.Bar_Carol_JoinRequest.Name},
+			wantJoinRequest: nil,
+			wantCode:        codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			joinRequest, err := c.GetJoinRequest(ctx, test.req)
+			if diff := cmp.Diff(joinRequest, test.wantJoinRequest, protocmp.Transform()); diff != "" {
+				t.Errorf("c.GetJoinRequest(%v, %v) joinRequest != test.wantJoinRequest (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_ListJoinRequests(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	for _, test := range []struct {
+		desc     string
+		req      *pb.ListJoinRequestsRequest
+		wantResp *pb.ListJoinRequestsResponse
+		wantCode codes.Code
+	}{
+		{
+			desc: "OK",
+			req:  &pb.ListJoinRequestsRequest{Parent: testresources.Bar.Name},
+			wantResp: &pb.ListJoinRequestsResponse{
+				JoinRequests: []*pb.JoinRequest{testresources.Bar_Bob_JoinRequest},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "InvalidParent",
+			req:      &pb.ListJoinRequestsRequest{Parent: testresources.Alice.Name},
+			wantResp: nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "Pagination",
+			req:      &pb.ListJoinRequestsRequest{Parent: testresources.Bar.Name, PageToken: "token"},
+			wantResp: nil,
+			wantCode: codes.Unimplemented,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			resp, err := c.ListJoinRequests(ctx, test.req)
+			if diff := cmp.Diff(resp, test.wantResp, protocmp.Transform()); diff != "" {
+				t.Errorf("c.ListJoinRequests(%v, %v) resp != test.wantResp (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_CreateJoinRequest(t *testing.T) {
+	ctx := context.Background()
+	for _, test := range []struct {
+		desc      string
+		ctx       context.Context
+		req       *pb.CreateJoinRequestRequest
+		wantState pb.JoinRequest_State
+		wantCode  codes.Code
+	}{
+		{
+			desc: "OK_Approval",
+			ctx:  ctx,
+			req: &pb.CreateJoinRequestRequest{
+				Parent:      testresources.Bar.Name,
+				JoinRequest: &pb.JoinRequest{User: testresources.Carol.Name, Message: "Hi!"},
+			},
+			wantState: pb.JoinRequest_PENDING,
+			wantCode:  codes.OK,
+		},
+		{
+			desc: "OK_Open",
+			ctx:  ctx,
+			req: &pb.CreateJoinRequestRequest{
+				Parent:      testresources.Mall.Name,
+				JoinRequest: &pb.JoinRequest{User: testresources.Carol.Name},
+			},
+			wantState: pb.JoinRequest_APPROVED,
+			wantCode:  codes.OK,
+		},
+		{
+			desc: "OK_Self",
+			ctx:  authn.NewContext(ctx, authn.Identity{Name: testresources.Carol.Name, User: testresources.Carol.Name}),
+			req: &pb.CreateJoinRequestRequest{
+				Parent:      testresources.Bar.Name,
+				JoinRequest: &pb.JoinRequest{User: testresources.Carol.Name},
+			},
+			wantState: pb.JoinRequest_PENDING,
+			wantCode:  codes.OK,
+		},
+		{
+			desc: "OtherUser",
+			ctx:  authn.NewContext(ctx, authn.Identity{Name: testresources.Alice.Name, User: testresources.Alice.Name}),
+			req: &pb.CreateJoinRequestRequest{
+				Parent:      testresources.Bar.Name,
+				JoinRequest: &pb.JoinRequest{User: testresources.Carol.Name},
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			desc: "InviteOnly",
+			ctx:  ctx,
+			req: &pb.CreateJoinRequestRequest{
+				Parent:      testresources.Pharmacy.Name,
+				JoinRequest: &pb.JoinRequest{User: testresources.Carol.Name},
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			desc: "AlreadyMember",
+			ctx:  ctx,
+			req: &pb.CreateJoinRequestRequest{
+				Parent:      testresources.Bar.Name,
+				JoinRequest: &pb.JoinRequest{User: testresources.Bob.Name},
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			desc: "AlreadyRequested",
+			ctx:  ctx,
+			req: &pb.CreateJoinRequestRequest{
+				Parent:      testresources.Mall.Name,
+				JoinRequest: &pb.JoinRequest{User: testresources.Bob.Name},
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			desc: "InvalidUser",
+			ctx:  ctx,
+			req: &pb.CreateJoinRequestRequest{
+				Parent:      testresources.Bar.Name,
+				JoinRequest: &pb.JoinRequest{User: testresources.Mall.Name},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "UserNotFound",
+			ctx:  ctx,
+			req: &pb.CreateJoinRequestRequest{
+				Parent:      testresources.Bar.Name,
+				JoinRequest: &pb.JoinRequest{User: "users/00000000-0000-0000-0000-000000000000"},
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			desc: "StoreNotFound",
+			ctx:  ctx,
+			req: &pb.CreateJoinRequestRequest{
+				Parent:      "stores/00000000-0000-0000-0000-000000000000",
+				JoinRequest: &pb.JoinRequest{User: testresources.Carol.Name},
+			},
+			wantCode: codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			svc := seed(ctx, t)
+			seedCarol(ctx, t, svc)
+			joinRequest, err := svc.CreateJoinRequest(test.ctx, test.req)
+			if got := status.Code(err); got != test.wantCode {
+				t.Fatalf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+			if test.wantCode != codes.OK {
+				return
+			}
+			if err := joinrequests.Validate(joinRequest); err != nil {
+				t.Errorf("joinrequests.Validate(%v) = %v; want nil", joinRequest, err)
+			}
+			if got := joinRequest.State; got != test.wantState {
+				t.Errorf("joinRequest.State = %v; want %v", got, test.wantState)
+			}
+			member, err := svc.isMember(ctx, test.req.Parent, joinRequest.User)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := test.wantState == pb.JoinRequest_APPROVED; member != want {
+				t.Errorf("svc.isMember(%q, %q) = %v; want %v", test.req.Parent, joinRequest.User, member, want)
+			}
+		})
+	}
+}
+
+func TestService_DeleteJoinRequest(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	name := testresources.Mall_Bob_JoinRequest.Name
+	if _, err := c.DeleteJoinRequest(ctx, &pb.DeleteJoinRequestRequest{Name: name}); err != nil {
+		t.Fatalf("c.DeleteJoinRequest(%q) err = %v; want nil", name, err)
+	}
+	_, err := c.DeleteJoinRequest(ctx, &pb.DeleteJoinRequestRequest{Name: name})
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Errorf("second c.DeleteJoinRequest(%q): status.Code(%v) = %v; want %v", name, err, got, want)
+	}
+	_, err = c.DeleteJoinRequest(ctx, &pb.DeleteJoinRequestRequest{Name: testresources.Mall.Name})
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("c.DeleteJoinRequest(store): status.Code(%v) = %v; want %v", err, got, want)
+	}
+}
+
+func TestService_ApproveJoinRequest(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	adminCtx := asOperator(ctx)
+	seedCarol(ctx, t, svc)
+	c := serveAndDial(ctx, t, svc)
+	carol, err := c.CreateJoinRequest(ctx, &pb.CreateJoinRequestRequest{
+		Parent:      testresources.Bar.Name,
+		JoinRequest: &pb.JoinRequest{User: testresources.Carol.Name},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		desc         string
+		name         string
+		wantDiscount bool
+	}{
+		{desc: "Bar", name: carol.Name, wantDiscount: testresources.Bar.DefaultDiscount},
+		{desc: "Mall", name: testresources.Mall_Bob_JoinRequest.Name, wantDiscount: testresources.Mall.DefaultDiscount},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			req := &pb.ApproveJoinRequestRequest{Name: test.name}
+			membership, err := svc.ApproveJoinRequest(adminCtx, req)
+			if err != nil {
+				t.Fatalf("svc.ApproveJoinRequest(%v) err = %v; want nil", req, err)
+			}
+			if err := memberships.Validate(membership); err != nil {
+				t.Errorf("memberships.Validate(%v) = %v; want nil", membership, err)
+			}
+			if got := membership.Discount; got != test.wantDiscount {
+				t.Errorf("membership.Discount = %v; want %v", got, test.wantDiscount)
+			}
+			joinRequest, err := c.GetJoinRequest(ctx, &pb.GetJoinRequestRequest{Name: test.name})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if joinRequest.State != pb.JoinRequest_APPROVED || joinRequest.Membership != membership.Name {
+				t.Errorf("joinRequest = %v; want approved with membership %q", joinRequest, membership.Name)
+			}
+			_, err = svc.ApproveJoinRequest(adminCtx, req)
+			if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+				t.Errorf("second svc.ApproveJoinRequest(%v): status.Code(%v) = %v; want %v", req, err, got, want)
+			}
+		})
+	}
+	_, err = svc.ApproveJoinRequest(adminCtx, &pb.ApproveJoinRequestRequest{Name: testresources.Bar_Carol_JoinRequest.Name})
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Errorf("svc.ApproveJoinRequest(not found): status.Code(%v) = %v; want %v", err, got, want)
+	}
+}
+
+func TestService_RejectJoinRequest(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	adminCtx := asOperator(ctx)
+	req := &pb.RejectJoinRequestRequest{Name: testresources.Mall_Bob_JoinRequest.Name}
+	joinRequest, err := svc.RejectJoinRequest(adminCtx, req)
+	if err != nil {
+		t.Fatalf("svc.RejectJoinRequest(%v) err = %v; want nil", req, err)
+	}
+	want := joinrequests.Clone(testresources.Mall_Bob_JoinRequest)
+	want.State = pb.JoinRequest_REJECTED
+	if diff := cmp.Diff(joinRequest, want, protocmp.Transform()); diff != "" {
+		t.Errorf("svc.RejectJoinRequest(%v) joinRequest != want (-got +want)\n%s", req, diff)
+	}
+	for _, name := range []string{
+		testresources.Mall_Bob_JoinRequest.Name,
+		testresources.Bar_Bob_JoinRequest.Name,
+	} {
+		_, err := svc.RejectJoinRequest(adminCtx, &pb.RejectJoinRequestRequest{Name: name})
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("svc.RejectJoinRequest(%q): status.Code(%v) = %v; want %v", name, err, got, want)
+		}
+		_, err = svc.ApproveJoinRequest(adminCtx, &pb.ApproveJoinRequestRequest{Name: name})
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("svc.ApproveJoinRequest(%q): status.Code(%v) = %v; want %v", name, err, got, want)
+		}
+	}
+	member, err := svc.isMember(ctx, testresources.Mall.Name, testresources.Bob.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member {
+		t.Errorf("Bob is a member of %q after the join request was rejected", testresources.Mall.Name)
+	}
+}
+
+func TestService_ApproveJoinRequest_Administrator(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	name := testresources.Mall_Bob_JoinRequest.Name
+	// Bob made the join request, and Alice is a member of Mall, but not an
+	// administrator. Neither anonymous callers nor devices may approve or
+	// reject join requests.
+	for _, caller := range []struct {
+		desc string
+		ctx  context.Context
+		want codes.Code
+	}{
+		{desc: "Requester", ctx: asUser(ctx, testresources.Bob.Name), want: codes.PermissionDenied},
+		{desc: "Member", ctx: asUser(ctx, testresources.Alice.Name), want: codes.PermissionDenied},
+		{desc: "Anonymous", ctx: ctx, want: codes.Unauthenticated},
+		{desc: "Device", ctx: asDevice(ctx, testresources.Mall_Kiosk), want: codes.PermissionDenied},
+	} {
+		_, err := svc.ApproveJoinRequest(caller.ctx, &pb.ApproveJoinRequestRequest{Name: name})
+		if got := status.Code(err); got != caller.want {
+			t.Errorf("%s: ApproveJoinRequest: status.Code(%v) = %v; want %v", caller.desc, err, got, caller.want)
+		}
+		_, err = svc.RejectJoinRequest(caller.ctx, &pb.RejectJoinRequestRequest{Name: name})
+		if got := status.Code(err); got != caller.want {
+			t.Errorf("%s: RejectJoinRequest: status.Code(%v) = %v; want %v", caller.desc, err, got, caller.want)
+		}
+	}
+	member, err := svc.isMember(ctx, testresources.Mall.Name, testresources.Bob.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member {
+		t.Errorf("%q is a member of %q after approving their own join request", testresources.Bob.Name, testresources.Mall.Name)
+	}
+	makeAdministrator(ctx, t, svc, testresources.Mall_Alice)
+	if _, err := svc.ApproveJoinRequest(asUser(ctx, testresources.Alice.Name), &pb.ApproveJoinRequestRequest{Name: name}); err != nil {
+		t.Errorf("ApproveJoinRequest as administrator: err = %v; want nil", err)
+	}
+}
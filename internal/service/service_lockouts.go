@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) ListLockouts(ctx context.Context, req *pb.ListLockoutsRequest) (*pb.ListLockoutsResponse, error) {
+	if !s.isAdministrator(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only administrators may list lockouts")
+	}
+	if req.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative page size: %d", req.PageSize)
+	}
+	if req.PageSize > 0 || req.PageToken != "" {
+		return nil, status.Error(codes.Unimplemented, "pagination is not implemented")
+	}
+	var lockouts []*pb.Lockout
+	for _, l := range s.guard.Lockouts() {
+		lockout := &pb.Lockout{
+			Name:         l.Name,
+			User:         l.User,
+			Peer:         l.Peer,
+			FailureCount: int32(l.Failures),
+			ExpireTime:   timestamppb.New(l.Until),
+		}
+		// The guard tells accounts of users and memberships apart only by
+		// their names.
+		if memberships.ValidateName(l.User) == nil {
+			lockout.User = ""
+			lockout.Membership = l.User
+		}
+		lockouts = append(lockouts, lockout)
+	}
+	return &pb.ListLockoutsResponse{
+		Lockouts:      lockouts,
+		NextPageToken: "",
+	}, nil
+}
+
+func (s *Service) DeleteLockout(ctx context.Context, req *pb.DeleteLockoutRequest) (*emptypb.Empty, error) {
+	if !s.isAdministrator(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only administrators may delete lockouts")
+	}
+	if err := s.guard.Clear(req.Name); err != nil {
+		if errors.Is(err, resourcename.ErrInvalidName) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if notFound := new(repositories.NotFound); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	return new(emptypb.Empty), nil
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/authn"
+	"github.com/Saser/strecku/testresources"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// lockOut fails to authenticate as Alice until her account is locked out.
+func lockOut(ctx context.Context, t *testing.T, svc *Service) {
+	t.Helper()
+	users := svc.guard.Users(svc.userRepo)
+	// Attempts made while the account is blocked do not count, so the delays
+	// between failures are disabled.
+	svc.guard.Account.BaseDelay = 0
+	svc.guard.Account.MaxDelay = 0
+	for i := 0; i < svc.guard.Account.MaxFailures; i++ {
+		if err := users.Authenticate(ctx, testresources.Alice.Name, "wrong password"); err == nil {
+			t.Fatal("Authenticate with wrong password: err = nil; want non-nil")
+		}
+	}
+}
+
+func TestService_ListLockouts(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	adminCtx := asOperator(ctx)
+	{
+		resp, err := svc.ListLockouts(adminCtx, &pb.ListLockoutsRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := len(resp.Lockouts); got != 0 {
+			t.Errorf("len(resp.Lockouts) = %d; want 0", got)
+		}
+	}
+	lockOut(ctx, t, svc)
+	{
+		resp, err := svc.ListLockouts(adminCtx, &pb.ListLockoutsRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := len(resp.Lockouts); got != 1 {
+			t.Fatalf("len(resp.Lockouts) = %d; want 1", got)
+		}
+		l := resp.Lockouts[0]
+		if got, want := l.User, testresources.Alice.Name; got != want {
+			t.Errorf("lockout.User = %q; want %q", got, want)
+		}
+		if got, want := l.FailureCount, int32(svc.guard.Account.MaxFailures); got != want {
+			t.Errorf("lockout.FailureCount = %d; want %d", got, want)
+		}
+	}
+	for _, test := range []struct {
+		desc string
+		ctx  context.Context
+		req  *pb.ListLockoutsRequest
+		want codes.Code
+	}{
+		{desc: "NegativePageSize", ctx: adminCtx, req: &pb.ListLockoutsRequest{PageSize: -1}, want: codes.InvalidArgument},
+		{desc: "PaginationUnimplemented_PositivePageSize", ctx: adminCtx, req: &pb.ListLockoutsRequest{PageSize: 1}, want: codes.Unimplemented},
+		{desc: "PaginationUnimplemented_NonEmptyPageToken", ctx: adminCtx, req: &pb.ListLockoutsRequest{PageToken: "token"}, want: codes.Unimplemented},
+		{desc: "Unauthenticated", ctx: ctx, req: &pb.ListLockoutsRequest{}, want: codes.PermissionDenied},
+		{desc: "User", ctx: asUser(ctx, testresources.Alice.Name), req: &pb.ListLockoutsRequest{}, want: codes.PermissionDenied},
+		{desc: "OtherCertificate", ctx: authn.NewContext(ctx, authn.Identity{Name: "kiosk-bar", Subject: "CN=kiosk-1,O=Bar"}), req: &pb.ListLockoutsRequest{}, want: codes.PermissionDenied},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := svc.ListLockouts(test.ctx, test.req)
+			if got := status.Code(err); got != test.want {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.want)
+			}
+		})
+	}
+}
+
+func TestService_ListLockouts_Membership(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	for i := 0; i < svc.guard.Account.MaxFailures; i++ {
+		svc.guard.Failed(ctx, testresources.Bar_Alice.Name)
+	}
+	resp, err := svc.ListLockouts(asOperator(ctx), &pb.ListLockoutsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(resp.Lockouts); got != 1 {
+		t.Fatalf("len(resp.Lockouts) = %d; want 1", got)
+	}
+	l := resp.Lockouts[0]
+	if got, want := l.Membership, testresources.Bar_Alice.Name; got != want {
+		t.Errorf("lockout.Membership = %q; want %q", got, want)
+	}
+	if got, want := l.User, ""; got != want {
+		t.Errorf("lockout.User = %q; want %q", got, want)
+	}
+}
+
+func TestService_DeleteLockout(t *testing.T) {
+	ctx := context.Background()
+	// Test scenario(s) where the delete is successful.
+	t.Run("OK", func(t *testing.T) {
+		svc := seed(ctx, t)
+			lockOut(ctx, t, svc)
+		name := svc.guard.Lockouts()[0].Name
+		if _, err := svc.DeleteLockout(asOperator(ctx), &pb.DeleteLockoutRequest{Name: name}); err != nil {
+			t.Errorf("DeleteLockout: err = %v; want nil", err)
+		}
+		if err := svc.guard.Users(svc.userRepo).Authenticate(ctx, testresources.Alice.Name, testresources.AlicePassword); err != nil {
+			t.Errorf("Authenticate after DeleteLockout: err = %v; want nil", err)
+		}
+	})
+	// Test scenario(s) where the delete fails.
+	t.Run("Errors", func(t *testing.T) {
+		svc := seed(ctx, t)
+			lockOut(ctx, t, svc)
+		name := svc.guard.Lockouts()[0].Name
+		for _, test := range []struct {
+			desc string
+			ctx  context.Context
+			req  *pb.DeleteLockoutRequest
+			want codes.Code
+		}{
+			{
+				desc: "EmptyName",
+				ctx:  asOperator(ctx),
+				req:  &pb.DeleteLockoutRequest{Name: ""},
+				want: codes.InvalidArgument,
+			},
+			{
+				desc: "NotFound",
+				ctx:  asOperator(ctx),
+				req:  &pb.DeleteLockoutRequest{Name: "lockouts/6f1c9d52-6d43-4c4a-9a8e-0c8f5b0e6b1a"},
+				want: codes.NotFound,
+			},
+			{
+				desc: "Unauthenticated",
+				ctx:  ctx,
+				req:  &pb.DeleteLockoutRequest{Name: name},
+				want: codes.PermissionDenied,
+			},
+			{
+				desc: "LockedOutUser",
+				ctx:  asUser(ctx, testresources.Alice.Name),
+				req:  &pb.DeleteLockoutRequest{Name: name},
+				want: codes.PermissionDenied,
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				_, err := svc.DeleteLockout(test.ctx, test.req)
+				if got := status.Code(err); got != test.want {
+					t.Errorf("status.Code(%v) = %v; want %v", err, got, test.want)
+				}
+			})
+		}
+	})
+}
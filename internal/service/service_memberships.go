@@ -20,7 +20,7 @@ func (s *Service) GetMembership(ctx context.Context, req *pb.GetMembershipReques
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	membership, err := s.membershipRepo.LookupMembership(ctx, name)
@@ -28,7 +28,7 @@ func (s *Service) GetMembership(ctx context.Context, req *pb.GetMembershipReques
 		if notFound := new(memberships.NotFoundError); errors.As(err, &notFound) {
 			return nil, status.Error(codes.NotFound, notFound.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return membership, nil
 }
@@ -39,7 +39,7 @@ func (s *Service) ListMemberships(ctx context.Context, req *pb.ListMembershipsRe
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	if req.PageSize < 0 {
@@ -57,7 +57,7 @@ func (s *Service) ListMemberships(ctx context.Context, req *pb.ListMembershipsRe
 	}
 	filtered, err := s.membershipRepo.FilterMemberships(ctx, predicate)
 	if err != nil {
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return &pb.ListMembershipsResponse{
 		Memberships:   filtered,
@@ -71,11 +71,16 @@ func (s *Service) CreateMembership(ctx context.Context, req *pb.CreateMembership
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	membership := req.Membership
 	membership.Name = memberships.GenerateName(req.Parent)
+	paymentReference, err := memberships.PaymentReference(membership.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	membership.PaymentReference = paymentReference
 	if err := memberships.Validate(membership); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid membership: %v", err)
 	}
@@ -83,7 +88,7 @@ func (s *Service) CreateMembership(ctx context.Context, req *pb.CreateMembership
 		if exists := new(memberships.ExistsError); errors.As(err, &exists) {
 			return nil, status.Error(codes.AlreadyExists, exists.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return membership, nil
 }
@@ -94,6 +99,8 @@ func (s *Service) UpdateMembership(ctx context.Context, req *pb.UpdateMembership
 	if err != nil {
 		return nil, err
 	}
+	paymentReference := dst.PaymentReference
+	pinSet := dst.PinSet
 	mask := req.UpdateMask
 	if mask == nil {
 		dst = src
@@ -109,11 +116,19 @@ func (s *Service) UpdateMembership(ctx context.Context, req *pb.UpdateMembership
 				dst.Administrator = src.Administrator
 			case "discount":
 				dst.Discount = src.Discount
+			case "leaderboard_opt_out":
+				dst.LeaderboardOptOut = src.LeaderboardOptOut
+			case "payment_reference":
+				return nil, status.Errorf(codes.InvalidArgument, `field "payment_reference" cannot be updated`)
+			case "pin_set":
+				return nil, status.Errorf(codes.InvalidArgument, `field "pin_set" cannot be updated`)
 			default:
 				return nil, status.Errorf(codes.Internal, "update not implemented for path %q", path)
 			}
 		}
 	}
+	dst.PaymentReference = paymentReference
+	dst.PinSet = pinSet
 	if err := memberships.Validate(dst); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid membership: %v", err)
 	}
@@ -122,26 +137,46 @@ func (s *Service) UpdateMembership(ctx context.Context, req *pb.UpdateMembership
 		case memberships.ErrUpdateUser:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update: %v", err)
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	return dst, nil
 }
 
+func (s *Service) ChangeMembershipPin(ctx context.Context, req *pb.ChangeMembershipPinRequest) (*pb.Membership, error) {
+	membership, err := s.GetMembership(ctx, &pb.GetMembershipRequest{Name: req.Membership})
+	if err != nil {
+		return nil, err
+	}
+	if caller, ok := callerUser(ctx); ok && caller != membership.User {
+		return nil, status.Error(codes.PermissionDenied, "users can only change the PINs of their own memberships")
+	}
+	if req.Pin != "" {
+		if err := memberships.ValidatePin(req.Pin); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid PIN: %v", err)
+		}
+	}
+	if err := s.membershipRepo.SetPin(ctx, membership.Name, req.Pin); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	membership.PinSet = req.Pin != ""
+	return membership, nil
+}
+
 func (s *Service) DeleteMembership(ctx context.Context, req *pb.DeleteMembershipRequest) (*emptypb.Empty, error) {
 	if err := memberships.ValidateName(req.Name); err != nil {
 		switch {
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	if err := s.membershipRepo.DeleteMembership(ctx, req.Name); err != nil {
 		if notFound := new(memberships.NotFoundError); errors.As(err, &notFound) {
 			return nil, status.Error(codes.NotFound, notFound.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return new(emptypb.Empty), nil
 }
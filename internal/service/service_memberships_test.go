@@ -207,10 +207,15 @@ func TestService_CreateMembership(t *testing.T) {
 			membership, err := c.CreateMembership(ctx, test.req)
 			if diff := cmp.Diff(
 				membership, test.wantMembership, protocmp.Transform(),
-				protocmp.IgnoreFields(new(pb.Membership), "name"),
+				protocmp.IgnoreFields(new(pb.Membership), "name", "payment_reference"),
 			); diff != "" {
 				t.Errorf("c.CreateMembership(%v, %v) membership != test.wantMembership (-got +want)\n%s", ctx, test.req, diff)
 			}
+			if membership != nil {
+				if want, _ := memberships.PaymentReference(membership.Name); membership.PaymentReference != want {
+					t.Errorf("membership.PaymentReference = %q; want %q", membership.PaymentReference, want)
+				}
+			}
 			if got := status.Code(err); got != test.wantCode {
 				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
 			}
@@ -263,6 +268,18 @@ func TestService_UpdateMembership(t *testing.T) {
 				},
 				want: newBarAlice,
 			},
+			{
+				desc: "FullUpdate_NilUpdateMask_NoPaymentReference",
+				req: &pb.UpdateMembershipRequest{
+					Membership: func() *pb.Membership {
+						membership := memberships.Clone(newBarAlice)
+						membership.PaymentReference = ""
+						return membership
+					}(),
+					UpdateMask: nil,
+				},
+				want: newBarAlice,
+			},
 			{
 				desc: "FullUpdate_AllPaths",
 				req: &pb.UpdateMembershipRequest{
@@ -295,6 +312,21 @@ func TestService_UpdateMembership(t *testing.T) {
 					return membership
 				}(),
 			},
+			{
+				desc: "PartialUpdate_PartialMembership_LeaderboardOptOut",
+				req: &pb.UpdateMembershipRequest{
+					Membership: &pb.Membership{
+						Name:              testresources.Bar_Alice.Name,
+						LeaderboardOptOut: true,
+					},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"leaderboard_opt_out"}},
+				},
+				want: func() *pb.Membership {
+					membership := memberships.Clone(oldBarAlice)
+					membership.LeaderboardOptOut = true
+					return membership
+				}(),
+			},
 			{
 				desc: "PartialUpdate_PartialMembership_Administrator",
 				req: &pb.UpdateMembershipRequest{
@@ -393,6 +425,22 @@ func TestService_UpdateMembership(t *testing.T) {
 				},
 				want: codes.InvalidArgument,
 			},
+			{
+				desc: "InvalidUpdateMask_PaymentReference",
+				req: &pb.UpdateMembershipRequest{
+					Membership: testresources.Bar_Alice,
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"payment_reference"}},
+				},
+				want: codes.InvalidArgument,
+			},
+			{
+				desc: "InvalidUpdateMask_PinSet",
+				req: &pb.UpdateMembershipRequest{
+					Membership: testresources.Bar_Alice,
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pin_set"}},
+				},
+				want: codes.InvalidArgument,
+			},
 		} {
 			t.Run(test.desc, func(t *testing.T) {
 				c := serveAndDial(ctx, t, seed(ctx, t))
@@ -405,6 +453,92 @@ func TestService_UpdateMembership(t *testing.T) {
 	})
 }
 
+func TestService_ChangeMembershipPin(t *testing.T) {
+	ctx := context.Background()
+	t.Run("OK", func(t *testing.T) {
+		svc := seed(ctx, t)
+		alice := asUser(ctx, testresources.Alice.Name)
+		membership, err := svc.ChangeMembershipPin(alice, &pb.ChangeMembershipPinRequest{
+			Membership: testresources.Bar_Alice.Name,
+			Pin:        "1234",
+		})
+		if err != nil {
+			t.Fatalf("svc.ChangeMembershipPin(alice, ...) err = %v; want nil", err)
+		}
+		if !membership.PinSet {
+			t.Error("membership.PinSet = false; want true")
+		}
+		if err := svc.membershipRepo.CheckPin(ctx, testresources.Bar_Alice.Name, "1234"); err != nil {
+			t.Errorf("svc.membershipRepo.CheckPin(ctx, %q, %q) = %v; want nil", testresources.Bar_Alice.Name, "1234", err)
+		}
+		// Updating the membership neither removes the PIN nor reports it
+		// as removed.
+		membership.Discount = true
+		updated, err := svc.UpdateMembership(ctx, &pb.UpdateMembershipRequest{Membership: membership})
+		if err != nil {
+			t.Fatalf("svc.UpdateMembership(ctx, ...) err = %v; want nil", err)
+		}
+		if !updated.PinSet {
+			t.Error("updated.PinSet = false; want true")
+		}
+		// An empty PIN removes the PIN.
+		membership, err = svc.ChangeMembershipPin(alice, &pb.ChangeMembershipPinRequest{
+			Membership: testresources.Bar_Alice.Name,
+		})
+		if err != nil {
+			t.Fatalf("svc.ChangeMembershipPin(alice, ...) err = %v; want nil", err)
+		}
+		if membership.PinSet {
+			t.Error("membership.PinSet = true after removing PIN; want false")
+		}
+	})
+	for _, test := range []struct {
+		desc string
+		ctx  context.Context
+		req  *pb.ChangeMembershipPinRequest
+		want codes.Code
+	}{
+		{
+			desc: "OtherUser",
+			ctx:  asUser(ctx, testresources.Bob.Name),
+			req:  &pb.ChangeMembershipPinRequest{Membership: testresources.Bar_Alice.Name, Pin: "1234"},
+			want: codes.PermissionDenied,
+		},
+		{
+			desc: "TooShort",
+			ctx:  ctx,
+			req:  &pb.ChangeMembershipPinRequest{Membership: testresources.Bar_Alice.Name, Pin: "123"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "NotDigits",
+			ctx:  ctx,
+			req:  &pb.ChangeMembershipPinRequest{Membership: testresources.Bar_Alice.Name, Pin: "abcd"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "InvalidName",
+			ctx:  ctx,
+			req:  &pb.ChangeMembershipPinRequest{Membership: testresources.Bar.Name, Pin: "1234"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "NotFound",
+			ctx:  ctx,
+			req:  &pb.ChangeMembershipPinRequest{Membership: testresources.Mall_Bob.Name, Pin: "1234"},
+			want: codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			svc := seed(ctx, t)
+			_, err := svc.ChangeMembershipPin(test.ctx, test.req)
+			if got := status.Code(err); got != test.want {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.want)
+			}
+		})
+	}
+}
+
 func TestService_DeleteMembership(t *testing.T) {
 	ctx := context.Background()
 	// Test scenario(s) where the delete is successful.
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *Service) GetPayment(ctx context.Context, req *pb.GetPaymentRequest) (*pb.Payment, error) {
@@ -20,7 +21,7 @@ func (s *Service) GetPayment(ctx context.Context, req *pb.GetPaymentRequest) (*p
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	payment, err := s.paymentRepo.LookupPayment(ctx, name)
@@ -28,7 +29,7 @@ func (s *Service) GetPayment(ctx context.Context, req *pb.GetPaymentRequest) (*p
 		if notFound := new(payments.NotFoundError); errors.As(err, &notFound) {
 			return nil, status.Error(codes.NotFound, notFound.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return payment, nil
 }
@@ -39,7 +40,7 @@ func (s *Service) ListPayments(ctx context.Context, req *pb.ListPaymentsRequest)
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	if req.PageSize < 0 {
@@ -57,7 +58,7 @@ func (s *Service) ListPayments(ctx context.Context, req *pb.ListPaymentsRequest)
 	}
 	filtered, err := s.paymentRepo.FilterPayments(ctx, predicate)
 	if err != nil {
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return &pb.ListPaymentsResponse{
 		Payments:      filtered,
@@ -71,16 +72,17 @@ func (s *Service) CreatePayment(ctx context.Context, req *pb.CreatePaymentReques
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	payment := req.Payment
 	payment.Name = payments.GenerateName(req.Parent)
+	payment.CreateTime = timestamppb.Now()
 	if err := payments.Validate(payment); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid payment: %v", err)
 	}
 	if err := s.paymentRepo.CreatePayment(ctx, payment); err != nil {
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return payment, nil
 }
@@ -91,6 +93,7 @@ func (s *Service) UpdatePayment(ctx context.Context, req *pb.UpdatePaymentReques
 	if err != nil {
 		return nil, err
 	}
+	createTime := dst.CreateTime
 	mask := req.UpdateMask
 	if mask == nil {
 		dst = src
@@ -102,6 +105,8 @@ func (s *Service) UpdatePayment(ctx context.Context, req *pb.UpdatePaymentReques
 			switch path {
 			case "user":
 				return nil, status.Errorf(codes.InvalidArgument, `field "user" cannot be updated`)
+			case "create_time":
+				return nil, status.Errorf(codes.InvalidArgument, `field "create_time" cannot be updated`)
 			case "description":
 				dst.Description = src.Description
 			case "amount_cents":
@@ -111,6 +116,7 @@ func (s *Service) UpdatePayment(ctx context.Context, req *pb.UpdatePaymentReques
 			}
 		}
 	}
+	dst.CreateTime = createTime
 	if err := payments.Validate(dst); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid payment: %v", err)
 	}
@@ -119,7 +125,7 @@ func (s *Service) UpdatePayment(ctx context.Context, req *pb.UpdatePaymentReques
 		case payments.ErrUpdateUser:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update: %v", err)
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	return dst, nil
@@ -131,14 +137,14 @@ func (s *Service) DeletePayment(ctx context.Context, req *pb.DeletePaymentReques
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	if err := s.paymentRepo.DeletePayment(ctx, req.Name); err != nil {
 		if notFound := new(payments.NotFoundError); errors.As(err, &notFound) {
 			return nil, status.Error(codes.NotFound, notFound.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return new(emptypb.Empty), nil
 }
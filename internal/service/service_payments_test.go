@@ -180,10 +180,13 @@ func TestService_CreatePayment(t *testing.T) {
 			payment, err := c.CreatePayment(ctx, test.req)
 			if diff := cmp.Diff(
 				payment, test.wantPayment, protocmp.Transform(),
-				protocmp.IgnoreFields(new(pb.Payment), "name"),
+				protocmp.IgnoreFields(new(pb.Payment), "name", "create_time"),
 			); diff != "" {
 				t.Errorf("c.CreatePayment(%v, %v) payment != test.wantPayment (-got +want)\n%s", ctx, test.req, diff)
 			}
+			if err == nil && payment.CreateTime == nil {
+				t.Errorf("c.CreatePayment(%v, %v) create_time = nil; want non-nil", ctx, test.req)
+			}
 			if got := status.Code(err); got != test.wantCode {
 				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
 			}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/resources/stores"
+	"github.com/Saser/strecku/resources/stores/pricingrules"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Service) GetPricingRule(ctx context.Context, req *pb.GetPricingRuleRequest) (*pb.PricingRule, error) {
+	name := req.Name
+	if err := pricingrules.ValidateName(name); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	rule, err := s.pricingRuleRepo.LookupPricingRule(ctx, name)
+	if err != nil {
+		if notFound := new(pricingrules.NotFoundError); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	return rule, nil
+}
+
+func (s *Service) ListPricingRules(ctx context.Context, req *pb.ListPricingRulesRequest) (*pb.ListPricingRulesResponse, error) {
+	if err := stores.ValidateName(req.Parent); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if req.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative page size: %d", req.PageSize)
+	}
+	if req.PageSize > 0 || req.PageToken != "" {
+		return nil, status.Error(codes.Unimplemented, "pagination is not implemented")
+	}
+	predicate := func(rule *pb.PricingRule) bool {
+		parent, err := pricingrules.Parent(rule.Name)
+		if err != nil {
+			return false
+		}
+		return parent == req.Parent
+	}
+	filtered, err := s.pricingRuleRepo.FilterPricingRules(ctx, predicate)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return &pb.ListPricingRulesResponse{
+		PricingRules:  filtered,
+		NextPageToken: "",
+	}, nil
+}
+
+func (s *Service) CreatePricingRule(ctx context.Context, req *pb.CreatePricingRuleRequest) (*pb.PricingRule, error) {
+	if err := stores.ValidateName(req.Parent); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	rule := req.PricingRule
+	rule.Name = pricingrules.GenerateName(req.Parent)
+	if err := pricingrules.Validate(rule); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pricing rule: %v", err)
+	}
+	if err := s.pricingRuleRepo.CreatePricingRule(ctx, rule); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return rule, nil
+}
+
+func (s *Service) UpdatePricingRule(ctx context.Context, req *pb.UpdatePricingRuleRequest) (*pb.PricingRule, error) {
+	src := req.PricingRule
+	dst, err := s.GetPricingRule(ctx, &pb.GetPricingRuleRequest{Name: src.Name})
+	if err != nil {
+		return nil, err
+	}
+	mask := req.UpdateMask
+	if mask == nil {
+		dst = src
+	} else {
+		if !mask.IsValid(dst) {
+			return nil, status.Error(codes.InvalidArgument, "invalid update mask")
+		}
+		for _, path := range mask.Paths {
+			switch path {
+			case "display_name":
+				dst.DisplayName = src.DisplayName
+			case "products":
+				dst.Products = src.Products
+			case "start_time":
+				dst.StartTime = src.StartTime
+			case "end_time":
+				dst.EndTime = src.EndTime
+			case "time_zone":
+				dst.TimeZone = src.TimeZone
+			case "weekdays":
+				dst.Weekdays = src.Weekdays
+			case "daily_start_minute":
+				dst.DailyStartMinute = src.DailyStartMinute
+			case "daily_end_minute":
+				dst.DailyEndMinute = src.DailyEndMinute
+			case "min_quantity":
+				dst.MinQuantity = src.MinQuantity
+			case "unit_interval":
+				dst.UnitInterval = src.UnitInterval
+			case "discount_members_only":
+				dst.DiscountMembersOnly = src.DiscountMembersOnly
+			case "administrators_only":
+				dst.AdministratorsOnly = src.AdministratorsOnly
+			case "percent_off", "fixed_price_cents":
+				dst.Effect = src.Effect
+			default:
+				return nil, status.Errorf(codes.Internal, "update not implemented for path %q", path)
+			}
+		}
+	}
+	if err := pricingrules.Validate(dst); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pricing rule: %v", err)
+	}
+	if err := s.pricingRuleRepo.UpdatePricingRule(ctx, dst); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return dst, nil
+}
+
+func (s *Service) DeletePricingRule(ctx context.Context, req *pb.DeletePricingRuleRequest) (*emptypb.Empty, error) {
+	if err := pricingrules.ValidateName(req.Name); err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if err := s.pricingRuleRepo.DeletePricingRule(ctx, req.Name); err != nil {
+		if notFound := new(pricingrules.NotFoundError); errors.As(err, &notFound) {
+			return nil, status.Error(codes.NotFound, notFound.Error())
+		}
+		return nil, internalError(ctx, err)
+	}
+	return new(emptypb.Empty), nil
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/pricingrules"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestService_GetPricingRule(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	for _, test := range []struct {
+		desc     string
+		req      *pb.GetPricingRuleRequest
+		wantRule *pb.PricingRule
+		wantCode codes.Code
+	}{
+		{
+			desc:     "OK",
+			req:      &pb.GetPricingRuleRequest{Name: testresources.Bar_SecondCocktailHalfPrice.Name},
+			wantRule: testresources.Bar_SecondCocktailHalfPrice,
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "EmptyName",
+			req:      &pb.GetPricingRuleRequest{Name: ""},
+			wantRule: nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "InvalidName",
+			req:      &pb.GetPricingRuleRequest{Name: testresources.Beer.Name}, // name of a product
+			wantRule: nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "NotFound",
+			req:      &pb.GetPricingRuleRequest{Name: testresources.Bar_HappyHour.Name},
+			wantRule: nil,
+			wantCode: codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			rule, err := c.GetPricingRule(ctx, test.req)
+			if diff := cmp.Diff(rule, test.wantRule, protocmp.Transform()); diff != "" {
+				t.Errorf("c.GetPricingRule(%v, %v) rule != test.wantRule (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_ListPricingRules(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	for _, test := range []struct {
+		desc     string
+		req      *pb.ListPricingRulesRequest
+		wantResp *pb.ListPricingRulesResponse
+		wantCode codes.Code
+	}{
+		{
+			desc: "OK_Bar",
+			req:  &pb.ListPricingRulesRequest{Parent: testresources.Bar.Name},
+			wantResp: &pb.ListPricingRulesResponse{
+				PricingRules: []*pb.PricingRule{testresources.Bar_SecondCocktailHalfPrice},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "OK_Mall",
+			req:  &pb.ListPricingRulesRequest{Parent: testresources.Mall.Name},
+			wantResp: &pb.ListPricingRulesResponse{
+				PricingRules: []*pb.PricingRule{testresources.Mall_JeansSale},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc:     "InvalidParent",
+			req:      &pb.ListPricingRulesRequest{Parent: testresources.Alice.Name}, // name of a user
+			wantResp: nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "NegativePageSize",
+			req:      &pb.ListPricingRulesRequest{Parent: testresources.Bar.Name, PageSize: -1},
+			wantResp: nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "PaginationUnimplemented",
+			req:      &pb.ListPricingRulesRequest{Parent: testresources.Bar.Name, PageToken: "token"},
+			wantResp: nil,
+			wantCode: codes.Unimplemented,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			resp, err := c.ListPricingRules(ctx, test.req)
+			if diff := cmp.Diff(resp, test.wantResp, protocmp.Transform()); diff != "" {
+				t.Errorf("c.ListPricingRules(%v, %v) resp != test.wantResp (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_CreatePricingRule(t *testing.T) {
+	ctx := context.Background()
+	for _, test := range []struct {
+		desc     string
+		req      *pb.CreatePricingRuleRequest
+		wantRule *pb.PricingRule
+		wantCode codes.Code
+	}{
+		{
+			desc: "OK",
+			req: &pb.CreatePricingRuleRequest{
+				Parent:      testresources.Bar.Name,
+				PricingRule: pricingrules.Clone(testresources.Bar_HappyHour),
+			},
+			wantRule: testresources.Bar_HappyHour,
+			wantCode: codes.OK,
+		},
+		{
+			desc: "ProductWrongStore",
+			req: &pb.CreatePricingRuleRequest{
+				Parent:      testresources.Mall.Name,
+				PricingRule: pricingrules.Clone(testresources.Bar_HappyHour),
+			},
+			wantRule: nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "InvalidParent",
+			req: &pb.CreatePricingRuleRequest{
+				Parent:      testresources.Alice.Name, // name of a user
+				PricingRule: pricingrules.Clone(testresources.Bar_HappyHour),
+			},
+			wantRule: nil,
+			wantCode: codes.InvalidArgument,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			c := serveAndDial(ctx, t, seed(ctx, t))
+			rule, err := c.CreatePricingRule(ctx, test.req)
+			if diff := cmp.Diff(
+				rule, test.wantRule, protocmp.Transform(),
+				protocmp.IgnoreFields(new(pb.PricingRule), "name"),
+			); diff != "" {
+				t.Errorf("c.CreatePricingRule(%v, %v) rule != test.wantRule (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_UpdatePricingRule(t *testing.T) {
+	ctx := context.Background()
+	// Test scenario(s) where the update is successful.
+	t.Run("OK", func(t *testing.T) {
+		oldRule := pricingrules.Clone(testresources.Bar_SecondCocktailHalfPrice)
+		newRule := pricingrules.Clone(oldRule)
+		newRule.DisplayName = "Third cocktail free"
+		newRule.UnitInterval = 3
+		newRule.Effect = &pb.PricingRule_PercentOff{PercentOff: 100}
+		for _, test := range []struct {
+			desc string
+			req  *pb.UpdatePricingRuleRequest
+			want *pb.PricingRule
+		}{
+			{
+				desc: "NoOp_NilUpdateMask",
+				req:  &pb.UpdatePricingRuleRequest{PricingRule: oldRule, UpdateMask: nil},
+				want: oldRule,
+			},
+			{
+				desc: "NoOp_NoPaths",
+				req:  &pb.UpdatePricingRuleRequest{PricingRule: newRule, UpdateMask: &fieldmaskpb.FieldMask{Paths: nil}},
+				want: oldRule,
+			},
+			{
+				desc: "FullUpdate_NilUpdateMask",
+				req:  &pb.UpdatePricingRuleRequest{PricingRule: newRule, UpdateMask: nil},
+				want: newRule,
+			},
+			{
+				desc: "FullUpdate_AllPaths",
+				req: &pb.UpdatePricingRuleRequest{
+					PricingRule: newRule,
+					UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"display_name", "unit_interval", "percent_off"}},
+				},
+				want: newRule,
+			},
+			{
+				desc: "PartialUpdate_DisplayName",
+				req: &pb.UpdatePricingRuleRequest{
+					PricingRule: newRule,
+					UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+				},
+				want: func() *pb.PricingRule {
+					rule := pricingrules.Clone(oldRule)
+					rule.DisplayName = newRule.DisplayName
+					return rule
+				}(),
+			},
+			{
+				desc: "PartialUpdate_Effect",
+				req: &pb.UpdatePricingRuleRequest{
+					PricingRule: &pb.PricingRule{
+						Name:   oldRule.Name,
+						Effect: &pb.PricingRule_FixedPriceCents{FixedPriceCents: -5000},
+					},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"fixed_price_cents"}},
+				},
+				want: func() *pb.PricingRule {
+					rule := pricingrules.Clone(oldRule)
+					rule.Effect = &pb.PricingRule_FixedPriceCents{FixedPriceCents: -5000}
+					return rule
+				}(),
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				c := serveAndDial(ctx, t, seed(ctx, t))
+				rule, err := c.UpdatePricingRule(ctx, test.req)
+				if diff := cmp.Diff(rule, test.want, protocmp.Transform()); diff != "" {
+					t.Errorf("c.UpdatePricingRule(%v, %v) rule != test.want (-got +want)\n%s", ctx, test.req, diff)
+				}
+				if got, want := status.Code(err), codes.OK; got != want {
+					t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+				}
+			})
+		}
+	})
+	// Test scenario(s) where the update fails.
+	t.Run("Errors", func(t *testing.T) {
+		for _, test := range []struct {
+			desc string
+			req  *pb.UpdatePricingRuleRequest
+			want codes.Code
+		}{
+			{
+				desc: "EmptyDisplayName",
+				req: &pb.UpdatePricingRuleRequest{
+					PricingRule: func() *pb.PricingRule {
+						rule := pricingrules.Clone(testresources.Bar_SecondCocktailHalfPrice)
+						rule.DisplayName = ""
+						return rule
+					}(),
+					UpdateMask: nil,
+				},
+				want: codes.InvalidArgument,
+			},
+			{
+				desc: "PercentOffOutOfRange",
+				req: &pb.UpdatePricingRuleRequest{
+					PricingRule: &pb.PricingRule{
+						Name:   testresources.Bar_SecondCocktailHalfPrice.Name,
+						Effect: &pb.PricingRule_PercentOff{PercentOff: 150},
+					},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"percent_off"}},
+				},
+				want: codes.InvalidArgument,
+			},
+			{
+				desc: "NotFound",
+				req: &pb.UpdatePricingRuleRequest{
+					PricingRule: testresources.Bar_HappyHour,
+					UpdateMask:  nil,
+				},
+				want: codes.NotFound,
+			},
+			{
+				desc: "InvalidUpdateMask",
+				req: &pb.UpdatePricingRuleRequest{
+					PricingRule: testresources.Bar_SecondCocktailHalfPrice,
+					UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"invalid"}},
+				},
+				want: codes.InvalidArgument,
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				c := serveAndDial(ctx, t, seed(ctx, t))
+				_, err := c.UpdatePricingRule(ctx, test.req)
+				if got := status.Code(err); got != test.want {
+					t.Errorf("status.Code(%v) = %v; want %v", err, got, test.want)
+				}
+			})
+		}
+	})
+}
+
+func TestService_DeletePricingRule(t *testing.T) {
+	ctx := context.Background()
+	// Test scenario(s) where the delete is successful.
+	t.Run("OK", func(t *testing.T) {
+		c := serveAndDial(ctx, t, seed(ctx, t))
+		{
+			req := &pb.DeletePricingRuleRequest{Name: testresources.Bar_SecondCocktailHalfPrice.Name}
+			_, err := c.DeletePricingRule(ctx, req)
+			if got, want := status.Code(err), codes.OK; got != want {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+			}
+		}
+		{
+			req := &pb.GetPricingRuleRequest{Name: testresources.Bar_SecondCocktailHalfPrice.Name}
+			_, err := c.GetPricingRule(ctx, req)
+			if got, want := status.Code(err), codes.NotFound; got != want {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+			}
+		}
+	})
+	// Test scenario(s) where the delete fails.
+	t.Run("Errors", func(t *testing.T) {
+		c := serveAndDial(ctx, t, seed(ctx, t))
+		for _, test := range []struct {
+			desc string
+			req  *pb.DeletePricingRuleRequest
+			want codes.Code
+		}{
+			{
+				desc: "EmptyName",
+				req:  &pb.DeletePricingRuleRequest{Name: ""},
+				want: codes.InvalidArgument,
+			},
+			{
+				desc: "NotFound",
+				req:  &pb.DeletePricingRuleRequest{Name: testresources.Bar_HappyHour.Name},
+				want: codes.NotFound,
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				_, err := c.DeletePricingRule(ctx, test.req)
+				if got := status.Code(err); got != test.want {
+					t.Errorf("status.Code(%v) = %v; want %v", err, got, test.want)
+				}
+			})
+		}
+	})
+}
@@ -20,7 +20,7 @@ func (s *Service) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*p
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	product, err := s.productRepo.LookupProduct(ctx, name)
@@ -28,7 +28,7 @@ func (s *Service) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*p
 		if notFound := new(products.NotFoundError); errors.As(err, &notFound) {
 			return nil, status.Error(codes.NotFound, notFound.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return product, nil
 }
@@ -39,7 +39,7 @@ func (s *Service) ListProducts(ctx context.Context, req *pb.ListProductsRequest)
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	if req.PageSize < 0 {
@@ -57,7 +57,7 @@ func (s *Service) ListProducts(ctx context.Context, req *pb.ListProductsRequest)
 	}
 	filtered, err := s.productRepo.FilterProducts(ctx, predicate)
 	if err != nil {
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return &pb.ListProductsResponse{
 		Products:      filtered,
@@ -71,7 +71,7 @@ func (s *Service) CreateProduct(ctx context.Context, req *pb.CreateProductReques
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	product := req.Product
@@ -80,7 +80,7 @@ func (s *Service) CreateProduct(ctx context.Context, req *pb.CreateProductReques
 		return nil, status.Errorf(codes.InvalidArgument, "invalid product: %v", err)
 	}
 	if err := s.productRepo.CreateProduct(ctx, product); err != nil {
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return product, nil
 }
@@ -106,6 +106,8 @@ func (s *Service) UpdateProduct(ctx context.Context, req *pb.UpdateProductReques
 				dst.FullPriceCents = src.FullPriceCents
 			case "discount_price_cents":
 				dst.DiscountPriceCents = src.DiscountPriceCents
+			case "deposit_cents":
+				dst.DepositCents = src.DepositCents
 			default:
 				return nil, status.Errorf(codes.Internal, "update not implemented for path %q", path)
 			}
@@ -115,7 +117,7 @@ func (s *Service) UpdateProduct(ctx context.Context, req *pb.UpdateProductReques
 		return nil, status.Errorf(codes.InvalidArgument, "invalid product: %v", err)
 	}
 	if err := s.productRepo.UpdateProduct(ctx, dst); err != nil {
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return dst, nil
 }
@@ -126,14 +128,14 @@ func (s *Service) DeleteProduct(ctx context.Context, req *pb.DeleteProductReques
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	if err := s.productRepo.DeleteProduct(ctx, req.Name); err != nil {
 		if notFound := new(products.NotFoundError); errors.As(err, &notFound) {
 			return nil, status.Error(codes.NotFound, notFound.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return new(emptypb.Empty), nil
 }
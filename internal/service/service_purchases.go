@@ -3,14 +3,23 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/authn"
+	"github.com/Saser/strecku/internal/pricing"
 	"github.com/Saser/strecku/resourcename"
 	"github.com/Saser/strecku/resources/stores"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"github.com/Saser/strecku/resources/stores/pricingrules"
+	"github.com/Saser/strecku/resources/stores/products"
 	"github.com/Saser/strecku/resources/stores/purchases"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *Service) GetPurchase(ctx context.Context, req *pb.GetPurchaseRequest) (*pb.Purchase, error) {
@@ -20,7 +29,7 @@ func (s *Service) GetPurchase(ctx context.Context, req *pb.GetPurchaseRequest) (
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	purchase, err := s.purchaseRepo.LookupPurchase(ctx, name)
@@ -28,7 +37,7 @@ func (s *Service) GetPurchase(ctx context.Context, req *pb.GetPurchaseRequest) (
 		if notFound := new(purchases.NotFoundError); errors.As(err, &notFound) {
 			return nil, status.Error(codes.NotFound, notFound.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return purchase, nil
 }
@@ -39,7 +48,7 @@ func (s *Service) ListPurchases(ctx context.Context, req *pb.ListPurchasesReques
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	if req.PageSize < 0 {
@@ -57,7 +66,7 @@ func (s *Service) ListPurchases(ctx context.Context, req *pb.ListPurchasesReques
 	}
 	filtered, err := s.purchaseRepo.FilterPurchases(ctx, predicate)
 	if err != nil {
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return &pb.ListPurchasesResponse{
 		Purchases:     filtered,
@@ -71,16 +80,49 @@ func (s *Service) CreatePurchase(ctx context.Context, req *pb.CreatePurchaseRequ
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	purchase := req.Purchase
 	purchase.Name = purchases.GenerateName(req.Parent)
+	purchase.CreateTime = timestamppb.Now()
+	purchase.State = pb.Purchase_ACTIVE
+	purchase.VoidTime = nil
+	purchase.Device = ""
+	if id, ok := authn.FromContext(ctx); ok {
+		purchase.Device = id.Device
+	}
+	for _, line := range purchase.Lines {
+		if purchases.LineKind(line) == pb.Purchase_Line_DEPOSIT {
+			return nil, status.Error(codes.InvalidArgument, "deposit lines are added automatically and cannot be created")
+		}
+		line.PricingRule = ""
+	}
 	if err := purchases.Validate(purchase); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid purchase: %v", err)
 	}
+	if purchase.Device != "" {
+		if err := s.priceDeviceLines(ctx, req.Parent, purchase); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkRefunds(ctx, purchase); err != nil {
+		return nil, err
+	}
+	if err := s.checkDepositReturns(ctx, purchase); err != nil {
+		return nil, err
+	}
+	priced, err := s.applyPricingRules(ctx, req.Parent, purchase)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	lines, err := s.addDeposits(ctx, priced)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	purchase.Lines = lines
 	if err := s.purchaseRepo.CreatePurchase(ctx, purchase); err != nil {
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return purchase, nil
 }
@@ -91,6 +133,14 @@ func (s *Service) UpdatePurchase(ctx context.Context, req *pb.UpdatePurchaseRequ
 	if err != nil {
 		return nil, err
 	}
+	if purchases.Voided(dst) {
+		return nil, status.Errorf(codes.FailedPrecondition, "purchase %q has been voided", dst.Name)
+	}
+	lines := dst.Lines
+	createTime := dst.CreateTime
+	state := dst.State
+	voidTime := dst.VoidTime
+	device := dst.Device
 	mask := req.UpdateMask
 	if mask == nil {
 		dst = src
@@ -102,22 +152,91 @@ func (s *Service) UpdatePurchase(ctx context.Context, req *pb.UpdatePurchaseRequ
 			switch path {
 			case "user":
 				return nil, status.Errorf(codes.InvalidArgument, `field "user" cannot be updated`)
+			case "create_time":
+				return nil, status.Errorf(codes.InvalidArgument, `field "create_time" cannot be updated`)
 			case "lines":
 				dst.Lines = src.Lines
+			case "state":
+				return nil, status.Errorf(codes.InvalidArgument, `field "state" cannot be updated`)
+			case "void_time":
+				return nil, status.Errorf(codes.InvalidArgument, `field "void_time" cannot be updated`)
+			case "device":
+				return nil, status.Errorf(codes.InvalidArgument, `field "device" cannot be updated`)
 			default:
 				return nil, status.Errorf(codes.InvalidArgument, "update not implemented for path %q", path)
 			}
 		}
 	}
+	dst.CreateTime = createTime
+	dst.State = state
+	dst.VoidTime = voidTime
+	dst.Device = device
+	// Changed lines are handled like the lines of a new purchase, while lines
+	// that are also in the stored purchase keep their prices and pricing
+	// rules. Deposit lines, such as those of a purchase that was read before
+	// being updated, are dropped and derived again.
+	linesChanged := !proto.Equal(&pb.Purchase{Lines: lines}, &pb.Purchase{Lines: dst.Lines})
+	var changed []bool
+	if linesChanged {
+		dst.Lines = withoutDeposits(dst.Lines)
+		changed = changedLines(withoutDeposits(lines), dst.Lines)
+		for i, line := range dst.Lines {
+			if changed[i] {
+				line.PricingRule = ""
+			}
+		}
+	}
 	if err := purchases.Validate(dst); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid purchase: %v", err)
 	}
+	if err := s.checkRefunds(ctx, dst); err != nil {
+		return nil, err
+	}
+	if linesChanged {
+		refunded, err := s.refundedQuantities(ctx, dst.Name, "")
+		if err != nil {
+			return nil, internalError(ctx, err)
+		}
+		if len(refunded) > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "the lines of purchase %q cannot be updated because it has been refunded", dst.Name)
+		}
+		if err := s.checkDepositReturns(ctx, dst); err != nil {
+			return nil, err
+		}
+		parent, err := purchases.Parent(dst.Name)
+		if err != nil {
+			return nil, internalError(ctx, err)
+		}
+		// Pricing rules are applied to changed lines as of when the purchase
+		// was created.
+		var priced []*pb.Purchase_Line
+		for i, line := range dst.Lines {
+			if !changed[i] {
+				priced = append(priced, line)
+				continue
+			}
+			linePriced, err := s.applyPricingRules(ctx, parent, &pb.Purchase{
+				User:       dst.User,
+				CreateTime: dst.CreateTime,
+				Lines:      []*pb.Purchase_Line{line},
+			})
+			if err != nil {
+				return nil, internalError(ctx, err)
+			}
+			priced = append(priced, linePriced...)
+		}
+		withDeposits, err := s.addDeposits(ctx, priced)
+		if err != nil {
+			return nil, internalError(ctx, err)
+		}
+		dst.Lines = withDeposits
+	}
 	if err := s.purchaseRepo.UpdatePurchase(ctx, dst); err != nil {
 		switch err {
 		case purchases.ErrUpdateUser:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update: %v", err)
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	return dst, nil
@@ -129,14 +248,293 @@ func (s *Service) DeletePurchase(ctx context.Context, req *pb.DeletePurchaseRequ
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	if err := s.purchaseRepo.DeletePurchase(ctx, req.Name); err != nil {
 		if notFound := new(purchases.NotFoundError); errors.As(err, &notFound) {
 			return nil, status.Error(codes.NotFound, notFound.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return new(emptypb.Empty), nil
 }
+
+func (s *Service) UndoPurchase(ctx context.Context, req *pb.UndoPurchaseRequest) (*pb.Purchase, error) {
+	purchase, err := s.GetPurchase(ctx, &pb.GetPurchaseRequest{Name: req.Purchase})
+	if err != nil {
+		return nil, err
+	}
+	// Only the buyer and the device the purchase was made from can undo it.
+	// Kiosk tokens issued by IdentifyCard carry both, and must match both.
+	if id, ok := authn.FromContext(ctx); ok {
+		if id.User != "" && id.User != purchase.User {
+			return nil, status.Error(codes.PermissionDenied, "purchases can only be undone by the user who made them")
+		}
+		if id.Device != "" && id.Device != purchase.Device {
+			return nil, status.Error(codes.PermissionDenied, "purchases can only be undone by the device they were made from")
+		}
+	}
+	if purchases.Voided(purchase) {
+		return nil, status.Errorf(codes.FailedPrecondition, "purchase %q has already been voided", purchase.Name)
+	}
+	refunded, err := s.refundedQuantities(ctx, purchase.Name, "")
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	if len(refunded) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "purchase %q has been refunded", purchase.Name)
+	}
+	parent, err := purchases.Parent(purchase.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	store, err := s.storeRepo.Lookup(ctx, parent)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	window := stores.PurchaseUndoWindow(store)
+	if now := time.Now(); now.Sub(purchase.CreateTime.AsTime()) > window {
+		return nil, status.Errorf(codes.FailedPrecondition, "purchase %q can no longer be undone; purchases in %q can only be undone within %v", purchase.Name, parent, window)
+	}
+	voided := purchases.Clone(purchase)
+	voided.State = pb.Purchase_VOIDED
+	voided.VoidTime = timestamppb.Now()
+	if err := s.purchaseRepo.UpdatePurchase(ctx, voided); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return voided, nil
+}
+
+// changedLines reports, for each line in updated, whether it is not one of the
+// lines in stored. Each line in stored is matched at most once.
+func changedLines(stored, updated []*pb.Purchase_Line) []bool {
+	unmatched := make([]*pb.Purchase_Line, len(stored))
+	copy(unmatched, stored)
+	changed := make([]bool, len(updated))
+	for i, line := range updated {
+		changed[i] = true
+		for j, other := range unmatched {
+			if other != nil && proto.Equal(line, other) {
+				changed[i] = false
+				unmatched[j] = nil
+				break
+			}
+		}
+	}
+	return changed
+}
+
+// withoutDeposits returns lines without its deposit lines.
+func withoutDeposits(lines []*pb.Purchase_Line) []*pb.Purchase_Line {
+	var filtered []*pb.Purchase_Line
+	for _, line := range lines {
+		if purchases.LineKind(line) != pb.Purchase_Line_DEPOSIT {
+			filtered = append(filtered, line)
+		}
+	}
+	return filtered
+}
+
+// addDeposits returns lines with a deposit line added after each charge line
+// whose product has a deposit. Products that no longer exist have no deposit.
+func (s *Service) addDeposits(ctx context.Context, lines []*pb.Purchase_Line) ([]*pb.Purchase_Line, error) {
+	withDeposits := make([]*pb.Purchase_Line, 0, len(lines))
+	for _, line := range lines {
+		withDeposits = append(withDeposits, line)
+		if purchases.LineKind(line) != pb.Purchase_Line_CHARGE || line.Product == "" {
+			continue
+		}
+		product, err := s.productRepo.LookupProduct(ctx, line.Product)
+		if err != nil {
+			if notFound := new(products.NotFoundError); errors.As(err, &notFound) {
+				continue
+			}
+			return nil, err
+		}
+		if product.DepositCents == 0 {
+			continue
+		}
+		withDeposits = append(withDeposits, &pb.Purchase_Line{
+			Description: fmt.Sprintf("Deposit: %s", product.DisplayName),
+			Quantity:    line.Quantity,
+			PriceCents:  product.DepositCents,
+			Product:     product.Name,
+			Kind:        pb.Purchase_Line_DEPOSIT,
+		})
+	}
+	return withDeposits, nil
+}
+
+// checkDepositReturns fails unless each deposit return line of purchase
+// refers to an existing product with a deposit, and credits exactly that
+// deposit for each unit.
+func (s *Service) checkDepositReturns(ctx context.Context, purchase *pb.Purchase) error {
+	for _, line := range purchase.Lines {
+		if purchases.LineKind(line) != pb.Purchase_Line_DEPOSIT_RETURN {
+			continue
+		}
+		product, err := s.productRepo.LookupProduct(ctx, line.Product)
+		if err != nil {
+			if notFound := new(products.NotFoundError); errors.As(err, &notFound) {
+				return status.Errorf(codes.InvalidArgument, "invalid deposit return: %v", notFound)
+			}
+			return internalError(ctx, err)
+		}
+		if product.DepositCents == 0 {
+			return status.Errorf(codes.InvalidArgument, "invalid deposit return: product %q has no deposit", product.Name)
+		}
+		if line.PriceCents != -product.DepositCents {
+			return status.Errorf(codes.InvalidArgument, "invalid deposit return: price %d does not match the deposit %d of product %q", line.PriceCents, product.DepositCents, product.Name)
+		}
+	}
+	return nil
+}
+
+// priceDeviceLines sets the price of each charge in purchase, which is being
+// created by a device, to the price of its product. Devices are not trusted
+// with prices, so they cannot refund purchases or charge for anything but
+// products.
+func (s *Service) priceDeviceLines(ctx context.Context, store string, purchase *pb.Purchase) error {
+	membership, err := s.membershipRepo.LookupMembershipIn(ctx, store, purchase.User)
+	if err != nil {
+		if notFound := new(memberships.NotFoundError); !errors.As(err, &notFound) {
+			return internalError(ctx, err)
+		}
+		membership = nil
+	}
+	for _, line := range purchase.Lines {
+		switch purchases.LineKind(line) {
+		case pb.Purchase_Line_CHARGE:
+		case pb.Purchase_Line_REFUND:
+			return status.Error(codes.PermissionDenied, "devices cannot refund purchases")
+		default:
+			continue
+		}
+		if line.Product == "" {
+			return status.Error(codes.InvalidArgument, "invalid purchase: charges created by devices must have a product")
+		}
+		product, err := s.productRepo.LookupProduct(ctx, line.Product)
+		if err != nil {
+			if notFound := new(products.NotFoundError); errors.As(err, &notFound) {
+				return status.Errorf(codes.InvalidArgument, "invalid purchase: %v", notFound)
+			}
+			return internalError(ctx, err)
+		}
+		line.PriceCents = product.FullPriceCents
+		if membership.GetDiscount() {
+			line.PriceCents = product.DiscountPriceCents
+		}
+	}
+	return nil
+}
+
+// applyPricingRules returns the lines of purchase with the pricing rules of
+// store applied to them, as of the time the purchase was created.
+func (s *Service) applyPricingRules(ctx context.Context, store string, purchase *pb.Purchase) ([]*pb.Purchase_Line, error) {
+	rules, err := s.pricingRuleRepo.FilterPricingRules(ctx, func(rule *pb.PricingRule) bool {
+		parent, err := pricingrules.Parent(rule.Name)
+		return err == nil && parent == store
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return purchase.Lines, nil
+	}
+	membership, err := s.membershipRepo.LookupMembershipIn(ctx, store, purchase.User)
+	if err != nil {
+		if notFound := new(memberships.NotFoundError); !errors.As(err, &notFound) {
+			return nil, err
+		}
+		membership = nil
+	}
+	return pricing.Apply(rules, membership, purchase.CreateTime.AsTime(), purchase.Lines), nil
+}
+
+// refundKey identifies a line in a purchase.
+type refundKey struct {
+	purchase string
+	line     int32
+}
+
+// checkRefunds fails unless each refund line of purchase refers to a charge
+// line of another active purchase by the same user, has the negated price of
+// that line, and does not refund more than was bought together with earlier
+// refunds. The product of each refund line is set to that of the refunded
+// line.
+func (s *Service) checkRefunds(ctx context.Context, purchase *pb.Purchase) error {
+	requested := make(map[refundKey]int64)
+	originals := make(map[string]*pb.Purchase)
+	for _, line := range purchase.Lines {
+		if purchases.LineKind(line) != pb.Purchase_Line_REFUND {
+			continue
+		}
+		original, ok := originals[line.RefundedPurchase]
+		if !ok {
+			var err error
+			original, err = s.purchaseRepo.LookupPurchase(ctx, line.RefundedPurchase)
+			if err != nil {
+				if notFound := new(purchases.NotFoundError); errors.As(err, &notFound) {
+					return status.Errorf(codes.InvalidArgument, "invalid refund: %v", notFound)
+				}
+				return internalError(ctx, err)
+			}
+			originals[line.RefundedPurchase] = original
+		}
+		if original.User != purchase.User {
+			return status.Errorf(codes.InvalidArgument, "invalid refund: purchase %q was made by another user", original.Name)
+		}
+		if purchases.Voided(original) {
+			return status.Errorf(codes.FailedPrecondition, "purchase %q has been voided and cannot be refunded", original.Name)
+		}
+		if int(line.RefundedLine) >= len(original.Lines) {
+			return status.Errorf(codes.InvalidArgument, "invalid refund: purchase %q has no line %d", original.Name, line.RefundedLine)
+		}
+		refunded := original.Lines[line.RefundedLine]
+		if purchases.LineKind(refunded) != pb.Purchase_Line_CHARGE {
+			return status.Errorf(codes.InvalidArgument, "invalid refund: line %d of purchase %q is not a charge", line.RefundedLine, original.Name)
+		}
+		if line.PriceCents != -refunded.PriceCents {
+			return status.Errorf(codes.InvalidArgument, "invalid refund: price %d does not match the price %d of line %d of purchase %q", line.PriceCents, refunded.PriceCents, line.RefundedLine, original.Name)
+		}
+		line.Product = refunded.Product
+		requested[refundKey{purchase: original.Name, line: line.RefundedLine}] += int64(line.Quantity)
+	}
+	for name, original := range originals {
+		earlier, err := s.refundedQuantities(ctx, name, purchase.Name)
+		if err != nil {
+			return internalError(ctx, err)
+		}
+		for i, line := range original.Lines {
+			quantity, ok := requested[refundKey{purchase: name, line: int32(i)}]
+			if !ok {
+				continue
+			}
+			if remaining := int64(line.Quantity) - earlier[int32(i)]; quantity > remaining {
+				return status.Errorf(codes.FailedPrecondition, "cannot refund %d of line %d of purchase %q; only %d remain", quantity, i, name, remaining)
+			}
+		}
+	}
+	return nil
+}
+
+// refundedQuantities returns the quantities refunded of each line of the named
+// purchase by active purchases other than the one named except.
+func (s *Service) refundedQuantities(ctx context.Context, name string, except string) (map[int32]int64, error) {
+	refunds, err := s.purchaseRepo.FilterPurchases(ctx, func(purchase *pb.Purchase) bool {
+		return purchase.Name != except && !purchases.Voided(purchase)
+	})
+	if err != nil {
+		return nil, err
+	}
+	refunded := make(map[int32]int64)
+	for _, refund := range refunds {
+		for _, line := range refund.Lines {
+			if purchases.LineKind(line) == pb.Purchase_Line_REFUND && line.RefundedPurchase == name {
+				refunded[line.RefundedLine] += int64(line.Quantity)
+			}
+		}
+	}
+	return refunded, nil
+}
@@ -3,15 +3,23 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/authn"
+	"github.com/Saser/strecku/resources/stores"
+	"github.com/Saser/strecku/resources/stores/devices"
+	"github.com/Saser/strecku/resources/stores/memberships"
 	"github.com/Saser/strecku/resources/stores/purchases"
 	"github.com/Saser/strecku/testresources"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func purchaseLess(u1, u2 *pb.Purchase) bool {
@@ -171,8 +179,12 @@ func TestService_CreatePurchase(t *testing.T) {
 				Parent:   testresources.Bar.Name,
 				Purchase: testresources.Bar_Alice_Cocktail1,
 			},
-			wantPurchase: testresources.Bar_Alice_Cocktail1,
-			wantCode:     codes.OK,
+			wantPurchase: func() *pb.Purchase {
+				purchase := purchases.Clone(testresources.Bar_Alice_Cocktail1)
+				purchase.State = pb.Purchase_ACTIVE
+				return purchase
+			}(),
+			wantCode: codes.OK,
 		},
 	} {
 		t.Run(test.desc, func(t *testing.T) {
@@ -180,10 +192,13 @@ func TestService_CreatePurchase(t *testing.T) {
 			purchase, err := c.CreatePurchase(ctx, test.req)
 			if diff := cmp.Diff(
 				purchase, test.wantPurchase, protocmp.Transform(),
-				protocmp.IgnoreFields(new(pb.Purchase), "name"),
+				protocmp.IgnoreFields(new(pb.Purchase), "name", "create_time"),
 			); diff != "" {
 				t.Errorf("c.CreatePurchase(%v, %v) purchase != test.wantPurchase (-got +want)\n%s", ctx, test.req, diff)
 			}
+			if err == nil && purchase.CreateTime == nil {
+				t.Errorf("c.CreatePurchase(%v, %v) create_time = nil; want non-nil", ctx, test.req)
+			}
 			if got := status.Code(err); got != test.wantCode {
 				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
 			}
@@ -209,6 +224,25 @@ func TestService_UpdatePurchase(t *testing.T) {
 			PriceCents:  testresources.Beer.FullPriceCents,
 			Product:     testresources.Beer.Name,
 		})
+		// The seeded pricing rule takes half off every second cocktail, so the
+		// updated line of two cocktails is split.
+		updatedPurchase := purchases.Clone(newPurchase)
+		updatedPurchase.Lines = []*pb.Purchase_Line{
+			{
+				Description: testresources.Cocktail.DisplayName,
+				Quantity:    1,
+				PriceCents:  testresources.Cocktail.FullPriceCents,
+				Product:     testresources.Cocktail.Name,
+			},
+			{
+				Description: testresources.Cocktail.DisplayName + " (" + testresources.Bar_SecondCocktailHalfPrice.DisplayName + ")",
+				Quantity:    1,
+				PriceCents:  testresources.Cocktail.FullPriceCents / 2,
+				Product:     testresources.Cocktail.Name,
+				PricingRule: testresources.Bar_SecondCocktailHalfPrice.Name,
+			},
+			newPurchase.Lines[1],
+		}
 		for _, test := range []struct {
 			desc string
 			req  *pb.UpdatePurchaseRequest
@@ -244,7 +278,7 @@ func TestService_UpdatePurchase(t *testing.T) {
 					Purchase:   newPurchase,
 					UpdateMask: nil,
 				},
-				want: newPurchase,
+				want: updatedPurchase,
 			},
 			{
 				desc: "FullUpdate_AllPaths",
@@ -252,7 +286,7 @@ func TestService_UpdatePurchase(t *testing.T) {
 					Purchase:   newPurchase,
 					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"lines"}},
 				},
-				want: newPurchase,
+				want: updatedPurchase,
 			},
 		} {
 			t.Run(test.desc, func(t *testing.T) {
@@ -362,6 +396,18 @@ func TestService_UpdatePurchase(t *testing.T) {
 				},
 				want: codes.InvalidArgument,
 			},
+			{
+				desc: "UpdateState",
+				req: &pb.UpdatePurchaseRequest{
+					Purchase: func() *pb.Purchase {
+						purchase := purchases.Clone(testresources.Bar_Alice_Beer1)
+						purchase.State = pb.Purchase_VOIDED
+						return purchase
+					}(),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+				},
+				want: codes.InvalidArgument,
+			},
 		} {
 			t.Run(test.desc, func(t *testing.T) {
 				c := serveAndDial(ctx, t, seed(ctx, t))
@@ -422,3 +468,798 @@ func TestService_DeletePurchase(t *testing.T) {
 		}
 	})
 }
+
+func TestService_UndoPurchase(t *testing.T) {
+	ctx := context.Background()
+	alice := asUser(ctx, testresources.Alice.Name)
+	bob := asUser(ctx, testresources.Bob.Name)
+	kiosk := asDevice(ctx, testresources.Bar_Kiosk)
+	member := authn.NewContext(ctx, authn.Identity{
+		Name:   testresources.Bar_Kiosk.Name,
+		User:   testresources.Alice.Name,
+		Device: testresources.Bar_Kiosk.Name,
+		Scopes: []string{devices.ScopePurchasesCreate},
+	})
+	create := func(ctx context.Context, t *testing.T, svc *Service) *pb.Purchase {
+		t.Helper()
+		req := &pb.CreatePurchaseRequest{
+			Parent:   testresources.Bar.Name,
+			Purchase: purchases.Clone(testresources.Bar_Alice_Beer1),
+		}
+		purchase, err := svc.CreatePurchase(ctx, req)
+		if err != nil {
+			t.Fatalf("svc.CreatePurchase(ctx, %v) err = %v; want nil", req, err)
+		}
+		return purchase
+	}
+
+	for _, test := range []struct {
+		desc      string
+		createCtx context.Context
+		undoCtx   context.Context
+	}{
+		{desc: "User", createCtx: alice, undoCtx: alice},
+		{desc: "Device", createCtx: kiosk, undoCtx: kiosk},
+		{desc: "Member", createCtx: member, undoCtx: member},
+		{desc: "DeviceUndoesMemberPurchase", createCtx: member, undoCtx: kiosk},
+		{desc: "UserUndoesKioskPurchase", createCtx: kiosk, undoCtx: alice},
+		{desc: "Anonymous", createCtx: ctx, undoCtx: ctx},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			svc := seed(ctx, t)
+			purchase := create(test.createCtx, t, svc)
+			req := &pb.UndoPurchaseRequest{Purchase: purchase.Name}
+			voided, err := svc.UndoPurchase(test.undoCtx, req)
+			if err != nil {
+				t.Fatalf("svc.UndoPurchase(ctx, %v) err = %v; want nil", req, err)
+			}
+			want := purchases.Clone(purchase)
+			want.State = pb.Purchase_VOIDED
+			if diff := cmp.Diff(
+				voided, want, protocmp.Transform(),
+				protocmp.IgnoreFields(new(pb.Purchase), "void_time"),
+			); diff != "" {
+				t.Errorf("svc.UndoPurchase(ctx, %v) purchase != want (-got +want)\n%s", req, diff)
+			}
+			if voided.VoidTime == nil {
+				t.Errorf("svc.UndoPurchase(ctx, %v) void_time = nil; want non-nil", req)
+			}
+			// The purchase is kept, but cannot be undone or updated again.
+			got, err := svc.GetPurchase(ctx, &pb.GetPurchaseRequest{Name: purchase.Name})
+			if err != nil {
+				t.Fatalf("svc.GetPurchase(ctx, %q) err = %v; want nil", purchase.Name, err)
+			}
+			if diff := cmp.Diff(got, voided, protocmp.Transform()); diff != "" {
+				t.Errorf("svc.GetPurchase(ctx, %q) purchase != voided (-got +want)\n%s", purchase.Name, diff)
+			}
+			_, err = svc.UndoPurchase(test.undoCtx, req)
+			if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+				t.Errorf("second UndoPurchase: status.Code(%v) = %v; want %v", err, got, want)
+			}
+			_, err = svc.UpdatePurchase(ctx, &pb.UpdatePurchaseRequest{Purchase: voided})
+			if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+				t.Errorf("UpdatePurchase of voided purchase: status.Code(%v) = %v; want %v", err, got, want)
+			}
+		})
+	}
+
+	for _, test := range []struct {
+		desc      string
+		createCtx context.Context
+		undoCtx   context.Context
+		wantCode  codes.Code
+	}{
+		{desc: "OtherUser", createCtx: alice, undoCtx: bob, wantCode: codes.PermissionDenied},
+		{desc: "OtherDevice", createCtx: kiosk, undoCtx: asDevice(ctx, testresources.Mall_Kiosk), wantCode: codes.PermissionDenied},
+		{desc: "DeviceUndoesUserPurchase", createCtx: alice, undoCtx: kiosk, wantCode: codes.PermissionDenied},
+		{
+			desc:      "OtherMember",
+			createCtx: member,
+			undoCtx: authn.NewContext(ctx, authn.Identity{
+				Name:   testresources.Bar_Kiosk.Name,
+				User:   testresources.Bob.Name,
+				Device: testresources.Bar_Kiosk.Name,
+				Scopes: []string{devices.ScopePurchasesCreate},
+			}),
+			wantCode: codes.PermissionDenied,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			svc := seed(ctx, t)
+			purchase := create(test.createCtx, t, svc)
+			_, err := svc.UndoPurchase(test.undoCtx, &pb.UndoPurchaseRequest{Purchase: purchase.Name})
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+
+	t.Run("WindowExpired", func(t *testing.T) {
+		svc := seed(ctx, t)
+		req := &pb.UndoPurchaseRequest{Purchase: testresources.Bar_Alice_Beer1.Name}
+		_, err := svc.UndoPurchase(alice, req)
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+		}
+	})
+
+	t.Run("WindowDisabled", func(t *testing.T) {
+		svc := seed(ctx, t)
+		store := stores.Clone(testresources.Bar)
+		store.PurchaseUndoWindow = durationpb.New(0)
+		if _, err := svc.UpdateStore(ctx, &pb.UpdateStoreRequest{
+			Store:      store,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"purchase_undo_window"}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		purchase := create(alice, t, svc)
+		_, err := svc.UndoPurchase(alice, &pb.UndoPurchaseRequest{Purchase: purchase.Name})
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+		}
+	})
+
+	for _, test := range []struct {
+		desc     string
+		req      *pb.UndoPurchaseRequest
+		wantCode codes.Code
+	}{
+		{
+			desc:     "EmptyName",
+			req:      &pb.UndoPurchaseRequest{Purchase: ""},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "NotFound",
+			req:      &pb.UndoPurchaseRequest{Purchase: testresources.Bar_Alice_Cocktail1.Name},
+			wantCode: codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			c := serveAndDial(ctx, t, seed(ctx, t))
+			_, err := c.UndoPurchase(ctx, test.req)
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_CreatePurchase_Deposits(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	if err := svc.productRepo.CreateProduct(ctx, testresources.Soda); err != nil {
+		t.Fatal(err)
+	}
+	soda := &pb.Purchase_Line{
+		Description: testresources.Soda.DisplayName,
+		Quantity:    3,
+		PriceCents:  testresources.Soda.FullPriceCents,
+		Product:     testresources.Soda.Name,
+	}
+	beer := &pb.Purchase_Line{
+		Description: testresources.Beer.DisplayName,
+		Quantity:    1,
+		PriceCents:  testresources.Beer.FullPriceCents,
+		Product:     testresources.Beer.Name,
+	}
+	req := &pb.CreatePurchaseRequest{
+		Parent: testresources.Bar.Name,
+		Purchase: &pb.Purchase{
+			User:  testresources.Alice.Name,
+			Lines: []*pb.Purchase_Line{soda, beer},
+		},
+	}
+	purchase, err := svc.CreatePurchase(ctx, req)
+	if err != nil {
+		t.Fatalf("svc.CreatePurchase(ctx, %v) err = %v; want nil", req, err)
+	}
+	deposit := &pb.Purchase_Line{
+		Description: "Deposit: " + testresources.Soda.DisplayName,
+		Quantity:    3,
+		PriceCents:  testresources.Soda.DepositCents,
+		Product:     testresources.Soda.Name,
+		Kind:        pb.Purchase_Line_DEPOSIT,
+	}
+	want := []*pb.Purchase_Line{soda, deposit, beer}
+	if diff := cmp.Diff(purchase.Lines, want, protocmp.Transform()); diff != "" {
+		t.Errorf("purchase.Lines != want (-got +want)\n%s", diff)
+	}
+
+	// Deposit lines cannot be created by clients.
+	req = &pb.CreatePurchaseRequest{
+		Parent: testresources.Bar.Name,
+		Purchase: &pb.Purchase{
+			User:  testresources.Alice.Name,
+			Lines: []*pb.Purchase_Line{deposit},
+		},
+	}
+	_, err = svc.CreatePurchase(ctx, req)
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+	}
+}
+
+func TestService_CreatePurchase_Device(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	kiosk := asDevice(ctx, testresources.Bar_Kiosk)
+	discount := memberships.Clone(testresources.Bar_Bob)
+	discount.Discount = true
+	if err := svc.membershipRepo.UpdateMembership(ctx, discount); err != nil {
+		t.Fatal(err)
+	}
+	beer := func(priceCents int64) *pb.Purchase_Line {
+		return &pb.Purchase_Line{
+			Description: testresources.Beer.DisplayName,
+			Quantity:    1,
+			PriceCents:  priceCents,
+			Product:     testresources.Beer.Name,
+		}
+	}
+	create := func(user string, lines ...*pb.Purchase_Line) *pb.CreatePurchaseRequest {
+		return &pb.CreatePurchaseRequest{
+			Parent: testresources.Bar.Name,
+			Purchase: &pb.Purchase{
+				User:  user,
+				Lines: lines,
+			},
+		}
+	}
+
+	// Devices cannot set the prices of charges, which are taken from the
+	// products instead.
+	for _, test := range []struct {
+		desc string
+		user string
+		want int64
+	}{
+		{desc: "FullPrice", user: testresources.Alice.Name, want: testresources.Beer.FullPriceCents},
+		{desc: "DiscountPrice", user: testresources.Bob.Name, want: testresources.Beer.DiscountPriceCents},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			req := create(test.user, beer(-1))
+			purchase, err := svc.CreatePurchase(kiosk, req)
+			if err != nil {
+				t.Fatalf("svc.CreatePurchase(kiosk, %v) err = %v; want nil", req, err)
+			}
+			want := []*pb.Purchase_Line{beer(test.want)}
+			if diff := cmp.Diff(purchase.Lines, want, protocmp.Transform()); diff != "" {
+				t.Errorf("purchase.Lines != want (-got +want)\n%s", diff)
+			}
+		})
+	}
+
+	for _, test := range []struct {
+		desc string
+		req  *pb.CreatePurchaseRequest
+		want codes.Code
+	}{
+		{
+			desc: "NoProduct",
+			req: create(testresources.Alice.Name, &pb.Purchase_Line{
+				Description: "Something",
+				Quantity:    1,
+				PriceCents:  -1,
+			}),
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "Refund",
+			req: create(testresources.Alice.Name, &pb.Purchase_Line{
+				Description:      "Refund",
+				Quantity:         1,
+				PriceCents:       -testresources.Beer.FullPriceCents,
+				Kind:             pb.Purchase_Line_REFUND,
+				RefundedPurchase: testresources.Bar_Alice_Beer1.Name,
+			}),
+			want: codes.PermissionDenied,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := svc.CreatePurchase(kiosk, test.req)
+			if got := status.Code(err); got != test.want {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.want)
+			}
+		})
+	}
+}
+
+func TestService_UpdatePurchase_Deposits(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	if err := svc.productRepo.CreateProduct(ctx, testresources.Soda); err != nil {
+		t.Fatal(err)
+	}
+	soda := func(quantity int32) *pb.Purchase_Line {
+		return &pb.Purchase_Line{
+			Description: testresources.Soda.DisplayName,
+			Quantity:    quantity,
+			PriceCents:  testresources.Soda.FullPriceCents,
+			Product:     testresources.Soda.Name,
+		}
+	}
+	deposit := func(quantity int32, priceCents int64) *pb.Purchase_Line {
+		return &pb.Purchase_Line{
+			Description: "Deposit: " + testresources.Soda.DisplayName,
+			Quantity:    quantity,
+			PriceCents:  priceCents,
+			Product:     testresources.Soda.Name,
+			Kind:        pb.Purchase_Line_DEPOSIT,
+		}
+	}
+	purchase, err := svc.CreatePurchase(ctx, &pb.CreatePurchaseRequest{
+		Parent: testresources.Bar.Name,
+		Purchase: &pb.Purchase{
+			User:  testresources.Alice.Name,
+			Lines: []*pb.Purchase_Line{soda(3)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := func(lines ...*pb.Purchase_Line) *pb.UpdatePurchaseRequest {
+		updated := purchases.Clone(purchase)
+		updated.Lines = lines
+		return &pb.UpdatePurchaseRequest{
+			Purchase:   updated,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"lines"}},
+		}
+	}
+
+	// Unchanged lines keep their deposit lines.
+	req := update(purchase.Lines...)
+	updated, err := svc.UpdatePurchase(ctx, req)
+	if err != nil {
+		t.Fatalf("svc.UpdatePurchase(ctx, %v) err = %v; want nil", req, err)
+	}
+	if diff := cmp.Diff(updated.Lines, purchase.Lines, protocmp.Transform()); diff != "" {
+		t.Errorf("updated.Lines != purchase.Lines (-got +want)\n%s", diff)
+	}
+
+	// Deposit lines are derived again from changed lines.
+	req = update(soda(2))
+	updated, err = svc.UpdatePurchase(ctx, req)
+	if err != nil {
+		t.Fatalf("svc.UpdatePurchase(ctx, %v) err = %v; want nil", req, err)
+	}
+	want := []*pb.Purchase_Line{soda(2), deposit(2, testresources.Soda.DepositCents)}
+	if diff := cmp.Diff(updated.Lines, want, protocmp.Transform()); diff != "" {
+		t.Errorf("updated.Lines != want (-got +want)\n%s", diff)
+	}
+	purchase = updated
+
+	// A purchase that was read, had a line added, and was written back keeps
+	// its deposit lines without duplicating them.
+	beer := &pb.Purchase_Line{
+		Description: testresources.Beer.DisplayName,
+		Quantity:    1,
+		PriceCents:  testresources.Beer.FullPriceCents,
+		Product:     testresources.Beer.Name,
+	}
+	read, err := svc.GetPurchase(ctx, &pb.GetPurchaseRequest{Name: purchase.Name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = update(append(read.Lines, beer)...)
+	updated, err = svc.UpdatePurchase(ctx, req)
+	if err != nil {
+		t.Fatalf("svc.UpdatePurchase(ctx, %v) err = %v; want nil", req, err)
+	}
+	want = []*pb.Purchase_Line{soda(2), deposit(2, testresources.Soda.DepositCents), beer}
+	if diff := cmp.Diff(updated.Lines, want, protocmp.Transform()); diff != "" {
+		t.Errorf("updated.Lines != want (-got +want)\n%s", diff)
+	}
+
+	// Deposit lines sent by clients are ignored.
+	req = update(soda(5), deposit(5, 0))
+	updated, err = svc.UpdatePurchase(ctx, req)
+	if err != nil {
+		t.Fatalf("svc.UpdatePurchase(ctx, %v) err = %v; want nil", req, err)
+	}
+	want = []*pb.Purchase_Line{soda(5), deposit(5, testresources.Soda.DepositCents)}
+	if diff := cmp.Diff(updated.Lines, want, protocmp.Transform()); diff != "" {
+		t.Errorf("updated.Lines != want (-got +want)\n%s", diff)
+	}
+
+	req = update(soda(2), &pb.Purchase_Line{
+		Description: "Bottles",
+		Quantity:    1,
+		PriceCents:  100000,
+		Product:     testresources.Soda.Name,
+		Kind:        pb.Purchase_Line_DEPOSIT_RETURN,
+	})
+	_, err = svc.UpdatePurchase(ctx, req)
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+	}
+}
+
+func TestService_UpdatePurchase_PricingRules(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	// The purchase was made during the January jeans sale, which has since
+	// ended.
+	purchase := purchases.Clone(testresources.Mall_Alice_Jeans1)
+	purchase.Name = purchases.GenerateName(testresources.Mall.Name)
+	purchase.CreateTime = timestamppb.New(time.Date(2021, time.January, 15, 12, 0, 0, 0, time.UTC))
+	if err := svc.purchaseRepo.CreatePurchase(ctx, purchase); err != nil {
+		t.Fatal(err)
+	}
+	jeans := func(quantity int32, rule string) *pb.Purchase_Line {
+		return &pb.Purchase_Line{
+			Description: testresources.Jeans.DisplayName,
+			Quantity:    quantity,
+			PriceCents:  testresources.Jeans.FullPriceCents,
+			Product:     testresources.Jeans.Name,
+			PricingRule: rule,
+		}
+	}
+	onSale := &pb.Purchase_Line{
+		Description: testresources.Jeans.DisplayName + " (" + testresources.Mall_JeansSale.DisplayName + ")",
+		Quantity:    2,
+		PriceCents:  testresources.Jeans.FullPriceCents * 70 / 100,
+		Product:     testresources.Jeans.Name,
+		PricingRule: testresources.Mall_JeansSale.Name,
+	}
+	// The cases update the same purchase in order.
+	for _, test := range []struct {
+		desc  string
+		lines []*pb.Purchase_Line
+		want  []*pb.Purchase_Line
+	}{
+		{
+			// Rules set by clients are ignored.
+			desc:  "ForgedRule",
+			lines: []*pb.Purchase_Line{jeans(1, testresources.Mall_JeansSale.Name)},
+			want:  []*pb.Purchase_Line{jeans(1, "")},
+		},
+		{
+			// Rules are applied as of the create time of the purchase.
+			desc:  "CreateTime",
+			lines: []*pb.Purchase_Line{jeans(2, "")},
+			want:  []*pb.Purchase_Line{onSale},
+		},
+		{
+			// Lines that are sent back unchanged keep their prices and rules,
+			// while new lines are priced.
+			desc:  "UnchangedLine",
+			lines: []*pb.Purchase_Line{onSale, jeans(2, "")},
+			want:  []*pb.Purchase_Line{onSale, onSale},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			updated := purchases.Clone(purchase)
+			updated.Lines = test.lines
+			req := &pb.UpdatePurchaseRequest{
+				Purchase:   updated,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"lines"}},
+			}
+			got, err := svc.UpdatePurchase(ctx, req)
+			if err != nil {
+				t.Fatalf("svc.UpdatePurchase(ctx, %v) err = %v; want nil", req, err)
+			}
+			if diff := cmp.Diff(got.Lines, test.want, protocmp.Transform()); diff != "" {
+				t.Errorf("got.Lines != test.want (-got +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestService_CreatePurchase_DepositReturns(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	if err := svc.productRepo.CreateProduct(ctx, testresources.Soda); err != nil {
+		t.Fatal(err)
+	}
+	bottles := func(product string, priceCents int64) *pb.CreatePurchaseRequest {
+		return &pb.CreatePurchaseRequest{
+			Parent: testresources.Bar.Name,
+			Purchase: &pb.Purchase{
+				User: testresources.Alice.Name,
+				Lines: []*pb.Purchase_Line{
+					{
+						Description: "Bottles",
+						Quantity:    5,
+						PriceCents:  priceCents,
+						Product:     product,
+						Kind:        pb.Purchase_Line_DEPOSIT_RETURN,
+					},
+				},
+			},
+		}
+	}
+	req := bottles(testresources.Soda.Name, -testresources.Soda.DepositCents)
+	if _, err := svc.CreatePurchase(ctx, req); err != nil {
+		t.Errorf("svc.CreatePurchase(ctx, %v) err = %v; want nil", req, err)
+	}
+	for _, test := range []struct {
+		desc string
+		req  *pb.CreatePurchaseRequest
+	}{
+		{desc: "NoProduct", req: bottles("", -testresources.Soda.DepositCents)},
+		{desc: "WrongPrice", req: bottles(testresources.Soda.Name, 100000)},
+		{desc: "NoDeposit", req: bottles(testresources.Beer.Name, 100)},
+		{desc: "ProductNotFound", req: bottles(testresources.Cocktail.Name, 100)},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := svc.CreatePurchase(ctx, test.req)
+			if got, want := status.Code(err), codes.InvalidArgument; got != want {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+			}
+		})
+	}
+}
+
+func TestService_CreatePurchase_PricingRules(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	if err := svc.productRepo.CreateProduct(ctx, testresources.Cocktail); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.pricingRuleRepo.CreatePricingRule(ctx, testresources.Mall_StaffDiscount); err != nil {
+		t.Fatal(err)
+	}
+
+	// Rules set by clients are ignored, and the line is split when the rule
+	// applies to only some units.
+	req := &pb.CreatePurchaseRequest{
+		Parent: testresources.Bar.Name,
+		Purchase: &pb.Purchase{
+			User: testresources.Alice.Name,
+			Lines: []*pb.Purchase_Line{
+				{
+					Description: testresources.Cocktail.DisplayName,
+					Quantity:    3,
+					PriceCents:  testresources.Cocktail.FullPriceCents,
+					Product:     testresources.Cocktail.Name,
+					PricingRule: testresources.Bar_HappyHour.Name,
+				},
+			},
+		},
+	}
+	purchase, err := svc.CreatePurchase(ctx, req)
+	if err != nil {
+		t.Fatalf("svc.CreatePurchase(ctx, %v) err = %v; want nil", req, err)
+	}
+	want := []*pb.Purchase_Line{
+		{
+			Description: testresources.Cocktail.DisplayName,
+			Quantity:    2,
+			PriceCents:  testresources.Cocktail.FullPriceCents,
+			Product:     testresources.Cocktail.Name,
+		},
+		{
+			Description: testresources.Cocktail.DisplayName + " (" + testresources.Bar_SecondCocktailHalfPrice.DisplayName + ")",
+			Quantity:    1,
+			PriceCents:  testresources.Cocktail.FullPriceCents / 2,
+			Product:     testresources.Cocktail.Name,
+			PricingRule: testresources.Bar_SecondCocktailHalfPrice.Name,
+		},
+	}
+	if diff := cmp.Diff(purchase.Lines, want, protocmp.Transform()); diff != "" {
+		t.Errorf("purchase.Lines != want (-got +want)\n%s", diff)
+	}
+
+	// Membership conditions are evaluated against the membership of the user.
+	jeans := &pb.Purchase_Line{
+		Description: testresources.Jeans.DisplayName,
+		Quantity:    1,
+		PriceCents:  testresources.Jeans.FullPriceCents,
+		Product:     testresources.Jeans.Name,
+	}
+	buyJeans := func(t *testing.T) []*pb.Purchase_Line {
+		t.Helper()
+		req := &pb.CreatePurchaseRequest{
+			Parent: testresources.Mall.Name,
+			Purchase: &pb.Purchase{
+				User:  testresources.Alice.Name,
+				Lines: []*pb.Purchase_Line{proto.Clone(jeans).(*pb.Purchase_Line)},
+			},
+		}
+		purchase, err := svc.CreatePurchase(ctx, req)
+		if err != nil {
+			t.Fatalf("svc.CreatePurchase(ctx, %v) err = %v; want nil", req, err)
+		}
+		return purchase.Lines
+	}
+	t.Run("NotAdministrator", func(t *testing.T) {
+		if diff := cmp.Diff(buyJeans(t), []*pb.Purchase_Line{jeans}, protocmp.Transform()); diff != "" {
+			t.Errorf("lines != want (-got +want)\n%s", diff)
+		}
+	})
+	t.Run("Administrator", func(t *testing.T) {
+		admin := memberships.Clone(testresources.Mall_Alice)
+		admin.Administrator = true
+		if err := svc.membershipRepo.UpdateMembership(ctx, admin); err != nil {
+			t.Fatal(err)
+		}
+		want := []*pb.Purchase_Line{
+			{
+				Description: testresources.Jeans.DisplayName + " (" + testresources.Mall_StaffDiscount.DisplayName + ")",
+				Quantity:    1,
+				PriceCents:  testresources.Jeans.FullPriceCents * 80 / 100,
+				Product:     testresources.Jeans.Name,
+				PricingRule: testresources.Mall_StaffDiscount.Name,
+			},
+		}
+		if diff := cmp.Diff(buyJeans(t), want, protocmp.Transform()); diff != "" {
+			t.Errorf("lines != want (-got +want)\n%s", diff)
+		}
+	})
+}
+
+func TestService_CreatePurchase_Refunds(t *testing.T) {
+	ctx := context.Background()
+	refund := func(user, purchase string, line int32, quantity int32, priceCents int64) *pb.CreatePurchaseRequest {
+		return &pb.CreatePurchaseRequest{
+			Parent: testresources.Bar.Name,
+			Purchase: &pb.Purchase{
+				User: user,
+				Lines: []*pb.Purchase_Line{
+					{
+						Description:      "Refund",
+						Quantity:         quantity,
+						PriceCents:       priceCents,
+						Kind:             pb.Purchase_Line_REFUND,
+						RefundedPurchase: purchase,
+						RefundedLine:     line,
+					},
+				},
+			},
+		}
+	}
+	alice := testresources.Alice.Name
+	beer1 := testresources.Bar_Alice_Beer1.Name
+	beerRefund := -testresources.Beer.FullPriceCents
+
+	t.Run("OK", func(t *testing.T) {
+		svc := seed(ctx, t)
+		req := refund(alice, beer1, 0, 1, beerRefund)
+		purchase, err := svc.CreatePurchase(ctx, req)
+		if err != nil {
+			t.Fatalf("svc.CreatePurchase(ctx, %v) err = %v; want nil", req, err)
+		}
+		if got, want := purchase.Lines[0].Product, testresources.Beer.Name; got != want {
+			t.Errorf("purchase.Lines[0].Product = %q; want %q", got, want)
+		}
+		// The line has now been refunded in full.
+		_, err = svc.CreatePurchase(ctx, refund(alice, beer1, 0, 1, beerRefund))
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("second refund: status.Code(%v) = %v; want %v", err, got, want)
+		}
+		// The refunded lines cannot be changed.
+		updated := purchases.Clone(testresources.Bar_Alice_Beer1)
+		updated.Lines[0].Quantity = 2
+		_, err = svc.UpdatePurchase(ctx, &pb.UpdatePurchaseRequest{Purchase: updated})
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("UpdatePurchase of refunded purchase: status.Code(%v) = %v; want %v", err, got, want)
+		}
+		// Once the refund is voided, the line can be refunded again.
+		if _, err := svc.UndoPurchase(ctx, &pb.UndoPurchaseRequest{Purchase: purchase.Name}); err != nil {
+			t.Fatalf("svc.UndoPurchase(ctx, %q) err = %v; want nil", purchase.Name, err)
+		}
+		if _, err := svc.CreatePurchase(ctx, refund(alice, beer1, 0, 1, beerRefund)); err != nil {
+			t.Errorf("refund after undo: err = %v; want nil", err)
+		}
+	})
+
+	t.Run("UndoRefunded", func(t *testing.T) {
+		svc := seed(ctx, t)
+		original, err := svc.CreatePurchase(ctx, &pb.CreatePurchaseRequest{
+			Parent:   testresources.Bar.Name,
+			Purchase: purchases.Clone(testresources.Bar_Alice_Beer1),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := svc.CreatePurchase(ctx, refund(alice, original.Name, 0, 1, beerRefund)); err != nil {
+			t.Fatal(err)
+		}
+		_, err = svc.UndoPurchase(ctx, &pb.UndoPurchaseRequest{Purchase: original.Name})
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+		}
+	})
+
+	t.Run("VoidedOriginal", func(t *testing.T) {
+		svc := seed(ctx, t)
+		original, err := svc.CreatePurchase(ctx, &pb.CreatePurchaseRequest{
+			Parent:   testresources.Bar.Name,
+			Purchase: purchases.Clone(testresources.Bar_Alice_Beer1),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := svc.UndoPurchase(ctx, &pb.UndoPurchaseRequest{Purchase: original.Name}); err != nil {
+			t.Fatal(err)
+		}
+		_, err = svc.CreatePurchase(ctx, refund(alice, original.Name, 0, 1, beerRefund))
+		if got, want := status.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+		}
+	})
+
+	t.Run("Deposit", func(t *testing.T) {
+		svc := seed(ctx, t)
+		if err := svc.productRepo.CreateProduct(ctx, testresources.Soda); err != nil {
+			t.Fatal(err)
+		}
+		original, err := svc.CreatePurchase(ctx, &pb.CreatePurchaseRequest{
+			Parent: testresources.Bar.Name,
+			Purchase: &pb.Purchase{
+				User: alice,
+				Lines: []*pb.Purchase_Line{
+					{
+						Description: testresources.Soda.DisplayName,
+						Quantity:    1,
+						PriceCents:  testresources.Soda.FullPriceCents,
+						Product:     testresources.Soda.Name,
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = svc.CreatePurchase(ctx, refund(alice, original.Name, 1, 1, -testresources.Soda.DepositCents))
+		if got, want := status.Code(err), codes.InvalidArgument; got != want {
+			t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+		}
+	})
+
+	for _, test := range []struct {
+		desc     string
+		req      *pb.CreatePurchaseRequest
+		wantCode codes.Code
+	}{
+		{
+			desc:     "OtherUser",
+			req:      refund(testresources.Bob.Name, beer1, 0, 1, beerRefund),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "PurchaseNotFound",
+			req:      refund(alice, testresources.Bar_Alice_Cocktail1.Name, 0, 1, -testresources.Cocktail.FullPriceCents),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "LineNotFound",
+			req:      refund(alice, beer1, 1, 1, beerRefund),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "WrongPrice",
+			req:      refund(alice, beer1, 0, 1, beerRefund+1),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "NegativePrice",
+			req:      refund(alice, beer1, 0, 1, -beerRefund),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "TooMany",
+			req:      refund(alice, beer1, 0, 2, beerRefund),
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			desc: "TooManyInSamePurchase",
+			req: func() *pb.CreatePurchaseRequest {
+				req := refund(alice, beer1, 0, 1, beerRefund)
+				req.Purchase.Lines = append(req.Purchase.Lines, purchases.Clone(req.Purchase).Lines[0])
+				return req
+			}(),
+			wantCode: codes.FailedPrecondition,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			svc := seed(ctx, t)
+			_, err := svc.CreatePurchase(ctx, test.req)
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
@@ -7,11 +7,22 @@ import (
 	"time"
 
 	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/authn"
+	"github.com/Saser/strecku/internal/lockout"
+	"github.com/Saser/strecku/internal/mail"
 	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/internal/statistics"
+	"github.com/Saser/strecku/internal/tokens"
+	"github.com/Saser/strecku/resources/stores/devices"
+	"github.com/Saser/strecku/resources/stores/devices/apikeys"
+	"github.com/Saser/strecku/resources/stores/invitations"
+	"github.com/Saser/strecku/resources/stores/joinrequests"
 	"github.com/Saser/strecku/resources/stores/memberships"
 	"github.com/Saser/strecku/resources/stores/payments"
+	"github.com/Saser/strecku/resources/stores/pricingrules"
 	"github.com/Saser/strecku/resources/stores/products"
 	"github.com/Saser/strecku/resources/stores/purchases"
+	"github.com/Saser/strecku/resources/users/cards"
 	"github.com/Saser/strecku/testresources"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
@@ -19,6 +30,14 @@ import (
 
 const bufSize = 1024 * 1024
 
+// operator is the name of the client certificate identity that administers
+// the server seeded by seed.
+const operator = "operator"
+
+func asOperator(ctx context.Context) context.Context {
+	return authn.NewContext(ctx, authn.Identity{Name: operator, Subject: "CN=operator"})
+}
+
 func seed(ctx context.Context, t *testing.T) *Service {
 	t.Helper()
 	userRepo := repositories.NewInMemoryUsers()
@@ -74,7 +93,68 @@ func seed(ctx context.Context, t *testing.T) *Service {
 			testresources.Mall_Alice_Payment,
 		},
 	)
-	return New(userRepo, storeRepo, membershipRepo, productRepo, purchaseRepo, paymentRepo)
+	invitationRepo := invitations.SeedRepository(
+		t,
+		[]*pb.Invitation{
+			testresources.Bar_Carol_Invitation,
+			testresources.Mall_Bob_Invitation,
+			testresources.Bar_Expired_Invitation,
+		},
+	)
+	joinRequestRepo := joinrequests.SeedRepository(
+		t,
+		[]*pb.JoinRequest{
+			testresources.Mall_Bob_JoinRequest,
+			testresources.Bar_Bob_JoinRequest,
+		},
+	)
+	deviceRepo := devices.SeedRepository(
+		t,
+		[]*pb.Device{
+			testresources.Bar_Kiosk,
+			testresources.Mall_Kiosk,
+		},
+	)
+	apiKeyRepo := apikeys.SeedRepository(
+		t,
+		map[string]*pb.ApiKey{
+			testresources.Bar_Kiosk_ApiKeySecret:  testresources.Bar_Kiosk_ApiKey,
+			testresources.Mall_Kiosk_ApiKeySecret: testresources.Mall_Kiosk_ApiKey,
+		},
+	)
+	cardRepo := cards.SeedRepository(
+		t,
+		[]*pb.Card{
+			testresources.Alice_Card,
+			testresources.Bob_Card,
+		},
+	)
+	pricingRuleRepo := pricingrules.SeedRepository(
+		t,
+		[]*pb.PricingRule{
+			testresources.Bar_SecondCocktailHalfPrice,
+			testresources.Mall_JeansSale,
+		},
+	)
+	aggregator, err := statistics.NewAggregator(ctx, purchaseRepo)
+	if err != nil {
+		t.Fatalf("statistics.NewAggregator(ctx, purchaseRepo) = _, %v; want nil", err)
+	}
+	t.Cleanup(aggregator.Close)
+	svc := New(userRepo, storeRepo, membershipRepo, productRepo, purchaseRepo, paymentRepo, invitationRepo, joinRequestRepo, deviceRepo, apiKeyRepo, cardRepo, pricingRuleRepo, lockout.NewGuard(), aggregator, new(mail.Memory), tokens.NewStore())
+	svc.Administrators = []string{operator}
+	return svc
+}
+
+// makeAdministrator makes membership, which must be in the membership
+// repository of svc, an administrator membership.
+func makeAdministrator(ctx context.Context, t *testing.T, svc *Service, membership *pb.Membership) {
+	t.Helper()
+	admin := memberships.Clone(membership)
+	admin.Administrator = true
+	if err := svc.membershipRepo.UpdateMembership(ctx, admin); err != nil {
+		t.Fatalf("UpdateMembership(%v) = %v; want nil", admin, err)
+	}
 }
 
 func serveAndDial(ctx context.Context, t *testing.T, svc *Service) pb.StreckUClient {
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/statements"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) GenerateStatement(ctx context.Context, req *pb.GenerateStatementRequest) (*pb.Statement, error) {
+	membership, err := s.GetMembership(ctx, &pb.GetMembershipRequest{Name: req.Membership})
+	if err != nil {
+		return nil, err
+	}
+	var start, end time.Time
+	if req.StartTime != nil {
+		if err := req.StartTime.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start time: %v", err)
+		}
+		start = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		if err := req.EndTime.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end time: %v", err)
+		}
+		end = req.EndTime.AsTime()
+	}
+	statement, err := statements.Generate(ctx, s.purchaseRepo, s.paymentRepo, membership, start, end)
+	if err != nil {
+		if errors.Is(err, statements.ErrEndBeforeStart) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid period: %v", err)
+		}
+		return nil, internalError(ctx, err)
+	}
+	return statement, nil
+}
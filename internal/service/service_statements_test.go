@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestService_GenerateStatement(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	december := timestamppb.New(time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC))
+	january := timestamppb.New(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC))
+	for _, test := range []struct {
+		desc          string
+		req           *pb.GenerateStatementRequest
+		wantStatement *pb.Statement
+		wantCode      codes.Code
+	}{
+		{
+			desc: "OK",
+			req: &pb.GenerateStatementRequest{
+				Membership: testresources.Bar_Alice.Name,
+				StartTime:  december,
+				EndTime:    january,
+			},
+			wantStatement: &pb.Statement{
+				Membership:          testresources.Bar_Alice.Name,
+				User:                testresources.Alice.Name,
+				StartTime:           december,
+				EndTime:             january,
+				OpeningBalanceCents: 0,
+				Entries: []*pb.Statement_Entry{
+					{
+						CreateTime:  testresources.Bar_Alice_Beer1.CreateTime,
+						Purchase:    testresources.Bar_Alice_Beer1.Name,
+						Description: testresources.Beer.DisplayName,
+						Quantity:    1,
+						PriceCents:  testresources.Beer.FullPriceCents,
+						AmountCents: testresources.Beer.FullPriceCents,
+					},
+					{
+						CreateTime:  testresources.Bar_Alice_Payment.CreateTime,
+						Payment:     testresources.Bar_Alice_Payment.Name,
+						Description: testresources.Bar_Alice_Payment.Description,
+						Quantity:    1,
+						PriceCents:  testresources.Bar_Alice_Payment.AmountCents,
+						AmountCents: testresources.Bar_Alice_Payment.AmountCents,
+					},
+				},
+				ClosingBalanceCents: testresources.Beer.FullPriceCents + testresources.Bar_Alice_Payment.AmountCents,
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "InvalidName",
+			req: &pb.GenerateStatementRequest{
+				Membership: testresources.Alice.Name, // name of a user
+			},
+			wantStatement: nil,
+			wantCode:      codes.InvalidArgument,
+		},
+		{
+			desc: "NotFound",
+			req: &pb.GenerateStatementRequest{
+				Membership: testresources.Mall_Bob.Name,
+			},
+			wantStatement: nil,
+			wantCode:      codes.NotFound,
+		},
+		{
+			desc: "EndBeforeStart",
+			req: &pb.GenerateStatementRequest{
+				Membership: testresources.Bar_Alice.Name,
+				StartTime:  january,
+				EndTime:    december,
+			},
+			wantStatement: nil,
+			wantCode:      codes.InvalidArgument,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			statement, err := c.GenerateStatement(ctx, test.req)
+			if diff := cmp.Diff(statement, test.wantStatement, protocmp.Transform()); diff != "" {
+				t.Errorf("c.GenerateStatement(%v, %v) statement != test.wantStatement (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/internal/statistics"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"github.com/Saser/strecku/resources/stores/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultTopCount is the number of entries of each leaderboard when
+	// GetStoreStatisticsRequest.top_count is unspecified.
+	defaultTopCount = 10
+	// maxTopCount is the maximum number of entries of each leaderboard.
+	maxTopCount = 100
+)
+
+func totalsProto(t statistics.Totals) *pb.StoreStatistics_Totals {
+	return &pb.StoreStatistics_Totals{
+		Quantity:     t.Quantity,
+		RevenueCents: t.RevenueCents,
+	}
+}
+
+func (s *Service) GetStoreStatistics(ctx context.Context, req *pb.GetStoreStatisticsRequest) (*pb.StoreStatistics, error) {
+	store, err := statistics.Parent(req.Name)
+	if err != nil {
+		switch {
+		case errors.Is(err, resourcename.ErrInvalidName):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, internalError(ctx, err)
+		}
+	}
+	if _, err := s.GetStore(ctx, &pb.GetStoreRequest{Name: store}); err != nil {
+		return nil, err
+	}
+	var start, end time.Time
+	if req.StartTime != nil {
+		if err := req.StartTime.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start time: %v", err)
+		}
+		start = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		if err := req.EndTime.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end time: %v", err)
+		}
+		end = req.EndTime.AsTime()
+	} else {
+		end = time.Now().Truncate(time.Hour).Add(time.Hour)
+	}
+	start, end = start.Truncate(time.Hour), end.Truncate(time.Hour)
+	if end.Before(start) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid period: end time %v is before start time %v", end, start)
+	}
+	if req.Product != "" {
+		if err := products.ValidateName(req.Product); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid product: %v", err)
+		}
+		if parent, _ := products.Parent(req.Product); parent != store {
+			return nil, status.Errorf(codes.InvalidArgument, "product %q is not in store %q", req.Product, store)
+		}
+	}
+	loc := time.UTC
+	if req.TimeZone != "" {
+		if loc, err = time.LoadLocation(req.TimeZone); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid time zone: %v", err)
+		}
+	}
+	topCount := int(req.TopCount)
+	switch {
+	case topCount < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "negative top count: %d", req.TopCount)
+	case topCount == 0:
+		topCount = defaultTopCount
+	case topCount > maxTopCount:
+		topCount = maxTopCount
+	}
+
+	stats, err := s.statistics.Query(ctx, statistics.Query{
+		Store:    store,
+		Start:    start,
+		End:      end,
+		Product:  req.Product,
+		Location: loc,
+	})
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+
+	if req.StartTime == nil {
+		// The period starts with the first purchase, or is empty if there
+		// are no purchases.
+		start = end
+		if !stats.First.IsZero() {
+			start = stats.First
+		}
+	}
+	resp := &pb.StoreStatistics{
+		Name:      req.Name,
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+		Totals:    totalsProto(stats.Totals),
+	}
+	productEntries := func(top []statistics.ProductTotals) ([]*pb.StoreStatistics_ProductEntry, error) {
+		entries := make([]*pb.StoreStatistics_ProductEntry, len(top))
+		for i, p := range top {
+			entries[i] = &pb.StoreStatistics_ProductEntry{
+				Product: p.Product,
+				Totals:  totalsProto(p.Totals),
+			}
+			product, err := s.productRepo.LookupProduct(ctx, p.Product)
+			switch {
+			case err == nil:
+				entries[i].DisplayName = product.DisplayName
+			case errors.Is(err, &products.NotFoundError{Name: p.Product}):
+			default:
+				return nil, err
+			}
+		}
+		return entries, nil
+	}
+	if resp.MostBoughtProducts, err = productEntries(statistics.TopProductsByQuantity(stats.Products, topCount)); err != nil {
+		return nil, internalError(ctx, err)
+	}
+	if resp.HighestRevenueProducts, err = productEntries(statistics.TopProductsByRevenue(stats.Products, topCount)); err != nil {
+		return nil, internalError(ctx, err)
+	}
+
+	optedOut, err := s.membershipRepo.FilterMemberships(ctx, func(m *pb.Membership) bool {
+		parent, _ := memberships.Parent(m.Name)
+		return parent == store && m.LeaderboardOptOut
+	})
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	hidden := make(map[string]bool)
+	for _, m := range optedOut {
+		hidden[m.User] = true
+	}
+	var consumers []statistics.UserTotals
+	for _, u := range stats.Users {
+		if !hidden[u.User] {
+			consumers = append(consumers, u)
+		}
+	}
+	for _, u := range statistics.TopUsers(consumers, topCount) {
+		entry := &pb.StoreStatistics_ConsumerEntry{
+			User:   u.User,
+			Totals: totalsProto(u.Totals),
+		}
+		user, err := s.userRepo.Lookup(ctx, u.User)
+		if err != nil {
+			if notFound := new(repositories.NotFound); !errors.As(err, &notFound) {
+				return nil, internalError(ctx, err)
+			}
+		} else {
+			entry.DisplayName = user.DisplayName
+		}
+		resp.TopConsumers = append(resp.TopConsumers, entry)
+	}
+
+	// Weekdays start with Monday, while time.Weekday starts with Sunday.
+	for i := 1; i <= 7; i++ {
+		resp.Weekdays = append(resp.Weekdays, totalsProto(stats.Weekdays[i%7]))
+	}
+	for _, t := range stats.Hours {
+		resp.Hours = append(resp.Hours, totalsProto(t))
+	}
+	return resp, nil
+}
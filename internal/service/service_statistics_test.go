@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestService_GetStoreStatistics(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	name := testresources.Bar.Name + "/statistics"
+	december := timestamppb.New(time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC))
+	january := timestamppb.New(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC))
+	beer := &pb.StoreStatistics_Totals{
+		Quantity:     1,
+		RevenueCents: -testresources.Beer.FullPriceCents,
+	}
+	none := &pb.StoreStatistics_Totals{}
+	// breakdown returns n totals, where the totals at index i are beer if i
+	// is given, and none otherwise.
+	breakdown := func(n int, i ...int) []*pb.StoreStatistics_Totals {
+		totals := make([]*pb.StoreStatistics_Totals, n)
+		for j := range totals {
+			totals[j] = none
+		}
+		for _, j := range i {
+			totals[j] = beer
+		}
+		return totals
+	}
+	barStatistics := &pb.StoreStatistics{
+		Name:      name,
+		StartTime: december,
+		EndTime:   january,
+		Totals:    beer,
+		MostBoughtProducts: []*pb.StoreStatistics_ProductEntry{
+			{Product: testresources.Beer.Name, DisplayName: testresources.Beer.DisplayName, Totals: beer},
+		},
+		HighestRevenueProducts: []*pb.StoreStatistics_ProductEntry{
+			{Product: testresources.Beer.Name, DisplayName: testresources.Beer.DisplayName, Totals: beer},
+		},
+		TopConsumers: []*pb.StoreStatistics_ConsumerEntry{
+			{User: testresources.Alice.Name, DisplayName: testresources.Alice.DisplayName, Totals: beer},
+		},
+		// Bar_Alice_Beer1 was bought on a Tuesday at 18:00 UTC.
+		Weekdays: breakdown(7, 1),
+		Hours:    breakdown(24, 18),
+	}
+	for _, test := range []struct {
+		desc           string
+		req            *pb.GetStoreStatisticsRequest
+		wantStatistics *pb.StoreStatistics
+		wantCode       codes.Code
+	}{
+		{
+			desc: "OK",
+			req: &pb.GetStoreStatisticsRequest{
+				Name:      name,
+				StartTime: december,
+				EndTime:   january,
+			},
+			wantStatistics: barStatistics,
+			wantCode:       codes.OK,
+		},
+		{
+			desc: "OK_TimeZone",
+			req: &pb.GetStoreStatisticsRequest{
+				Name:      name,
+				StartTime: december,
+				EndTime:   january,
+				TimeZone:  "Etc/GMT+7", // UTC-7
+			},
+			wantStatistics: func() *pb.StoreStatistics {
+				statistics := proto.Clone(barStatistics).(*pb.StoreStatistics)
+				statistics.Hours = breakdown(24, 11)
+				return statistics
+			}(),
+			wantCode: codes.OK,
+		},
+		{
+			desc: "OK_OtherProduct",
+			req: &pb.GetStoreStatisticsRequest{
+				Name:      name,
+				StartTime: december,
+				EndTime:   january,
+				Product:   testresources.Cocktail.Name,
+			},
+			wantStatistics: &pb.StoreStatistics{
+				Name:      name,
+				StartTime: december,
+				EndTime:   january,
+				Totals:    none,
+				Weekdays:  breakdown(7),
+				Hours:     breakdown(24),
+			},
+			wantCode: codes.OK,
+		},
+		{
+			desc: "OK_DefaultStartTime",
+			req: &pb.GetStoreStatisticsRequest{
+				Name:    name,
+				EndTime: january,
+			},
+			wantStatistics: func() *pb.StoreStatistics {
+				statistics := proto.Clone(barStatistics).(*pb.StoreStatistics)
+				statistics.StartTime = timestamppb.New(testresources.Bar_Alice_Beer1.CreateTime.AsTime().Truncate(time.Hour))
+				return statistics
+			}(),
+			wantCode: codes.OK,
+		},
+		{
+			desc: "InvalidName",
+			req: &pb.GetStoreStatisticsRequest{
+				Name: testresources.Bar.Name, // name of a store
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "NotFound",
+			req: &pb.GetStoreStatisticsRequest{
+				Name: "stores/00000000-0000-0000-0000-000000000000/statistics",
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			desc: "EndBeforeStart",
+			req: &pb.GetStoreStatisticsRequest{
+				Name:      name,
+				StartTime: january,
+				EndTime:   december,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "ProductInOtherStore",
+			req: &pb.GetStoreStatisticsRequest{
+				Name:    name,
+				Product: testresources.Jeans.Name,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "InvalidTimeZone",
+			req: &pb.GetStoreStatisticsRequest{
+				Name:     name,
+				TimeZone: "Nowhere/Special",
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "NegativeTopCount",
+			req: &pb.GetStoreStatisticsRequest{
+				Name:     name,
+				TopCount: -1,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	} {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			statistics, err := c.GetStoreStatistics(ctx, test.req)
+			if diff := cmp.Diff(statistics, test.wantStatistics, protocmp.Transform()); diff != "" {
+				t.Errorf("c.GetStoreStatistics(%v, %v) statistics != test.wantStatistics (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_GetStoreStatistics_LeaderboardOptOut(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	req := &pb.GetStoreStatisticsRequest{Name: testresources.Bar.Name + "/statistics"}
+	topConsumers := func() []*pb.StoreStatistics_ConsumerEntry {
+		t.Helper()
+		statistics, err := c.GetStoreStatistics(ctx, req)
+		if err != nil {
+			t.Fatalf("c.GetStoreStatistics(%v, %v) err = %v; want nil", ctx, req, err)
+		}
+		return statistics.TopConsumers
+	}
+	if got := len(topConsumers()); got != 1 {
+		t.Fatalf("len(TopConsumers) = %d; want 1", got)
+	}
+	membership := memberships.Clone(testresources.Bar_Alice)
+	membership.LeaderboardOptOut = true
+	if _, err := c.UpdateMembership(ctx, &pb.UpdateMembershipRequest{
+		Membership: membership,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"leaderboard_opt_out"}},
+	}); err != nil {
+		t.Fatalf("c.UpdateMembership() err = %v; want nil", err)
+	}
+	if got := topConsumers(); len(got) != 0 {
+		t.Errorf("TopConsumers = %v; want none", got)
+	}
+}
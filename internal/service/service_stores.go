@@ -8,6 +8,7 @@ import (
 	"github.com/Saser/strecku/internal/repositories"
 	"github.com/Saser/strecku/resourcename"
 	"github.com/Saser/strecku/resources/stores"
+	"github.com/Saser/strecku/resources/stores/memberships"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -20,7 +21,7 @@ func (s *Service) GetStore(ctx context.Context, req *pb.GetStoreRequest) (*pb.St
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	store, err := s.storeRepo.Lookup(ctx, name)
@@ -28,11 +29,33 @@ func (s *Service) GetStore(ctx context.Context, req *pb.GetStoreRequest) (*pb.St
 		if notFound := new(repositories.NotFound); errors.As(err, &notFound) {
 			return nil, status.Error(codes.NotFound, notFound.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
+	}
+	// Private stores are hidden from users that are not members.
+	if user, ok := callerUser(ctx); ok && stores.Visibility(store) == pb.Store_PRIVATE {
+		member, err := s.isMember(ctx, name, user)
+		if err != nil {
+			return nil, internalError(ctx, err)
+		}
+		if !member {
+			return nil, status.Error(codes.NotFound, (&repositories.NotFound{Name: name}).Error())
+		}
 	}
 	return store, nil
 }
 
+// isMember returns whether user is a member of store.
+func (s *Service) isMember(ctx context.Context, store string, user string) (bool, error) {
+	_, err := s.membershipRepo.LookupMembershipIn(ctx, store, user)
+	if err != nil {
+		if notFound := new(memberships.NotFoundError); errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 func (s *Service) ListStores(ctx context.Context, req *pb.ListStoresRequest) (*pb.ListStoresResponse, error) {
 	if req.PageSize < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "negative page size: %d", req.PageSize)
@@ -42,10 +65,24 @@ func (s *Service) ListStores(ctx context.Context, req *pb.ListStoresRequest) (*p
 	}
 	allStores, err := s.storeRepo.List(ctx)
 	if err != nil {
-		return nil, internalError
+		return nil, internalError(ctx, err)
+	}
+	listed := allStores
+	// Users are only shown public stores, and the stores they are members of.
+	if user, ok := callerUser(ctx); ok {
+		listed = nil
+		for _, store := range allStores {
+			member, err := s.isMember(ctx, store.Name, user)
+			if err != nil {
+				return nil, internalError(ctx, err)
+			}
+			if member || stores.Visibility(store) == pb.Store_PUBLIC {
+				listed = append(listed, store)
+			}
+		}
 	}
 	return &pb.ListStoresResponse{
-		Stores:        allStores,
+		Stores:        listed,
 		NextPageToken: "",
 	}, nil
 }
@@ -53,6 +90,8 @@ func (s *Service) ListStores(ctx context.Context, req *pb.ListStoresRequest) (*p
 func (s *Service) CreateStore(ctx context.Context, req *pb.CreateStoreRequest) (*pb.Store, error) {
 	store := req.Store
 	store.Name = stores.GenerateName()
+	store.Visibility = stores.Visibility(store)
+	store.JoinPolicy = stores.JoinPolicy(store)
 	if err := stores.Validate(store); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid store: %v", err)
 	}
@@ -60,7 +99,7 @@ func (s *Service) CreateStore(ctx context.Context, req *pb.CreateStoreRequest) (
 		if exists := new(repositories.Exists); errors.As(err, &exists) {
 			return nil, status.Error(codes.AlreadyExists, exists.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return store, nil
 }
@@ -82,16 +121,28 @@ func (s *Service) UpdateStore(ctx context.Context, req *pb.UpdateStoreRequest) (
 			switch path {
 			case "display_name":
 				dst.DisplayName = src.DisplayName
+			case "swish_number":
+				dst.SwishNumber = src.SwishNumber
+			case "visibility":
+				dst.Visibility = src.Visibility
+			case "join_policy":
+				dst.JoinPolicy = src.JoinPolicy
+			case "default_discount":
+				dst.DefaultDiscount = src.DefaultDiscount
+			case "purchase_undo_window":
+				dst.PurchaseUndoWindow = src.PurchaseUndoWindow
 			default:
 				return nil, status.Errorf(codes.Internal, "update not implemented for path %q", path)
 			}
 		}
 	}
+	dst.Visibility = stores.Visibility(dst)
+	dst.JoinPolicy = stores.JoinPolicy(dst)
 	if err := stores.Validate(dst); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid store: %v", err)
 	}
 	if err := s.storeRepo.Update(ctx, dst); err != nil {
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return dst, nil
 }
@@ -102,14 +153,14 @@ func (s *Service) DeleteStore(ctx context.Context, req *pb.DeleteStoreRequest) (
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	if err := s.storeRepo.Delete(ctx, req.Name); err != nil {
 		if notFound := new(repositories.NotFound); errors.As(err, &notFound) {
 			return nil, status.Error(codes.NotFound, notFound.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return new(emptypb.Empty), nil
 }
@@ -5,9 +5,12 @@ import (
 	"testing"
 
 	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/authn"
 	"github.com/Saser/strecku/resources/stores"
+	"github.com/Saser/strecku/resources/stores/memberships"
 	"github.com/Saser/strecku/testresources"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
@@ -129,6 +132,18 @@ func TestService_CreateStore(t *testing.T) {
 			wantStore: testresources.Mall,
 			wantCode:  codes.OK,
 		},
+		{
+			desc: "OK_DefaultSettings",
+			req: &pb.CreateStoreRequest{
+				Store: &pb.Store{DisplayName: "New Store"},
+			},
+			wantStore: &pb.Store{
+				DisplayName: "New Store",
+				Visibility:  pb.Store_PRIVATE,
+				JoinPolicy:  pb.Store_INVITE_ONLY,
+			},
+			wantCode: codes.OK,
+		},
 	} {
 		t.Run(test.desc, func(t *testing.T) {
 			c := serveAndDial(ctx, t, seed(ctx, t))
@@ -153,6 +168,10 @@ func TestService_UpdateStore(t *testing.T) {
 		oldBar := stores.Clone(testresources.Bar)
 		newBar := stores.Clone(oldBar)
 		newBar.DisplayName = "New Bar"
+		newBar.SwishNumber = "1239876543"
+		newBar.Visibility = pb.Store_PRIVATE
+		newBar.JoinPolicy = pb.Store_OPEN
+		newBar.DefaultDiscount = true
 		for _, test := range []struct {
 			desc string
 			req  *pb.UpdateStoreRequest
@@ -170,7 +189,7 @@ func TestService_UpdateStore(t *testing.T) {
 				desc: "NoOp_AllPaths",
 				req: &pb.UpdateStoreRequest{
 					Store:      oldBar,
-					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name", "swish_number", "visibility", "join_policy", "default_discount"}},
 				},
 				want: oldBar,
 			},
@@ -194,10 +213,36 @@ func TestService_UpdateStore(t *testing.T) {
 				desc: "FullUpdate_AllPaths",
 				req: &pb.UpdateStoreRequest{
 					Store:      newBar,
-					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name", "swish_number", "visibility", "join_policy", "default_discount"}},
 				},
 				want: newBar,
 			},
+			{
+				desc: "PartialUpdate_SwishNumber",
+				req: &pb.UpdateStoreRequest{
+					Store:      newBar,
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"swish_number"}},
+				},
+				want: func() *pb.Store {
+					store := stores.Clone(oldBar)
+					store.SwishNumber = newBar.SwishNumber
+					return store
+				}(),
+			},
+			{
+				desc: "PartialUpdate_Settings",
+				req: &pb.UpdateStoreRequest{
+					Store:      newBar,
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility", "join_policy", "default_discount"}},
+				},
+				want: func() *pb.Store {
+					store := stores.Clone(oldBar)
+					store.Visibility = newBar.Visibility
+					store.JoinPolicy = newBar.JoinPolicy
+					store.DefaultDiscount = newBar.DefaultDiscount
+					return store
+				}(),
+			},
 		} {
 			t.Run(test.desc, func(t *testing.T) {
 				c := serveAndDial(ctx, t, seed(ctx, t))
@@ -218,6 +263,30 @@ func TestService_UpdateStore(t *testing.T) {
 			req  *pb.UpdateStoreRequest
 			want codes.Code
 		}{
+			{
+				desc: "InvalidSwishNumber",
+				req: &pb.UpdateStoreRequest{
+					Store: func() *pb.Store {
+						bar := stores.Clone(testresources.Bar)
+						bar.SwishNumber = "123-123 45 67"
+						return bar
+					}(),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"swish_number"}},
+				},
+				want: codes.InvalidArgument,
+			},
+			{
+				desc: "UnknownVisibility",
+				req: &pb.UpdateStoreRequest{
+					Store: func() *pb.Store {
+						bar := stores.Clone(testresources.Bar)
+						bar.Visibility = pb.Store_Visibility(42)
+						return bar
+					}(),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+				},
+				want: codes.InvalidArgument,
+			},
 			{
 				desc: "EmptyDisplayName",
 				req: &pb.UpdateStoreRequest{
@@ -306,3 +375,86 @@ func TestService_DeleteStore(t *testing.T) {
 		}
 	})
 }
+
+func TestService_StoreVisibility(t *testing.T) {
+	ctx := context.Background()
+	svc := seed(ctx, t)
+	// Pharmacy has no visibility, so it is private. Bob is its only member.
+	if err := svc.storeRepo.Create(ctx, testresources.Pharmacy); err != nil {
+		t.Fatal(err)
+	}
+	pharmacyBob := &pb.Membership{
+		Name: memberships.GenerateName(testresources.Pharmacy.Name),
+		User: testresources.Bob.Name,
+	}
+	var err error
+	if pharmacyBob.PaymentReference, err = memberships.PaymentReference(pharmacyBob.Name); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.membershipRepo.CreateMembership(ctx, pharmacyBob); err != nil {
+		t.Fatal(err)
+	}
+	as := func(user *pb.User) context.Context {
+		return authn.NewContext(ctx, authn.Identity{Name: user.Name, User: user.Name})
+	}
+
+	t.Run("ListStores", func(t *testing.T) {
+		for _, test := range []struct {
+			desc string
+			ctx  context.Context
+			want []*pb.Store
+		}{
+			{
+				desc: "Anonymous",
+				ctx:  ctx,
+				want: []*pb.Store{testresources.Bar, testresources.Mall, testresources.Pharmacy},
+			},
+			{
+				desc: "Alice",
+				ctx:  as(testresources.Alice),
+				want: []*pb.Store{testresources.Bar, testresources.Mall},
+			},
+			{
+				desc: "Bob",
+				ctx:  as(testresources.Bob),
+				want: []*pb.Store{testresources.Bar, testresources.Pharmacy},
+			},
+			{
+				desc: "Carol",
+				ctx:  as(testresources.Carol),
+				want: []*pb.Store{testresources.Bar},
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				resp, err := svc.ListStores(test.ctx, new(pb.ListStoresRequest))
+				if err != nil {
+					t.Fatalf("svc.ListStores() err = %v; want nil", err)
+				}
+				if diff := cmp.Diff(resp.Stores, test.want, protocmp.Transform(), cmpopts.SortSlices(storeLess)); diff != "" {
+					t.Errorf("svc.ListStores() stores != test.want (-got +want)\n%s", diff)
+				}
+			})
+		}
+	})
+
+	t.Run("GetStore", func(t *testing.T) {
+		for _, test := range []struct {
+			desc     string
+			ctx      context.Context
+			name     string
+			wantCode codes.Code
+		}{
+			{desc: "Unlisted_NonMember", ctx: as(testresources.Bob), name: testresources.Mall.Name, wantCode: codes.OK},
+			{desc: "Private_Anonymous", ctx: ctx, name: testresources.Pharmacy.Name, wantCode: codes.OK},
+			{desc: "Private_Member", ctx: as(testresources.Bob), name: testresources.Pharmacy.Name, wantCode: codes.OK},
+			{desc: "Private_NonMember", ctx: as(testresources.Alice), name: testresources.Pharmacy.Name, wantCode: codes.NotFound},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				_, err := svc.GetStore(test.ctx, &pb.GetStoreRequest{Name: test.name})
+				if got := status.Code(err); got != test.wantCode {
+					t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/statements"
+	"github.com/Saser/strecku/internal/swish"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultSwishImageSize is the size of QR code images when no size is
+	// requested.
+	defaultSwishImageSize = 512
+	// maxSwishImageSize is the largest size of QR code images that can be
+	// requested.
+	maxSwishImageSize = 4096
+)
+
+func (s *Service) GenerateSwishPayment(ctx context.Context, req *pb.GenerateSwishPaymentRequest) (*pb.SwishPayment, error) {
+	size := int(req.ImageSize)
+	switch {
+	case size < 0 || size > maxSwishImageSize:
+		return nil, status.Errorf(codes.InvalidArgument, "image size must be between 0 and %d", maxSwishImageSize)
+	case size == 0:
+		size = defaultSwishImageSize
+	}
+	membership, err := s.GetMembership(ctx, &pb.GetMembershipRequest{Name: req.Membership})
+	if err != nil {
+		return nil, err
+	}
+	parent, err := memberships.Parent(membership.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	store, err := s.GetStore(ctx, &pb.GetStoreRequest{Name: parent})
+	if err != nil {
+		return nil, err
+	}
+	if store.SwishNumber == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "store %q has no Swish number", store.Name)
+	}
+	statement, err := statements.Generate(ctx, s.purchaseRepo, s.paymentRepo, membership, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	if statement.ClosingBalanceCents >= 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "membership %q has no outstanding balance", membership.Name)
+	}
+	amount := -statement.ClosingBalanceCents
+	payload, err := swish.Payload(store.SwishNumber, amount, membership.PaymentReference)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	payment := &pb.SwishPayment{
+		Membership:  membership.Name,
+		Payee:       store.SwishNumber,
+		AmountCents: amount,
+		Message:     membership.PaymentReference,
+		Payload:     payload,
+	}
+	switch req.ImageFormat {
+	case pb.GenerateSwishPaymentRequest_IMAGE_FORMAT_UNSPECIFIED, pb.GenerateSwishPaymentRequest_PNG:
+		payment.Image, err = swish.PNG(payload, size)
+		payment.ImageContentType = "image/png"
+	case pb.GenerateSwishPaymentRequest_SVG:
+		payment.Image, err = swish.SVG(payload, size)
+		payment.ImageContentType = "image/svg+xml"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown image format: %v", req.ImageFormat)
+	}
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	return payment, nil
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestService_GenerateSwishPayment(t *testing.T) {
+	ctx := context.Background()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	// Bob has not made any purchases in the seeded data, so one is made to
+	// give him a negative balance.
+	if _, err := c.CreatePurchase(ctx, &pb.CreatePurchaseRequest{
+		Parent: testresources.Bar.Name,
+		Purchase: &pb.Purchase{
+			User: testresources.Bob.Name,
+			Lines: []*pb.Purchase_Line{
+				{
+					Description: testresources.Beer.DisplayName,
+					Quantity:    3,
+					PriceCents:  testresources.Beer.FullPriceCents,
+					Product:     testresources.Beer.Name,
+				},
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	wantPayment := &pb.SwishPayment{
+		Membership:  testresources.Bar_Bob.Name,
+		Payee:       testresources.Bar.SwishNumber,
+		AmountCents: 15000,
+		Message:     testresources.Bar_Bob.PaymentReference,
+		Payload:     "C" + testresources.Bar.SwishNumber + ";150.00;" + testresources.Bar_Bob.PaymentReference + ";0",
+	}
+	for _, test := range []struct {
+		desc            string
+		req             *pb.GenerateSwishPaymentRequest
+		wantPayment     *pb.SwishPayment
+		wantContentType string
+		wantCode        codes.Code
+	}{
+		{
+			desc: "OK_DefaultFormat",
+			req: &pb.GenerateSwishPaymentRequest{
+				Membership: testresources.Bar_Bob.Name,
+			},
+			wantPayment:     wantPayment,
+			wantContentType: "image/png",
+			wantCode:        codes.OK,
+		},
+		{
+			desc: "OK_SVG",
+			req: &pb.GenerateSwishPaymentRequest{
+				Membership:  testresources.Bar_Bob.Name,
+				ImageFormat: pb.GenerateSwishPaymentRequest_SVG,
+				ImageSize:   128,
+			},
+			wantPayment:     wantPayment,
+			wantContentType: "image/svg+xml",
+			wantCode:        codes.OK,
+		},
+		{
+			desc: "NoOutstandingBalance",
+			req: &pb.GenerateSwishPaymentRequest{
+				Membership: testresources.Bar_Alice.Name,
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			desc: "NoSwishNumber",
+			req: &pb.GenerateSwishPaymentRequest{
+				Membership: testresources.Mall_Alice.Name,
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			desc: "InvalidImageSize",
+			req: &pb.GenerateSwishPaymentRequest{
+				Membership: testresources.Bar_Bob.Name,
+				ImageSize:  -1,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "InvalidName",
+			req: &pb.GenerateSwishPaymentRequest{
+				Membership: testresources.Bar.Name, // name of a store
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc: "NotFound",
+			req: &pb.GenerateSwishPaymentRequest{
+				Membership: testresources.Mall_Bob.Name,
+			},
+			wantCode: codes.NotFound,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			payment, err := c.GenerateSwishPayment(ctx, test.req)
+			if got := status.Code(err); got != test.wantCode {
+				t.Fatalf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(
+				payment, test.wantPayment, protocmp.Transform(),
+				protocmp.IgnoreFields(new(pb.SwishPayment), "image", "image_content_type"),
+			); diff != "" {
+				t.Errorf("c.GenerateSwishPayment(%v, %v) payment != test.wantPayment (-got +want)\n%s", ctx, test.req, diff)
+			}
+			if got := payment.ImageContentType; got != test.wantContentType {
+				t.Errorf("payment.ImageContentType = %q; want %q", got, test.wantContentType)
+			}
+			if len(payment.Image) == 0 {
+				t.Error("payment.Image is empty; want non-empty")
+			}
+		})
+	}
+}
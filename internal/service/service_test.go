@@ -0,0 +1,28 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Saser/strecku/internal/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInternalError(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := logging.NewContext(context.Background(), logging.New(&buf, logging.LevelInfo).With("request_id", "abc"))
+	err := internalError(ctx, errors.New("connection refused"))
+	if got, want := status.Code(err), codes.Internal; got != want {
+		t.Errorf("status.Code(internalError(...)) = %v; want %v", got, want)
+	}
+	if strings.Contains(err.Error(), "connection refused") {
+		t.Errorf("internalError(...) = %v; want the underlying error to be hidden", err)
+	}
+	if got, want := buf.String(), "ERROR internal error: connection refused request_id=abc\n"; !strings.HasSuffix(got, want) {
+		t.Errorf("log = %q; want suffix %q", got, want)
+	}
+}
@@ -20,7 +20,7 @@ func (s *Service) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	user, err := s.userRepo.Lookup(ctx, name)
@@ -28,7 +28,7 @@ func (s *Service) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User
 		if notFound := new(repositories.NotFound); errors.As(err, &notFound) {
 			return nil, status.Error(codes.NotFound, notFound.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return user, nil
 }
@@ -42,7 +42,7 @@ func (s *Service) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.
 	}
 	allUsers, err := s.userRepo.List(ctx)
 	if err != nil {
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
 	return &pb.ListUsersResponse{
 		Users:         allUsers,
@@ -53,6 +53,7 @@ func (s *Service) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.
 func (s *Service) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
 	user := req.User
 	user.Name = users.GenerateName()
+	user.EmailAddressVerified = false
 	if err := users.Validate(user); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user: %v", err)
 	}
@@ -66,8 +67,9 @@ func (s *Service) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*p
 		if exists := new(repositories.EmailAddressExists); errors.As(err, &exists) {
 			return nil, status.Error(codes.AlreadyExists, exists.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
 	}
+	s.sendVerificationEmail(ctx, user)
 	return user, nil
 }
 
@@ -77,6 +79,7 @@ func (s *Service) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*p
 	if err != nil {
 		return nil, err
 	}
+	oldEmailAddress, verified := dst.EmailAddress, dst.EmailAddressVerified
 	mask := req.UpdateMask
 	if mask == nil {
 		dst = src
@@ -90,6 +93,8 @@ func (s *Service) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*p
 				dst.EmailAddress = src.EmailAddress
 			case "display_name":
 				dst.DisplayName = src.DisplayName
+			case "email_address_verified":
+				return nil, status.Errorf(codes.InvalidArgument, `field "email_address_verified" cannot be updated`)
 			default:
 				return nil, status.Errorf(codes.Internal, "update not implemented for path %q", path)
 			}
@@ -98,11 +103,16 @@ func (s *Service) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*p
 	if err := users.Validate(dst); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user: %v", err)
 	}
+	emailAddressChanged := dst.EmailAddress != oldEmailAddress
+	dst.EmailAddressVerified = verified && !emailAddressChanged
 	if err := s.userRepo.Update(ctx, dst); err != nil {
 		if exists := new(repositories.EmailAddressExists); errors.As(err, &exists) {
 			return nil, status.Error(codes.AlreadyExists, exists.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
+	}
+	if emailAddressChanged {
+		s.sendVerificationEmail(ctx, dst)
 	}
 	return dst, nil
 }
@@ -113,14 +123,25 @@ func (s *Service) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*e
 		case errors.Is(err, resourcename.ErrInvalidName):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
-			return nil, internalError
+			return nil, internalError(ctx, err)
 		}
 	}
 	if err := s.userRepo.Delete(ctx, req.Name); err != nil {
 		if notFound := new(repositories.NotFound); errors.As(err, &notFound) {
 			return nil, status.Error(codes.NotFound, notFound.Error())
 		}
-		return nil, internalError
+		return nil, internalError(ctx, err)
+	}
+	// The cards of the user would otherwise keep their UIDs from being
+	// registered again.
+	userCards, err := s.cardsOf(ctx, req.Name)
+	if err != nil {
+		return nil, internalError(ctx, err)
+	}
+	for _, card := range userCards {
+		if err := s.cardRepo.DeleteCard(ctx, card.Name); err != nil {
+			return nil, internalError(ctx, err)
+		}
 	}
 	return new(emptypb.Empty), nil
 }
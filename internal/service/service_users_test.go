@@ -343,7 +343,8 @@ func TestService_DeleteUser(t *testing.T) {
 	ctx := context.Background()
 	// Test scenario(s) where the delete is successful.
 	t.Run("OK", func(t *testing.T) {
-		c := serveAndDial(ctx, t, seed(ctx, t))
+		svc := seed(ctx, t)
+		c := serveAndDial(ctx, t, svc)
 		{
 			req := &pb.DeleteUserRequest{Name: testresources.Alice.Name}
 			_, err := c.DeleteUser(ctx, req)
@@ -358,6 +359,13 @@ func TestService_DeleteUser(t *testing.T) {
 				t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
 			}
 		}
+		// The cards of the user are deleted too.
+		if _, err := svc.cardRepo.LookupCardByUID(ctx, testresources.Alice_Card.Uid); err == nil {
+			t.Errorf("svc.cardRepo.LookupCardByUID(ctx, %q) err = nil; want non-nil", testresources.Alice_Card.Uid)
+		}
+		if _, err := svc.cardRepo.LookupCardByUID(ctx, testresources.Bob_Card.Uid); err != nil {
+			t.Errorf("svc.cardRepo.LookupCardByUID(ctx, %q) err = %v; want nil", testresources.Bob_Card.Uid, err)
+		}
 	})
 	// Test scenario(s) where the delete fails.
 	t.Run("Errors", func(t *testing.T) {
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/events"
+	"github.com/Saser/strecku/resources/stores/payments"
+	"github.com/Saser/strecku/resources/stores/purchases"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var changeTypes = map[events.Type]pb.ChangeType{
+	events.Created: pb.ChangeType_CREATED,
+	events.Updated: pb.ChangeType_UPDATED,
+	events.Deleted: pb.ChangeType_DELETED,
+}
+
+// watch subscribes to the events on bus for resources in the store, whose
+// names are mapped to their stores by parent, and passes them to send until
+// the stream ends. The response headers are sent as soon as the subscription
+// is made, so that clients can tell when they will not miss any events.
+func (s *Service) watch(
+	stream grpc.ServerStream,
+	bus *events.Bus,
+	store string,
+	resumeSequence int64,
+	parent func(name string) (string, error),
+	send func(events.Event) error,
+) error {
+	ctx := stream.Context()
+	if _, err := s.GetStore(ctx, &pb.GetStoreRequest{Name: store}); err != nil {
+		return err
+	}
+	if resumeSequence < 0 {
+		return status.Errorf(codes.InvalidArgument, "negative resume sequence: %d", resumeSequence)
+	}
+	inStore := func(e events.Event) bool {
+		p, err := parent(e.Name)
+		return err == nil && p == store
+	}
+	sub, err := bus.Subscribe(uint64(resumeSequence), inStore)
+	if err != nil {
+		if seqErr := new(events.SequenceError); errors.As(err, &seqErr) {
+			return status.Errorf(codes.OutOfRange, "cannot resume from sequence %d; want a sequence from %d to %d", seqErr.Sequence, seqErr.Oldest, seqErr.Latest)
+		}
+		return internalError(ctx, err)
+	}
+	defer sub.Close()
+	if err := stream.SendHeader(nil); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-s.watchesStopped:
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+			}
+			return status.Error(codes.Canceled, ctx.Err().Error())
+		case e, ok := <-sub.Events():
+			if !ok {
+				if errors.Is(sub.Err(), events.ErrSlowConsumer) {
+					return status.Error(codes.ResourceExhausted, "stream fell too far behind; resume from the sequence of the last received event")
+				}
+				return internalError(ctx, sub.Err())
+			}
+			if err := send(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// StopWatches ends the streams of WatchPurchases and WatchPayments with
+// UNAVAILABLE, and makes new streams fail the same way. It is meant to be
+// called when the server shuts down, since the streams would otherwise keep
+// it from stopping gracefully.
+func (s *Service) StopWatches() {
+	s.stopWatchesOnce.Do(func() { close(s.watchesStopped) })
+}
+
+func (s *Service) WatchPurchases(req *pb.WatchPurchasesRequest, stream pb.StreckU_WatchPurchasesServer) error {
+	send := func(e events.Event) error {
+		return stream.Send(&pb.WatchPurchasesResponse{
+			Sequence:   int64(e.Sequence),
+			ChangeType: changeTypes[e.Type],
+			Purchase:   e.Resource.(*pb.Purchase),
+		})
+	}
+	return s.watch(stream, s.purchaseRepo.Events(), req.Parent, req.ResumeSequence, purchases.Parent, send)
+}
+
+func (s *Service) WatchPayments(req *pb.WatchPaymentsRequest, stream pb.StreckU_WatchPaymentsServer) error {
+	send := func(e events.Event) error {
+		return stream.Send(&pb.WatchPaymentsResponse{
+			Sequence:   int64(e.Sequence),
+			ChangeType: changeTypes[e.Type],
+			Payment:    e.Resource.(*pb.Payment),
+		})
+	}
+	return s.watch(stream, s.paymentRepo.Events(), req.Parent, req.ResumeSequence, payments.Parent, send)
+}
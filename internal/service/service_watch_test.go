@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/events"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestService_WatchPurchases(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	svc := seed(ctx, t)
+	c := serveAndDial(ctx, t, svc)
+
+	beer := &pb.Purchase{
+		User:  testresources.Bob.Name,
+		Lines: testresources.Bar_Alice_Beer1.Lines,
+	}
+	if _, err := c.CreatePurchase(ctx, &pb.CreatePurchaseRequest{Parent: testresources.Bar.Name, Purchase: beer}); err != nil {
+		t.Fatalf("CreatePurchase() = %v; want nil", err)
+	}
+	// The second purchase happens before the stream starts, and is only
+	// sent because the stream resumes from the event before it.
+	resume := int64(svc.purchaseRepo.Events().Latest())
+	created, err := c.CreatePurchase(ctx, &pb.CreatePurchaseRequest{Parent: testresources.Bar.Name, Purchase: beer})
+	if err != nil {
+		t.Fatalf("CreatePurchase() = %v; want nil", err)
+	}
+	stream, err := c.WatchPurchases(ctx, &pb.WatchPurchasesRequest{
+		Parent:         testresources.Bar.Name,
+		ResumeSequence: resume,
+	})
+	if err != nil {
+		t.Fatalf("WatchPurchases() = %v; want nil", err)
+	}
+	// Purchases in other stores are not sent.
+	if _, err := c.CreatePurchase(ctx, &pb.CreatePurchaseRequest{
+		Parent:   testresources.Mall.Name,
+		Purchase: &pb.Purchase{User: testresources.Alice.Name, Lines: testresources.Mall_Alice_Jeans1.Lines},
+	}); err != nil {
+		t.Fatalf("CreatePurchase() = %v; want nil", err)
+	}
+	if _, err := c.DeletePurchase(ctx, &pb.DeletePurchaseRequest{Name: created.Name}); err != nil {
+		t.Fatalf("DeletePurchase() = %v; want nil", err)
+	}
+
+	want := []*pb.WatchPurchasesResponse{
+		{Sequence: resume + 1, ChangeType: pb.ChangeType_CREATED, Purchase: created},
+		{Sequence: resume + 3, ChangeType: pb.ChangeType_DELETED, Purchase: created},
+	}
+	var got []*pb.WatchPurchasesResponse
+	for len(got) < len(want) {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("stream.Recv() = %v; want nil", err)
+		}
+		got = append(got, resp)
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("-got +want:\n%s", diff)
+	}
+}
+
+func TestService_WatchPurchases_SlowConsumer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	svc := seed(ctx, t)
+	c := serveAndDial(ctx, t, svc)
+	stream, err := c.WatchPurchases(ctx, &pb.WatchPurchasesRequest{Parent: testresources.Bar.Name})
+	if err != nil {
+		t.Fatalf("WatchPurchases() = %v; want nil", err)
+	}
+	// Once the headers are received the subscription has been made. Then
+	// publish more events than the subscription can buffer without
+	// receiving any of them. Large purchases make the stream fill up the
+	// flow control windows of gRPC, so that the events back up on the bus.
+	if _, err := stream.Header(); err != nil {
+		t.Fatalf("stream.Header() = %v; want nil", err)
+	}
+	bus := svc.purchaseRepo.Events()
+	large := &pb.Purchase{User: testresources.Bob.Name, Lines: make([]*pb.Purchase_Line, 1000)}
+	for i := range large.Lines {
+		large.Lines[i] = testresources.Bar_Alice_Beer1.Lines[0]
+	}
+	large.Name = testresources.Bar.Name + "/purchases/8d2ac1f1-f0e4-4c3e-9a53-0fbbd4a2d6a2"
+	for i := 0; i < 10*events.DefaultBufferSize; i++ {
+		bus.Publish(events.Event{Type: events.Updated, Name: large.Name, Resource: large, Previous: large})
+	}
+	for {
+		if _, err = stream.Recv(); err != nil {
+			break
+		}
+	}
+	if got, want := status.Code(err), codes.ResourceExhausted; got != want {
+		t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+	}
+}
+
+func TestService_WatchPurchases_Errors(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c := serveAndDial(ctx, t, seed(ctx, t))
+	for _, test := range []struct {
+		desc     string
+		req      *pb.WatchPurchasesRequest
+		wantCode codes.Code
+	}{
+		{
+			desc:     "InvalidParent",
+			req:      &pb.WatchPurchasesRequest{Parent: testresources.Alice.Name}, // name of a user
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "NotFound",
+			req:      &pb.WatchPurchasesRequest{Parent: testresources.Pharmacy.Name},
+			wantCode: codes.NotFound,
+		},
+		{
+			desc:     "NegativeResumeSequence",
+			req:      &pb.WatchPurchasesRequest{Parent: testresources.Bar.Name, ResumeSequence: -1},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			desc:     "FutureResumeSequence",
+			req:      &pb.WatchPurchasesRequest{Parent: testresources.Bar.Name, ResumeSequence: 1},
+			wantCode: codes.OutOfRange,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			stream, err := c.WatchPurchases(ctx, test.req)
+			if err != nil {
+				t.Fatalf("c.WatchPurchases(%v, %v) err = %v; want nil", ctx, test.req, err)
+			}
+			_, err = stream.Recv()
+			if got := status.Code(err); got != test.wantCode {
+				t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_WatchPayments(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	svc := seed(ctx, t)
+	c := serveAndDial(ctx, t, svc)
+
+	req := &pb.CreatePaymentRequest{
+		Parent: testresources.Bar.Name,
+		Payment: &pb.Payment{
+			User:        testresources.Bob.Name,
+			Description: testresources.Bar_Bob_Payment.Description,
+			AmountCents: testresources.Bar_Bob_Payment.AmountCents,
+		},
+	}
+	if _, err := c.CreatePayment(ctx, req); err != nil {
+		t.Fatalf("CreatePayment() = %v; want nil", err)
+	}
+	resume := int64(svc.paymentRepo.Events().Latest())
+	created, err := c.CreatePayment(ctx, req)
+	if err != nil {
+		t.Fatalf("CreatePayment() = %v; want nil", err)
+	}
+	stream, err := c.WatchPayments(ctx, &pb.WatchPaymentsRequest{
+		Parent:         testresources.Bar.Name,
+		ResumeSequence: resume,
+	})
+	if err != nil {
+		t.Fatalf("WatchPayments() = %v; want nil", err)
+	}
+	updated := proto.Clone(created).(*pb.Payment)
+	updated.Description = "Updated"
+	if _, err := c.UpdatePayment(ctx, &pb.UpdatePaymentRequest{Payment: updated}); err != nil {
+		t.Fatalf("UpdatePayment() = %v; want nil", err)
+	}
+	want := []*pb.WatchPaymentsResponse{
+		{Sequence: resume + 1, ChangeType: pb.ChangeType_CREATED, Payment: created},
+		{Sequence: resume + 2, ChangeType: pb.ChangeType_UPDATED, Payment: updated},
+	}
+	var got []*pb.WatchPaymentsResponse
+	for len(got) < len(want) {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("stream.Recv() = %v; want nil", err)
+		}
+		got = append(got, resp)
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("-got +want:\n%s", diff)
+	}
+}
+
+func TestService_StopWatches(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	svc := seed(ctx, t)
+	c := serveAndDial(ctx, t, svc)
+	stream, err := c.WatchPayments(ctx, &pb.WatchPaymentsRequest{Parent: testresources.Bar.Name})
+	if err != nil {
+		t.Fatalf("WatchPayments() = %v; want nil", err)
+	}
+	if _, err := stream.Header(); err != nil {
+		t.Fatalf("stream.Header() = %v; want nil", err)
+	}
+	svc.StopWatches()
+	_, err = stream.Recv()
+	if got, want := status.Code(err), codes.Unavailable; got != want {
+		t.Errorf("status.Code(%v) = %v; want %v", err, got, want)
+	}
+}
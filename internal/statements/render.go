@@ -0,0 +1,120 @@
+package statements
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FormatCents formats an amount in cents as a decimal number with two
+// fractional digits, such as "-12.50".
+func FormatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+func formatTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339)
+}
+
+// WriteText writes the statement to w as plain text, with the entries laid out
+// as a table.
+func WriteText(w io.Writer, statement *pb.Statement) error {
+	if _, err := fmt.Fprintf(w, "Statement for %s\n", statement.User); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Membership: %s\n", statement.Membership); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Period: %s - %s\n\n", formatTime(statement.StartTime), formatTime(statement.EndTime)); err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprint(tw, "Time\tDescription\tQuantity\tPrice\tAmount\t\n")
+	fmt.Fprintf(tw, "\tOpening balance\t\t\t%s\t\n", FormatCents(statement.OpeningBalanceCents))
+	for _, entry := range statement.Entries {
+		fmt.Fprintf(
+			tw,
+			"%s\t%s\t%d\t%s\t%s\t\n",
+			formatTime(entry.CreateTime),
+			entry.Description,
+			entry.Quantity,
+			FormatCents(entry.PriceCents),
+			FormatCents(entry.AmountCents),
+		)
+	}
+	fmt.Fprintf(tw, "\tClosing balance\t\t\t%s\t\n", FormatCents(statement.ClosingBalanceCents))
+	return tw.Flush()
+}
+
+var htmlTemplate = template.Must(template.New("statement").Funcs(template.FuncMap{
+	"cents": FormatCents,
+	"time":  formatTime,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Statement for {{.User}}</title>
+</head>
+<body>
+<h1>Statement for {{.User}}</h1>
+<p>Membership: {{.Membership}}</p>
+<p>Period: {{time .StartTime}} &ndash; {{time .EndTime}}</p>
+<table>
+<thead>
+<tr><th>Time</th><th>Description</th><th>Quantity</th><th>Price</th><th>Amount</th></tr>
+</thead>
+<tbody>
+<tr><td></td><td>Opening balance</td><td></td><td></td><td>{{cents .OpeningBalanceCents}}</td></tr>
+{{- range .Entries}}
+<tr><td>{{time .CreateTime}}</td><td>{{.Description}}</td><td>{{.Quantity}}</td><td>{{cents .PriceCents}}</td><td>{{cents .AmountCents}}</td></tr>
+{{- end}}
+<tr><td></td><td>Closing balance</td><td></td><td></td><td>{{cents .ClosingBalanceCents}}</td></tr>
+</tbody>
+</table>
+</body>
+</html>
+`))
+
+// WriteHTML writes the statement to w as a standalone HTML document.
+func WriteHTML(w io.Writer, statement *pb.Statement) error {
+	return htmlTemplate.Execute(w, statement)
+}
+
+// WriteCSV writes the statement to w as CSV. The first record is a header, and
+// the opening and closing balances are written as the first and last records,
+// respectively. Amounts are written in cents.
+func WriteCSV(w io.Writer, statement *pb.Statement) error {
+	cw := csv.NewWriter(w)
+	records := [][]string{
+		{"time", "purchase", "payment", "description", "quantity", "price_cents", "amount_cents"},
+		{formatTime(statement.StartTime), "", "", "Opening balance", "", "", strconv.FormatInt(statement.OpeningBalanceCents, 10)},
+	}
+	for _, entry := range statement.Entries {
+		records = append(records, []string{
+			formatTime(entry.CreateTime),
+			entry.Purchase,
+			entry.Payment,
+			entry.Description,
+			strconv.FormatInt(int64(entry.Quantity), 10),
+			strconv.FormatInt(entry.PriceCents, 10),
+			strconv.FormatInt(entry.AmountCents, 10),
+		})
+	}
+	records = append(records, []string{formatTime(statement.EndTime), "", "", "Closing balance", "", "", strconv.FormatInt(statement.ClosingBalanceCents, 10)})
+	return cw.WriteAll(records)
+}
@@ -0,0 +1,113 @@
+package statements
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var testStatement = &pb.Statement{
+	Membership:          testresources.Bar_Alice.Name,
+	User:                testresources.Alice.Name,
+	StartTime:           timestamppb.New(time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC)),
+	EndTime:             timestamppb.New(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	OpeningBalanceCents: -1250,
+	Entries: []*pb.Statement_Entry{
+		{
+			CreateTime:  testresources.Bar_Alice_Beer1.CreateTime,
+			Purchase:    testresources.Bar_Alice_Beer1.Name,
+			Description: "Beer <draft>",
+			Quantity:    2,
+			PriceCents:  -5000,
+			AmountCents: -10000,
+		},
+		{
+			CreateTime:  testresources.Bar_Alice_Payment.CreateTime,
+			Payment:     testresources.Bar_Alice_Payment.Name,
+			Description: "Payment, thanks",
+			Quantity:    1,
+			PriceCents:  10000,
+			AmountCents: 10000,
+		},
+	},
+	ClosingBalanceCents: -1250,
+}
+
+func TestFormatCents(t *testing.T) {
+	for _, test := range []struct {
+		cents int64
+		want  string
+	}{
+		{cents: 0, want: "0.00"},
+		{cents: 5, want: "0.05"},
+		{cents: -5, want: "-0.05"},
+		{cents: 1250, want: "12.50"},
+		{cents: -123456, want: "-1234.56"},
+	} {
+		if got := FormatCents(test.cents); got != test.want {
+			t.Errorf("FormatCents(%d) = %q; want %q", test.cents, got, test.want)
+		}
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteText(&buf, testStatement); err != nil {
+		t.Fatalf("WriteText(&buf, testStatement) = %v; want nil", err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		"Statement for " + testresources.Alice.Name,
+		"Period: 2020-12-01T00:00:00Z - 2021-01-01T00:00:00Z",
+		"Opening balance",
+		"Beer <draft>",
+		"-100.00",
+		"Closing balance",
+		"-12.50",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("WriteText(&buf, testStatement) wrote %q; want it to contain %q", got, want)
+		}
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteHTML(&buf, testStatement); err != nil {
+		t.Fatalf("WriteHTML(&buf, testStatement) = %v; want nil", err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		"<td>Beer &lt;draft&gt;</td>",
+		"<td>-100.00</td>",
+		"<td>Closing balance</td><td></td><td></td><td>-12.50</td>",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("WriteHTML(&buf, testStatement) wrote %q; want it to contain %q", got, want)
+		}
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, testStatement); err != nil {
+		t.Fatalf("WriteCSV(&buf, testStatement) = %v; want nil", err)
+	}
+	want := strings.Join([]string{
+		"time,purchase,payment,description,quantity,price_cents,amount_cents",
+		"2020-12-01T00:00:00Z,,,Opening balance,,,-1250",
+		"2020-12-01T18:00:00Z," + testresources.Bar_Alice_Beer1.Name + ",,Beer <draft>,2,-5000,-10000",
+		"2020-12-31T10:00:00Z,," + testresources.Bar_Alice_Payment.Name + ",\"Payment, thanks\",1,10000,10000",
+		"2021-01-01T00:00:00Z,,,Closing balance,,,-1250",
+		"",
+	}, "\n")
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Errorf("WriteCSV(&buf, testStatement) output != want (-got +want)\n%s", diff)
+	}
+}
@@ -0,0 +1,140 @@
+package statements
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"github.com/Saser/strecku/resources/stores/payments"
+	"github.com/Saser/strecku/resources/stores/purchases"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrEndBeforeStart = errors.New("end time is before start time")
+
+// Generate generates a statement for the given membership covering the
+// half-open interval [start, end). A zero start means that the period starts at
+// the first purchase or payment made by the member, and a zero end means that
+// the period ends at the current time. Purchases and payments without a create
+// time are considered to have been made before any period.
+func Generate(
+	ctx context.Context,
+	purchaseRepo *purchases.Repository,
+	paymentRepo *payments.Repository,
+	membership *pb.Membership,
+	start time.Time,
+	end time.Time,
+) (*pb.Statement, error) {
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if !start.IsZero() && end.Before(start) {
+		return nil, ErrEndBeforeStart
+	}
+	store, err := memberships.Parent(membership.Name)
+	if err != nil {
+		return nil, err
+	}
+	allPurchases, err := purchaseRepo.FilterPurchases(ctx, func(purchase *pb.Purchase) bool {
+		parent, err := purchases.Parent(purchase.Name)
+		if err != nil {
+			return false
+		}
+		return parent == store && purchase.User == membership.User && !purchases.Voided(purchase)
+	})
+	if err != nil {
+		return nil, err
+	}
+	allPayments, err := paymentRepo.FilterPayments(ctx, func(payment *pb.Payment) bool {
+		parent, err := payments.Parent(payment.Name)
+		if err != nil {
+			return false
+		}
+		return parent == store && payment.User == membership.User
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &pb.Statement{
+		Membership: membership.Name,
+		User:       membership.User,
+		EndTime:    timestamppb.New(end),
+	}
+	if !start.IsZero() {
+		statement.StartTime = timestamppb.New(start)
+	}
+	// include reports whether something created at the given time belongs
+	// to the period. If it does not, it must have been created either
+	// before or after the period.
+	include := func(ts *timestamppb.Timestamp) (included bool, before bool) {
+		if ts == nil {
+			return false, true
+		}
+		t := ts.AsTime()
+		if t.Before(start) {
+			return false, true
+		}
+		return t.Before(end), false
+	}
+	for _, purchase := range allPurchases {
+		included, before := include(purchase.CreateTime)
+		for _, line := range purchase.Lines {
+			amount := int64(line.Quantity) * line.PriceCents
+			switch {
+			case before:
+				statement.OpeningBalanceCents += amount
+			case included:
+				statement.Entries = append(statement.Entries, &pb.Statement_Entry{
+					CreateTime:  purchase.CreateTime,
+					Purchase:    purchase.Name,
+					Description: line.Description,
+					Quantity:    line.Quantity,
+					PriceCents:  line.PriceCents,
+					AmountCents: amount,
+				})
+			}
+		}
+	}
+	for _, payment := range allPayments {
+		included, before := include(payment.CreateTime)
+		switch {
+		case before:
+			statement.OpeningBalanceCents += payment.AmountCents
+		case included:
+			statement.Entries = append(statement.Entries, &pb.Statement_Entry{
+				CreateTime:  payment.CreateTime,
+				Payment:     payment.Name,
+				Description: payment.Description,
+				Quantity:    1,
+				PriceCents:  payment.AmountCents,
+				AmountCents: payment.AmountCents,
+			})
+		}
+	}
+	// Entries are ordered by time. Lines in the same purchase share the same
+	// time, so the sort must be stable to keep them in their original order.
+	sort.SliceStable(statement.Entries, func(i, j int) bool {
+		ti := statement.Entries[i].CreateTime.AsTime()
+		tj := statement.Entries[j].CreateTime.AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return entryName(statement.Entries[i]) < entryName(statement.Entries[j])
+	})
+	statement.ClosingBalanceCents = statement.OpeningBalanceCents
+	for _, entry := range statement.Entries {
+		statement.ClosingBalanceCents += entry.AmountCents
+	}
+	return statement, nil
+}
+
+func entryName(entry *pb.Statement_Entry) string {
+	if entry.Purchase != "" {
+		return entry.Purchase
+	}
+	return entry.Payment
+}
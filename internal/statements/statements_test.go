@@ -0,0 +1,176 @@
+package statements
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/payments"
+	"github.com/Saser/strecku/resources/stores/purchases"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func seed(t *testing.T) (*purchases.Repository, *payments.Repository) {
+	t.Helper()
+	purchaseRepo := purchases.SeedRepository(t, []*pb.Purchase{
+		testresources.Bar_Alice_Beer1,
+		testresources.Bar_Alice_Cocktail1,
+		testresources.Bar_Alice_Beer2_Cocktail2,
+		testresources.Bar_Bob_Beer1_Voided,
+		testresources.Mall_Alice_Jeans1,
+	})
+	paymentRepo := payments.SeedRepository(t, []*pb.Payment{
+		testresources.Bar_Alice_Payment,
+		testresources.Bar_Bob_Payment,
+		testresources.Mall_Alice_Payment,
+	})
+	return purchaseRepo, paymentRepo
+}
+
+func TestGenerate(t *testing.T) {
+	ctx := context.Background()
+	purchaseRepo, paymentRepo := seed(t)
+	december := time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC)
+	january := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	february := time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC)
+	cocktail1Entry := &pb.Statement_Entry{
+		CreateTime:  testresources.Bar_Alice_Cocktail1.CreateTime,
+		Purchase:    testresources.Bar_Alice_Cocktail1.Name,
+		Description: testresources.Cocktail.DisplayName,
+		Quantity:    1,
+		PriceCents:  testresources.Cocktail.FullPriceCents,
+		AmountCents: testresources.Cocktail.FullPriceCents,
+	}
+	paymentEntry := &pb.Statement_Entry{
+		CreateTime:  testresources.Bar_Alice_Payment.CreateTime,
+		Payment:     testresources.Bar_Alice_Payment.Name,
+		Description: testresources.Bar_Alice_Payment.Description,
+		Quantity:    1,
+		PriceCents:  testresources.Bar_Alice_Payment.AmountCents,
+		AmountCents: testresources.Bar_Alice_Payment.AmountCents,
+	}
+	for _, test := range []struct {
+		desc       string
+		membership *pb.Membership
+		start, end time.Time
+		want       *pb.Statement
+	}{
+		{
+			desc:       "Bar_Alice_December_StartAfterFirstPurchase",
+			membership: testresources.Bar_Alice,
+			start:      december.Add(48 * time.Hour),
+			end:        january,
+			want: &pb.Statement{
+				Membership:          testresources.Bar_Alice.Name,
+				User:                testresources.Alice.Name,
+				StartTime:           timestamppb.New(december.Add(48 * time.Hour)),
+				EndTime:             timestamppb.New(january),
+				OpeningBalanceCents: testresources.Beer.FullPriceCents,
+				Entries: []*pb.Statement_Entry{
+					cocktail1Entry,
+					paymentEntry,
+				},
+				ClosingBalanceCents: testresources.Beer.FullPriceCents,
+			},
+		},
+		{
+			desc:       "Bar_Alice_January",
+			membership: testresources.Bar_Alice,
+			start:      january,
+			end:        february,
+			want: &pb.Statement{
+				Membership:          testresources.Bar_Alice.Name,
+				User:                testresources.Alice.Name,
+				StartTime:           timestamppb.New(january),
+				EndTime:             timestamppb.New(february),
+				OpeningBalanceCents: testresources.Beer.FullPriceCents,
+				Entries: []*pb.Statement_Entry{
+					{
+						CreateTime:  testresources.Bar_Alice_Beer2_Cocktail2.CreateTime,
+						Purchase:    testresources.Bar_Alice_Beer2_Cocktail2.Name,
+						Description: testresources.Beer.DisplayName,
+						Quantity:    2,
+						PriceCents:  testresources.Beer.FullPriceCents,
+						AmountCents: 2 * testresources.Beer.FullPriceCents,
+					},
+					{
+						CreateTime:  testresources.Bar_Alice_Beer2_Cocktail2.CreateTime,
+						Purchase:    testresources.Bar_Alice_Beer2_Cocktail2.Name,
+						Description: testresources.Cocktail.DisplayName,
+						Quantity:    2,
+						PriceCents:  testresources.Cocktail.FullPriceCents,
+						AmountCents: 2 * testresources.Cocktail.FullPriceCents,
+					},
+				},
+				ClosingBalanceCents: testresources.Beer.FullPriceCents +
+					2*testresources.Beer.FullPriceCents +
+					2*testresources.Cocktail.FullPriceCents,
+			},
+		},
+		{
+			desc:       "Bar_Bob_NoStart",
+			membership: testresources.Bar_Bob,
+			start:      time.Time{},
+			end:        february,
+			want: &pb.Statement{
+				Membership:          testresources.Bar_Bob.Name,
+				User:                testresources.Bob.Name,
+				StartTime:           nil,
+				EndTime:             timestamppb.New(february),
+				OpeningBalanceCents: 0,
+				Entries: []*pb.Statement_Entry{
+					{
+						CreateTime:  testresources.Bar_Bob_Payment.CreateTime,
+						Payment:     testresources.Bar_Bob_Payment.Name,
+						Description: testresources.Bar_Bob_Payment.Description,
+						Quantity:    1,
+						PriceCents:  testresources.Bar_Bob_Payment.AmountCents,
+						AmountCents: testresources.Bar_Bob_Payment.AmountCents,
+					},
+				},
+				ClosingBalanceCents: testresources.Bar_Bob_Payment.AmountCents,
+			},
+		},
+		{
+			desc:       "Mall_Alice_Empty",
+			membership: testresources.Mall_Alice,
+			start:      january,
+			end:        february,
+			want: &pb.Statement{
+				Membership:          testresources.Mall_Alice.Name,
+				User:                testresources.Alice.Name,
+				StartTime:           timestamppb.New(january),
+				EndTime:             timestamppb.New(february),
+				OpeningBalanceCents: testresources.Jeans.FullPriceCents + testresources.Mall_Alice_Payment.AmountCents,
+				Entries:             nil,
+				ClosingBalanceCents: testresources.Jeans.FullPriceCents + testresources.Mall_Alice_Payment.AmountCents,
+			},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := Generate(ctx, purchaseRepo, paymentRepo, test.membership, test.start, test.end)
+			if err != nil {
+				t.Fatalf("Generate(ctx, purchaseRepo, paymentRepo, %v, %v, %v) err = %v; want nil", test.membership, test.start, test.end, err)
+			}
+			if diff := cmp.Diff(got, test.want, protocmp.Transform(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Generate(ctx, purchaseRepo, paymentRepo, %v, %v, %v) statement != test.want (-got +want)\n%s", test.membership, test.start, test.end, diff)
+			}
+		})
+	}
+}
+
+func TestGenerate_EndBeforeStart(t *testing.T) {
+	ctx := context.Background()
+	purchaseRepo, paymentRepo := seed(t)
+	start := time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	_, err := Generate(ctx, purchaseRepo, paymentRepo, testresources.Bar_Alice, start, end)
+	if !cmp.Equal(err, ErrEndBeforeStart, cmpopts.EquateErrors()) {
+		t.Errorf("Generate(ctx, purchaseRepo, paymentRepo, %v, %v, %v) err = %v; want %v", testresources.Bar_Alice, start, end, err, ErrEndBeforeStart)
+	}
+}
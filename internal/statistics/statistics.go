@@ -0,0 +1,429 @@
+package statistics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/events"
+	"github.com/Saser/strecku/resources/stores"
+	"github.com/Saser/strecku/resources/stores/purchases"
+)
+
+// NameFormat is the format of the resource names of the statistics of
+// stores, of which every store has exactly one.
+var NameFormat = stores.NameFormat.MustAppend("/statistics")
+
+// Name returns the resource name of the statistics of the store.
+func Name(store string) (string, error) {
+	uuids, err := stores.NameFormat.Parse(store)
+	if err != nil {
+		return "", err
+	}
+	return NameFormat.Format(uuids)
+}
+
+// Parent returns the resource name of the store that the statistics with the
+// given name belong to.
+func Parent(name string) (string, error) {
+	uuids, err := NameFormat.Parse(name)
+	if err != nil {
+		return "", err
+	}
+	return stores.NameFormat.Format(uuids)
+}
+
+// Totals are the sums over a set of purchase lines. Refunds are subtracted,
+// while deposits and deposit returns are not counted, as they are paid back.
+type Totals struct {
+	// Quantity is the number of items bought.
+	Quantity int64
+	// RevenueCents is the amount paid for the items. Since prices are
+	// negative, it is the negated sum of the amounts of the lines.
+	RevenueCents int64
+}
+
+func (t *Totals) add(o Totals) {
+	t.Quantity += o.Quantity
+	t.RevenueCents += o.RevenueCents
+}
+
+// ProductTotals are the totals of the lines of a product.
+type ProductTotals struct {
+	Product string
+	Totals
+}
+
+// UserTotals are the totals of the lines of the purchases of a user.
+type UserTotals struct {
+	User string
+	Totals
+}
+
+// Statistics are the totals of the lines of the purchases in a store during
+// a period of time, broken down in different ways.
+type Statistics struct {
+	Totals
+	// Products contains the totals of every product, ordered by name. Lines
+	// without a product are not included.
+	Products []ProductTotals
+	// Users contains the totals of every user, ordered by name.
+	Users []UserTotals
+	// Weekdays contains the totals of each day of the week, indexed by
+	// time.Weekday.
+	Weekdays [7]Totals
+	// Hours contains the totals of each hour of the day.
+	Hours [24]Totals
+	// First is the start of the hour of the first included purchase, or the
+	// zero time if no purchases are included.
+	First time.Time
+}
+
+// TopProductsByQuantity returns the n products with the largest quantities.
+// Ties are broken by revenue and then by name.
+func TopProductsByQuantity(products []ProductTotals, n int) []ProductTotals {
+	return topProducts(products, n, func(a, b ProductTotals) bool {
+		if a.Quantity != b.Quantity {
+			return a.Quantity > b.Quantity
+		}
+		if a.RevenueCents != b.RevenueCents {
+			return a.RevenueCents > b.RevenueCents
+		}
+		return a.Product < b.Product
+	})
+}
+
+// TopProductsByRevenue returns the n products with the largest revenues.
+// Ties are broken by quantity and then by name.
+func TopProductsByRevenue(products []ProductTotals, n int) []ProductTotals {
+	return topProducts(products, n, func(a, b ProductTotals) bool {
+		if a.RevenueCents != b.RevenueCents {
+			return a.RevenueCents > b.RevenueCents
+		}
+		if a.Quantity != b.Quantity {
+			return a.Quantity > b.Quantity
+		}
+		return a.Product < b.Product
+	})
+}
+
+func topProducts(products []ProductTotals, n int, less func(a, b ProductTotals) bool) []ProductTotals {
+	sorted := append([]ProductTotals(nil), products...)
+	sort.Slice(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	return sorted
+}
+
+// TopUsers returns the n users with the largest quantities. Ties are broken
+// by revenue and then by name.
+func TopUsers(users []UserTotals, n int) []UserTotals {
+	sorted := append([]UserTotals(nil), users...)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Quantity != b.Quantity {
+			return a.Quantity > b.Quantity
+		}
+		if a.RevenueCents != b.RevenueCents {
+			return a.RevenueCents > b.RevenueCents
+		}
+		return a.User < b.User
+	})
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	return sorted
+}
+
+// Query selects the purchase lines that statistics are computed from.
+type Query struct {
+	// Store is the resource name of the store.
+	Store string
+	// Start and End are rounded down to whole hours, and the statistics
+	// cover the purchases from the rounded Start up to but excluding the
+	// rounded End.
+	Start, End time.Time
+	// Product is the resource name of a product. If it is not empty, only
+	// the lines of that product are included.
+	Product string
+	// Location is the time zone of the weekdays and hours of the
+	// statistics. If it is nil, UTC is used.
+	Location *time.Location
+}
+
+// key identifies the lines of a product bought by a user.
+type key struct {
+	product string
+	user    string
+}
+
+// hour is the number of whole hours since the Unix epoch.
+type hour int64
+
+func hourOf(t time.Time) hour {
+	return hour(t.Unix() / 3600)
+}
+
+func (h hour) Time() time.Time {
+	return time.Unix(int64(h)*3600, 0)
+}
+
+// buckets maps each hour to the totals of the lines of the purchases during
+// that hour.
+type buckets map[hour]map[key]Totals
+
+// Aggregator maintains the totals of the lines of purchases, by store, hour,
+// product and user, so that statistics can be computed without going through
+// every purchase. The totals are updated from the events of the purchase
+// repository.
+type Aggregator struct {
+	repo *purchases.Repository
+	done chan struct{}
+
+	mu      sync.Mutex
+	applied *sync.Cond // signalled when events have been applied
+	sub     *events.Subscription
+	last    uint64             // the sequence number of the last applied event
+	stores  map[string]buckets // store name -> buckets
+	err     error              // set if the aggregator stopped because of an error
+}
+
+// NewAggregator returns an aggregator of the purchases in repo, which starts
+// from the purchases in it and then follows its events. The aggregator must
+// be created before the repository is used concurrently, and must be closed
+// when no longer used.
+func NewAggregator(ctx context.Context, repo *purchases.Repository) (*Aggregator, error) {
+	a := &Aggregator{
+		repo: repo,
+		done: make(chan struct{}),
+	}
+	a.applied = sync.NewCond(&a.mu)
+	if err := a.rebuild(ctx); err != nil {
+		return nil, err
+	}
+	go a.run()
+	return a, nil
+}
+
+// Close stops the aggregator.
+func (a *Aggregator) Close() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	select {
+	case <-a.done:
+	default:
+		close(a.done)
+		a.sub.Close()
+		a.applied.Broadcast()
+	}
+}
+
+// rebuild starts over from the purchases in the repository. a.mu must be
+// held, unless a has not been shared yet. Purchases that change while
+// rebuilding may be counted twice or not at all, which is why rebuilding is
+// only done when the aggregator is created, and when it has fallen further
+// behind the events than the repository keeps.
+func (a *Aggregator) rebuild(ctx context.Context) error {
+	bus := a.repo.Events()
+	latest := bus.Latest()
+	sub, err := bus.Subscribe(latest, nil)
+	if err != nil {
+		return err
+	}
+	all, err := a.repo.ListPurchases(ctx)
+	if err != nil {
+		sub.Close()
+		return err
+	}
+	a.sub = sub
+	a.last = latest
+	a.stores = make(map[string]buckets)
+	for _, purchase := range all {
+		a.apply(purchase, 1)
+	}
+	return nil
+}
+
+// run applies events until the aggregator is closed. When the subscription
+// ends because the aggregator fell behind, it resumes from the last applied
+// event, or rebuilds if that event is no longer kept.
+func (a *Aggregator) run() {
+	for {
+		a.mu.Lock()
+		sub := a.sub
+		a.mu.Unlock()
+		for e := range sub.Events() {
+			a.mu.Lock()
+			a.handle(e)
+			a.mu.Unlock()
+		}
+		a.mu.Lock()
+		select {
+		case <-a.done:
+			a.mu.Unlock()
+			return
+		default:
+		}
+		var err error
+		if a.sub, err = a.repo.Events().Subscribe(a.last, nil); err != nil {
+			if seqErr := new(events.SequenceError); errors.As(err, &seqErr) {
+				err = a.rebuild(context.Background())
+			}
+		}
+		if err != nil {
+			a.err = err
+			close(a.done)
+			a.applied.Broadcast()
+			a.mu.Unlock()
+			return
+		}
+		a.mu.Unlock()
+	}
+}
+
+// handle applies e. a.mu must be held.
+func (a *Aggregator) handle(e events.Event) {
+	if e.Sequence <= a.last {
+		return
+	}
+	switch e.Type {
+	case events.Created:
+		a.apply(e.Resource.(*pb.Purchase), 1)
+	case events.Updated:
+		a.apply(e.Previous.(*pb.Purchase), -1)
+		a.apply(e.Resource.(*pb.Purchase), 1)
+	case events.Deleted:
+		a.apply(e.Resource.(*pb.Purchase), -1)
+	}
+	a.last = e.Sequence
+	a.applied.Broadcast()
+}
+
+// apply adds the lines of purchase to the totals, multiplied by sign. Voided
+// purchases are not counted. a.mu must be held.
+func (a *Aggregator) apply(purchase *pb.Purchase, sign int64) {
+	if purchases.Voided(purchase) {
+		return
+	}
+	store, err := purchases.Parent(purchase.Name)
+	if err != nil {
+		return
+	}
+	bs, ok := a.stores[store]
+	if !ok {
+		bs = make(buckets)
+		a.stores[store] = bs
+	}
+	h := hourOf(purchase.CreateTime.AsTime())
+	totals, ok := bs[h]
+	if !ok {
+		totals = make(map[key]Totals)
+		bs[h] = totals
+	}
+	for _, line := range purchase.Lines {
+		quantity := int64(line.Quantity)
+		switch purchases.LineKind(line) {
+		case pb.Purchase_Line_CHARGE:
+		case pb.Purchase_Line_REFUND:
+			quantity = -quantity
+		default:
+			continue
+		}
+		k := key{product: line.Product, user: purchase.User}
+		t := totals[k]
+		t.add(Totals{
+			Quantity:     sign * quantity,
+			RevenueCents: sign * -int64(line.Quantity) * line.PriceCents,
+		})
+		if t == (Totals{}) {
+			delete(totals, k)
+		} else {
+			totals[k] = t
+		}
+	}
+	if len(totals) == 0 {
+		delete(bs, h)
+	}
+}
+
+// Query computes the statistics selected by q. The statistics include every
+// change to the repository that was made before Query was called.
+func (a *Aggregator) Query(ctx context.Context, q Query) (*Statistics, error) {
+	if err := stores.ValidateName(q.Store); err != nil {
+		return nil, err
+	}
+	loc := q.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	start, end := hourOf(q.Start), hourOf(q.End)
+	if start > end {
+		return nil, fmt.Errorf("statistics: end %v is before start %v", q.End, q.Start)
+	}
+
+	latest := a.repo.Events().Latest()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.last < latest {
+		select {
+		case <-a.done:
+			if a.err != nil {
+				return nil, a.err
+			}
+			return nil, errors.New("statistics: aggregator is closed")
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		a.applied.Wait()
+	}
+
+	stats := new(Statistics)
+	products := make(map[string]*Totals)
+	users := make(map[string]*Totals)
+	first := end
+	for h, totals := range a.stores[q.Store] {
+		if h < start || h >= end {
+			continue
+		}
+		t := h.Time().In(loc)
+		for k, kt := range totals {
+			if q.Product != "" && k.product != q.Product {
+				continue
+			}
+			if h < first {
+				first = h
+			}
+			stats.add(kt)
+			stats.Weekdays[t.Weekday()].add(kt)
+			stats.Hours[t.Hour()].add(kt)
+			if k.product != "" {
+				if products[k.product] == nil {
+					products[k.product] = new(Totals)
+				}
+				products[k.product].add(kt)
+			}
+			if users[k.user] == nil {
+				users[k.user] = new(Totals)
+			}
+			users[k.user].add(kt)
+		}
+	}
+	if first < end {
+		stats.First = first.Time()
+	}
+	for product, t := range products {
+		stats.Products = append(stats.Products, ProductTotals{Product: product, Totals: *t})
+	}
+	sort.Slice(stats.Products, func(i, j int) bool { return stats.Products[i].Product < stats.Products[j].Product })
+	for user, t := range users {
+		stats.Users = append(stats.Users, UserTotals{User: user, Totals: *t})
+	}
+	sort.Slice(stats.Users, func(i, j int) bool { return stats.Users[i].User < stats.Users[j].User })
+	return stats, nil
+}
@@ -0,0 +1,399 @@
+package statistics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/purchases"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestName_Parent(t *testing.T) {
+	name, err := Name(testresources.Bar.Name)
+	if err != nil {
+		t.Fatalf("Name(%q) = _, %v; want nil", testresources.Bar.Name, err)
+	}
+	if want := testresources.Bar.Name + "/statistics"; name != want {
+		t.Errorf("Name(%q) = %q; want %q", testresources.Bar.Name, name, want)
+	}
+	store, err := Parent(name)
+	if err != nil {
+		t.Fatalf("Parent(%q) = _, %v; want nil", name, err)
+	}
+	if store != testresources.Bar.Name {
+		t.Errorf("Parent(%q) = %q; want %q", name, store, testresources.Bar.Name)
+	}
+	if _, err := Parent(testresources.Bar.Name); err == nil {
+		t.Errorf("Parent(%q) = _, nil; want non-nil", testresources.Bar.Name)
+	}
+}
+
+func TestTopProducts(t *testing.T) {
+	products := []ProductTotals{
+		{Product: "a", Totals: Totals{Quantity: 1, RevenueCents: 300}},
+		{Product: "b", Totals: Totals{Quantity: 3, RevenueCents: 100}},
+		{Product: "c", Totals: Totals{Quantity: 3, RevenueCents: 200}},
+		{Product: "d", Totals: Totals{Quantity: 1, RevenueCents: 300}},
+	}
+	names := func(products []ProductTotals) []string {
+		var names []string
+		for _, p := range products {
+			names = append(names, p.Product)
+		}
+		return names
+	}
+	for _, test := range []struct {
+		desc string
+		top  func([]ProductTotals, int) []ProductTotals
+		n    int
+		want []string
+	}{
+		{desc: "ByQuantity", top: TopProductsByQuantity, n: 10, want: []string{"c", "b", "a", "d"}},
+		{desc: "ByQuantity_Limit", top: TopProductsByQuantity, n: 1, want: []string{"c"}},
+		{desc: "ByRevenue", top: TopProductsByRevenue, n: 10, want: []string{"a", "d", "c", "b"}},
+		{desc: "ByRevenue_Limit", top: TopProductsByRevenue, n: 3, want: []string{"a", "d", "c"}},
+	} {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, names(test.top(products, test.n))); diff != "" {
+				t.Errorf("unexpected products (-want +got)\n%s", diff)
+			}
+		})
+	}
+	if got := names(products); !cmp.Equal(got, []string{"a", "b", "c", "d"}) {
+		t.Errorf("input was reordered to %v", got)
+	}
+}
+
+func TestTopUsers(t *testing.T) {
+	users := []UserTotals{
+		{User: "users/a", Totals: Totals{Quantity: 1, RevenueCents: 100}},
+		{User: "users/b", Totals: Totals{Quantity: 2, RevenueCents: 100}},
+		{User: "users/c", Totals: Totals{Quantity: 2, RevenueCents: 200}},
+	}
+	want := []UserTotals{users[2], users[1]}
+	if diff := cmp.Diff(want, TopUsers(users, 2)); diff != "" {
+		t.Errorf("TopUsers(users, 2) (-want +got)\n%s", diff)
+	}
+}
+
+func newAggregator(ctx context.Context, t *testing.T, repo *purchases.Repository) *Aggregator {
+	t.Helper()
+	a, err := NewAggregator(ctx, repo)
+	if err != nil {
+		t.Fatalf("NewAggregator(ctx, repo) = _, %v; want nil", err)
+	}
+	t.Cleanup(a.Close)
+	return a
+}
+
+func TestAggregator_Query(t *testing.T) {
+	ctx := context.Background()
+	a := newAggregator(ctx, t, purchases.SeedRepository(t, []*pb.Purchase{
+		testresources.Bar_Alice_Beer1,
+		testresources.Bar_Alice_Cocktail1,
+		testresources.Bar_Alice_Beer2_Cocktail2,
+		testresources.Mall_Alice_Jeans1,
+	}))
+	var (
+		december = time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC)
+		january  = time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+		february = time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC)
+	)
+	beer := func(quantity int64) Totals {
+		return Totals{Quantity: quantity, RevenueCents: -quantity * testresources.Beer.FullPriceCents}
+	}
+	cocktail := func(quantity int64) Totals {
+		return Totals{Quantity: quantity, RevenueCents: -quantity * testresources.Cocktail.FullPriceCents}
+	}
+	sum := func(ts ...Totals) Totals {
+		var sum Totals
+		for _, t := range ts {
+			sum.add(t)
+		}
+		return sum
+	}
+	for _, test := range []struct {
+		desc string
+		q    Query
+		want *Statistics
+	}{
+		{
+			desc: "All",
+			q:    Query{Store: testresources.Bar.Name, Start: december, End: february},
+			want: &Statistics{
+				Totals: sum(beer(3), cocktail(3)),
+				Products: []ProductTotals{
+					{Product: testresources.Cocktail.Name, Totals: cocktail(3)},
+					{Product: testresources.Beer.Name, Totals: beer(3)},
+				},
+				Users: []UserTotals{
+					{User: testresources.Alice.Name, Totals: sum(beer(3), cocktail(3))},
+				},
+				Weekdays: [7]Totals{
+					time.Tuesday:  beer(1),
+					time.Friday:   sum(beer(2), cocktail(2)),
+					time.Saturday: cocktail(1),
+				},
+				Hours: [24]Totals{
+					18: beer(1),
+					20: sum(beer(2), cocktail(2)),
+					21: cocktail(1),
+				},
+				First: time.Date(2020, time.December, 1, 18, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			desc: "Period",
+			q:    Query{Store: testresources.Bar.Name, Start: december, End: january},
+			want: &Statistics{
+				Totals: sum(beer(1), cocktail(1)),
+				Products: []ProductTotals{
+					{Product: testresources.Cocktail.Name, Totals: cocktail(1)},
+					{Product: testresources.Beer.Name, Totals: beer(1)},
+				},
+				Users: []UserTotals{
+					{User: testresources.Alice.Name, Totals: sum(beer(1), cocktail(1))},
+				},
+				Weekdays: [7]Totals{
+					time.Tuesday:  beer(1),
+					time.Saturday: cocktail(1),
+				},
+				Hours: [24]Totals{
+					18: beer(1),
+					21: cocktail(1),
+				},
+				First: time.Date(2020, time.December, 1, 18, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			desc: "EndIsExclusive",
+			q: Query{
+				Store: testresources.Bar.Name,
+				Start: december,
+				End:   time.Date(2020, time.December, 1, 18, 59, 0, 0, time.UTC),
+			},
+			want: &Statistics{},
+		},
+		{
+			desc: "Product",
+			q:    Query{Store: testresources.Bar.Name, Start: december, End: february, Product: testresources.Beer.Name},
+			want: &Statistics{
+				Totals: beer(3),
+				Products: []ProductTotals{
+					{Product: testresources.Beer.Name, Totals: beer(3)},
+				},
+				Users: []UserTotals{
+					{User: testresources.Alice.Name, Totals: beer(3)},
+				},
+				Weekdays: [7]Totals{
+					time.Tuesday: beer(1),
+					time.Friday:  beer(2),
+				},
+				Hours: [24]Totals{
+					18: beer(1),
+					20: beer(2),
+				},
+				First: time.Date(2020, time.December, 1, 18, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			desc: "Location",
+			q: Query{
+				Store:    testresources.Bar.Name,
+				Start:    december,
+				End:      january,
+				Location: time.FixedZone("UTC+4", 4*60*60),
+			},
+			want: &Statistics{
+				Totals: sum(beer(1), cocktail(1)),
+				Products: []ProductTotals{
+					{Product: testresources.Cocktail.Name, Totals: cocktail(1)},
+					{Product: testresources.Beer.Name, Totals: beer(1)},
+				},
+				Users: []UserTotals{
+					{User: testresources.Alice.Name, Totals: sum(beer(1), cocktail(1))},
+				},
+				Weekdays: [7]Totals{
+					time.Tuesday: beer(1),
+					time.Sunday:  cocktail(1),
+				},
+				Hours: [24]Totals{
+					22: beer(1),
+					1:  cocktail(1),
+				},
+				First: time.Date(2020, time.December, 1, 18, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			desc: "OtherStore",
+			q:    Query{Store: testresources.Mall.Name, Start: december, End: january},
+			want: &Statistics{
+				Totals: Totals{Quantity: 1, RevenueCents: -testresources.Jeans.FullPriceCents},
+				Products: []ProductTotals{
+					{Product: testresources.Jeans.Name, Totals: Totals{Quantity: 1, RevenueCents: -testresources.Jeans.FullPriceCents}},
+				},
+				Users: []UserTotals{
+					{User: testresources.Alice.Name, Totals: Totals{Quantity: 1, RevenueCents: -testresources.Jeans.FullPriceCents}},
+				},
+				Weekdays: [7]Totals{
+					time.Thursday: {Quantity: 1, RevenueCents: -testresources.Jeans.FullPriceCents},
+				},
+				Hours: [24]Totals{
+					12: {Quantity: 1, RevenueCents: -testresources.Jeans.FullPriceCents},
+				},
+				First: time.Date(2020, time.December, 10, 12, 0, 0, 0, time.UTC),
+			},
+		},
+	} {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := a.Query(ctx, test.q)
+			if err != nil {
+				t.Fatalf("Query(ctx, %+v) = _, %v; want nil", test.q, err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Query(ctx, %+v) (-want +got)\n%s", test.q, diff)
+			}
+		})
+	}
+}
+
+func TestAggregator_Query_Errors(t *testing.T) {
+	ctx := context.Background()
+	a := newAggregator(ctx, t, purchases.NewRepository())
+	now := time.Now()
+	if _, err := a.Query(ctx, Query{Store: "invalid", Start: now, End: now}); err == nil {
+		t.Error("Query with invalid store = _, nil; want non-nil")
+	}
+	if _, err := a.Query(ctx, Query{Store: testresources.Bar.Name, Start: now, End: now.Add(-2 * time.Hour)}); err == nil {
+		t.Error("Query with end before start = _, nil; want non-nil")
+	}
+	a.Close()
+	if _, err := a.Query(ctx, Query{Store: testresources.Bar.Name, Start: now, End: now}); err != nil {
+		t.Errorf("Query after Close without pending changes = _, %v; want nil", err)
+	}
+}
+
+// TestAggregator_Changes tests that changes to the repository are included in
+// statistics queried after the changes.
+func TestAggregator_Changes(t *testing.T) {
+	ctx := context.Background()
+	repo := purchases.NewRepository()
+	a := newAggregator(ctx, t, repo)
+	q := Query{
+		Store: testresources.Bar.Name,
+		Start: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	check := func(desc string, want Totals) {
+		t.Helper()
+		got, err := a.Query(ctx, q)
+		if err != nil {
+			t.Fatalf("%s: Query(ctx, %+v) = _, %v; want nil", desc, q, err)
+		}
+		if got.Totals != want {
+			t.Errorf("%s: Totals = %+v; want %+v", desc, got.Totals, want)
+		}
+	}
+	beer1 := -testresources.Beer.FullPriceCents
+
+	check("empty", Totals{})
+	if err := repo.CreatePurchase(ctx, testresources.Bar_Alice_Beer1); err != nil {
+		t.Fatal(err)
+	}
+	check("created", Totals{Quantity: 1, RevenueCents: beer1})
+
+	updated := purchases.Clone(testresources.Bar_Alice_Beer1)
+	updated.Lines[0].Quantity = 3
+	if err := repo.UpdatePurchase(ctx, updated); err != nil {
+		t.Fatal(err)
+	}
+	check("updated", Totals{Quantity: 3, RevenueCents: 3 * beer1})
+
+	moved := purchases.Clone(updated)
+	moved.CreateTime = timestamppb.New(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if err := repo.UpdatePurchase(ctx, moved); err != nil {
+		t.Fatal(err)
+	}
+	check("moved out of period", Totals{})
+
+	if err := repo.UpdatePurchase(ctx, updated); err != nil {
+		t.Fatal(err)
+	}
+	check("moved back", Totals{Quantity: 3, RevenueCents: 3 * beer1})
+
+	voided := purchases.Clone(updated)
+	voided.State = pb.Purchase_VOIDED
+	voided.VoidTime = timestamppb.Now()
+	if err := repo.UpdatePurchase(ctx, voided); err != nil {
+		t.Fatal(err)
+	}
+	check("voided", Totals{})
+
+	if err := repo.DeletePurchase(ctx, updated.Name); err != nil {
+		t.Fatal(err)
+	}
+	check("deleted", Totals{})
+}
+
+func TestAggregator_LineKinds(t *testing.T) {
+	ctx := context.Background()
+	refund := &pb.Purchase{
+		Name: testresources.Bar.Name + "/purchases/5d3c1e0a-7b2f-4e89-a6d1-0f4b8c2e9a73",
+		User: testresources.Alice.Name,
+		Lines: []*pb.Purchase_Line{
+			{
+				Description: testresources.Soda.DisplayName,
+				Quantity:    2,
+				PriceCents:  testresources.Soda.FullPriceCents,
+				Product:     testresources.Soda.Name,
+			},
+			{
+				Description: "Deposit: " + testresources.Soda.DisplayName,
+				Quantity:    2,
+				PriceCents:  testresources.Soda.DepositCents,
+				Product:     testresources.Soda.Name,
+				Kind:        pb.Purchase_Line_DEPOSIT,
+			},
+			{
+				Description: "Bottles",
+				Quantity:    5,
+				PriceCents:  -testresources.Soda.DepositCents,
+				Product:     testresources.Soda.Name,
+				Kind:        pb.Purchase_Line_DEPOSIT_RETURN,
+			},
+			{
+				Description:      "Refund: " + testresources.Beer.DisplayName,
+				Quantity:         1,
+				PriceCents:       -testresources.Beer.FullPriceCents,
+				Product:          testresources.Beer.Name,
+				Kind:             pb.Purchase_Line_REFUND,
+				RefundedPurchase: testresources.Bar_Alice_Beer1.Name,
+			},
+		},
+		CreateTime: testresources.Bar_Alice_Beer1.CreateTime,
+	}
+	a := newAggregator(ctx, t, purchases.SeedRepository(t, []*pb.Purchase{
+		testresources.Bar_Alice_Beer1,
+		refund,
+	}))
+	q := Query{
+		Store: testresources.Bar.Name,
+		Start: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	got, err := a.Query(ctx, q)
+	if err != nil {
+		t.Fatalf("Query(ctx, %+v) = _, %v; want nil", q, err)
+	}
+	// The beer is refunded in full, and the deposits are not counted.
+	want := Totals{Quantity: 2, RevenueCents: -2 * testresources.Soda.FullPriceCents}
+	if got.Totals != want {
+		t.Errorf("Totals = %+v; want %+v", got.Totals, want)
+	}
+}
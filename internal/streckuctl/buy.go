@@ -0,0 +1,95 @@
+package streckuctl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/products"
+	"github.com/Saser/strecku/resources/users"
+)
+
+// Buy records a purchase of quantity of product for user, who is given
+// either by resource name or by email address. The price of the product is
+// the one the user pays as a member of the store of the product: the
+// discount price if the membership has discount, and the full price
+// otherwise. Errors of the calls to the service are returned as they are, so
+// that their status is kept.
+func Buy(ctx context.Context, client pb.StreckUClient, product string, user string, quantity int32) (*pb.Purchase, error) {
+	if quantity <= 0 {
+		return nil, fmt.Errorf("buy: non-positive quantity: %d", quantity)
+	}
+	if err := products.ValidateName(product); err != nil {
+		return nil, fmt.Errorf("buy: %w", err)
+	}
+	store, err := products.Parent(product)
+	if err != nil {
+		return nil, fmt.Errorf("buy: %w", err)
+	}
+	userName, err := lookupUser(ctx, client, user)
+	if err != nil {
+		return nil, err
+	}
+	p, err := client.GetProduct(ctx, &pb.GetProductRequest{Name: product})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.ListMemberships(ctx, &pb.ListMembershipsRequest{Parent: store})
+	if err != nil {
+		return nil, err
+	}
+	var membership *pb.Membership
+	for _, m := range resp.Memberships {
+		if m.User == userName {
+			membership = m
+			break
+		}
+	}
+	if membership == nil {
+		return nil, fmt.Errorf("buy: %s is not a member of %s", userName, store)
+	}
+	price := p.FullPriceCents
+	if membership.Discount {
+		price = p.DiscountPriceCents
+	}
+	purchase, err := client.CreatePurchase(ctx, &pb.CreatePurchaseRequest{
+		Parent: store,
+		Purchase: &pb.Purchase{
+			User: userName,
+			Lines: []*pb.Purchase_Line{
+				{
+					Description: p.DisplayName,
+					Quantity:    quantity,
+					PriceCents:  price,
+					Product:     p.Name,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return purchase, nil
+}
+
+// lookupUser returns the resource name of the user, which is either a
+// resource name or an email address.
+func lookupUser(ctx context.Context, client pb.StreckUClient, user string) (string, error) {
+	if users.ValidateName(user) == nil {
+		return user, nil
+	}
+	if !strings.Contains(user, "@") {
+		return "", fmt.Errorf("buy: %q is neither a user name nor an email address", user)
+	}
+	resp, err := client.ListUsers(ctx, &pb.ListUsersRequest{})
+	if err != nil {
+		return "", err
+	}
+	for _, u := range resp.Users {
+		if strings.EqualFold(u.EmailAddress, user) {
+			return u.Name, nil
+		}
+	}
+	return "", fmt.Errorf("buy: no user has email address %q", user)
+}
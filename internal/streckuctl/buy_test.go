@@ -0,0 +1,106 @@
+package streckuctl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestBuy(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := pb.NewStreckUClient(serveAndDial(ctx, t))
+	for _, test := range []struct {
+		desc     string
+		user     string
+		quantity int32
+		want     *pb.Purchase
+	}{
+		{
+			desc:     "FullPrice",
+			user:     testresources.Alice.Name,
+			quantity: 2,
+			want: &pb.Purchase{
+				User: testresources.Alice.Name,
+				Lines: []*pb.Purchase_Line{
+					{
+						Description: testresources.Beer.DisplayName,
+						Quantity:    2,
+						PriceCents:  testresources.Beer.FullPriceCents,
+						Product:     testresources.Beer.Name,
+					},
+				},
+				State: pb.Purchase_ACTIVE,
+			},
+		},
+		{
+			desc:     "DiscountByEmailAddress",
+			user:     testresources.Bob.EmailAddress,
+			quantity: 1,
+			want: &pb.Purchase{
+				User: testresources.Bob.Name,
+				Lines: []*pb.Purchase_Line{
+					{
+						Description: testresources.Beer.DisplayName,
+						Quantity:    1,
+						PriceCents:  testresources.Beer.DiscountPriceCents,
+						Product:     testresources.Beer.Name,
+					},
+				},
+				State: pb.Purchase_ACTIVE,
+			},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := Buy(ctx, client, testresources.Beer.Name, test.user, test.quantity)
+			if err != nil {
+				t.Fatalf("Buy(ctx, client, %q, %q, %d) = %v; want nil", testresources.Beer.Name, test.user, test.quantity, err)
+			}
+			if diff := cmp.Diff(got, test.want, protocmp.Transform(), protocmp.IgnoreFields(got, "name", "create_time")); diff != "" {
+				t.Errorf("-got +want:\n%s", diff)
+			}
+			if _, err := client.GetPurchase(ctx, &pb.GetPurchaseRequest{Name: got.Name}); err != nil {
+				t.Errorf("GetPurchase(%q) = %v; want nil", got.Name, err)
+			}
+		})
+	}
+}
+
+func TestBuy_Errors(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := pb.NewStreckUClient(serveAndDial(ctx, t))
+	for _, test := range []struct {
+		desc     string
+		product  string
+		user     string
+		quantity int32
+		wantCode codes.Code
+	}{
+		{desc: "NotMember", product: testresources.Jeans.Name, user: testresources.Bob.Name, quantity: 1},
+		{desc: "ZeroQuantity", product: testresources.Beer.Name, user: testresources.Alice.Name, quantity: 0},
+		{desc: "InvalidProduct", product: testresources.Bar.Name, user: testresources.Alice.Name, quantity: 1},
+		{desc: "UnknownEmailAddress", product: testresources.Beer.Name, user: "nobody@example.com", quantity: 1},
+		{desc: "InvalidUser", product: testresources.Beer.Name, user: "alice", quantity: 1},
+		{desc: "MissingProduct", product: testresources.Cocktail.Name, user: testresources.Alice.Name, quantity: 1, wantCode: codes.NotFound},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := Buy(ctx, client, test.product, test.user, test.quantity)
+			if err == nil {
+				t.Fatalf("Buy(ctx, client, %q, %q, %d) = nil; want an error", test.product, test.user, test.quantity)
+			}
+			if test.wantCode != codes.OK {
+				if got := status.Code(err); got != test.wantCode {
+					t.Errorf("status.Code(%v) = %v; want %v", err, got, test.wantCode)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package streckuctl
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// serviceDesc describes the StreckU service. Commands are resolved against the
+// descriptor rather than the generated client, so that every method of the
+// service can be called without a command of its own.
+var serviceDesc = pb.File_saser_strecku_v1_strecku_proto.Services().ByName("StreckU")
+
+// UnknownMethodError is returned for names that are not methods of the
+// StreckU service.
+type UnknownMethodError struct {
+	Method string
+}
+
+func (e *UnknownMethodError) Error() string {
+	return fmt.Sprintf("unknown method %q", e.Method)
+}
+
+func (e *UnknownMethodError) Is(target error) bool {
+	other, ok := target.(*UnknownMethodError)
+	return ok && e.Method == other.Method
+}
+
+// Methods returns the names of the methods of the StreckU service, in the
+// order they are declared.
+func Methods() []string {
+	methods := serviceDesc.Methods()
+	names := make([]string, methods.Len())
+	for i := range names {
+		names[i] = string(methods.Get(i).Name())
+	}
+	return names
+}
+
+func lookupMethod(name string) (protoreflect.MethodDescriptor, error) {
+	md := serviceDesc.Methods().ByName(protoreflect.Name(name))
+	if md == nil {
+		return nil, &UnknownMethodError{Method: name}
+	}
+	return md, nil
+}
+
+func newMessage(d protoreflect.MessageDescriptor) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(d.FullName())
+	if err != nil {
+		return nil, err
+	}
+	return mt.New().Interface(), nil
+}
+
+// NewRequest returns a request message for the method, parsed from its
+// protojson encoding in data. An empty data gives an empty request.
+func NewRequest(method string, data []byte) (proto.Message, error) {
+	md, err := lookupMethod(method)
+	if err != nil {
+		return nil, err
+	}
+	req, err := newMessage(md.Input())
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return req, nil
+	}
+	if err := protojson.Unmarshal(data, req); err != nil {
+		return nil, fmt.Errorf("%s: invalid request: %w", method, err)
+	}
+	return req, nil
+}
+
+// Call calls the method of the StreckU service over cc and passes the
+// responses to fn in the order they are received. Unary methods have exactly
+// one response, and server streaming methods have one per message in the
+// stream. Client streaming methods are not supported.
+func Call(ctx context.Context, cc grpc.ClientConnInterface, method string, req proto.Message, fn func(proto.Message) error) error {
+	md, err := lookupMethod(method)
+	if err != nil {
+		return err
+	}
+	if md.IsStreamingClient() {
+		return fmt.Errorf("%s: client streaming methods are not supported", method)
+	}
+	fullMethod := fmt.Sprintf("/%s/%s", serviceDesc.FullName(), md.Name())
+	if !md.IsStreamingServer() {
+		resp, err := newMessage(md.Output())
+		if err != nil {
+			return err
+		}
+		if err := cc.Invoke(ctx, fullMethod, req, resp); err != nil {
+			return err
+		}
+		return fn(resp)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := cc.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, fullMethod)
+	if err != nil {
+		return err
+	}
+	if err := stream.SendMsg(req); err != nil {
+		return err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	for {
+		resp, err := newMessage(md.Output())
+		if err != nil {
+			return err
+		}
+		if err := stream.RecvMsg(resp); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := fn(resp); err != nil {
+			return err
+		}
+	}
+}
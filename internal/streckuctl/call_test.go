@@ -0,0 +1,77 @@
+package streckuctl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestMethods(t *testing.T) {
+	methods := Methods()
+	for _, want := range []string{"GetUser", "ListLockouts", "ExportStore"} {
+		found := false
+		for _, m := range methods {
+			if m == want {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Methods() = %q; want it to contain %q", methods, want)
+		}
+	}
+}
+
+func TestNewRequest(t *testing.T) {
+	got, err := NewRequest("GetUser", []byte(`{"name": "users/alice"}`))
+	if err != nil {
+		t.Fatalf("NewRequest() err = %v; want nil", err)
+	}
+	want := &pb.GetUserRequest{Name: "users/alice"}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("-got +want:\n%s", diff)
+	}
+
+	if _, err := NewRequest("GetUser", []byte(`{"nickname": "alice"}`)); err == nil {
+		t.Error("NewRequest() with an unknown field = nil; want an error")
+	}
+	wantErr := &UnknownMethodError{Method: "GetUsers"}
+	if _, err := NewRequest("GetUsers", nil); !errors.Is(err, wantErr) {
+		t.Errorf("NewRequest() err = %v; want %v", err, wantErr)
+	}
+}
+
+func TestCall(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	cc := serveAndDial(ctx, t)
+
+	var got []proto.Message
+	collect := func(m proto.Message) error {
+		got = append(got, m)
+		return nil
+	}
+
+	req := &pb.GetUserRequest{Name: testresources.Alice.Name}
+	if err := Call(ctx, cc, "GetUser", req, collect); err != nil {
+		t.Fatalf("Call(GetUser) = %v; want nil", err)
+	}
+	if diff := cmp.Diff(got, []proto.Message{testresources.Alice}, protocmp.Transform()); diff != "" {
+		t.Errorf("GetUser: -got +want:\n%s", diff)
+	}
+
+	got = nil
+	exportReq := &pb.ExportStoreRequest{Name: testresources.Bar.Name}
+	if err := Call(ctx, cc, "ExportStore", exportReq, collect); err != nil {
+		t.Fatalf("Call(ExportStore) = %v; want nil", err)
+	}
+	if len(got) == 0 {
+		t.Error("Call(ExportStore) received no responses; want at least one")
+	}
+}
@@ -0,0 +1,279 @@
+package streckuctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Usage describes the commands accepted by Run.
+const Usage = `Commands:
+  <resources> get <name>
+  <resources> list [<parent>]
+  <resources> create [-password <password>] [<parent>] <resource>
+  <resources> update [-update-mask <paths>] <resource>
+  <resources> delete [-force] <name>
+      Call the standard methods of a resource type, where <resources> is one
+      of users, cards, stores, memberships, invitations, joinrequests,
+      devices, products, pricingrules, purchases, payments and lockouts.
+      <resource> is the protojson encoding of the resource, or "-" to read it
+      from standard input. <parent> is required for cards and the resources
+      of stores. <paths> is a comma-separated list of the fields to update.
+      API keys of devices are managed using call.
+  buy [-quantity <n>] <product> <user>
+      Record a purchase of a product for a user, given by resource name or
+      email address, at the price of the user's membership.
+  call <method> [<request>]
+      Call any method of the StreckU service, with the protojson encoding of
+      the request, or "-" to read it from standard input.
+  methods
+      List the methods of the StreckU service.
+`
+
+// UsageError is returned by Run for invalid command lines.
+type UsageError struct {
+	msg string
+}
+
+func (e *UsageError) Error() string {
+	return e.msg
+}
+
+func usageErrorf(format string, a ...interface{}) error {
+	return &UsageError{msg: fmt.Sprintf(format, a...)}
+}
+
+// resource describes a resource type with standard methods.
+type resource struct {
+	plural   string // the collection ID, e.g. "memberships"
+	singular string // the name of the message, e.g. "Membership"
+	// methodPlural is the plural used in method names, if it is not plural
+	// with the first letter in upper case, e.g. "PricingRules".
+	methodPlural string
+}
+
+var resources = []resource{
+	{plural: "users", singular: "User"},
+	{plural: "cards", singular: "Card"},
+	{plural: "stores", singular: "Store"},
+	{plural: "memberships", singular: "Membership"},
+	{plural: "invitations", singular: "Invitation"},
+	{plural: "joinrequests", singular: "JoinRequest", methodPlural: "JoinRequests"},
+	{plural: "devices", singular: "Device"},
+	{plural: "products", singular: "Product"},
+	{plural: "pricingrules", singular: "PricingRule", methodPlural: "PricingRules"},
+	{plural: "purchases", singular: "Purchase"},
+	{plural: "payments", singular: "Payment"},
+	{plural: "lockouts", singular: "Lockout"},
+}
+
+// method returns the name of the standard method of r for a verb such as
+// "get", or an error if r does not have that method.
+func (r resource) method(verb string) (string, error) {
+	var method string
+	switch verb {
+	case "list":
+		plural := r.methodPlural
+		if plural == "" {
+			plural = strings.ToUpper(r.plural[:1]) + r.plural[1:]
+		}
+		method = "List" + plural
+	case "get", "create", "update", "delete":
+		method = strings.ToUpper(verb[:1]) + verb[1:] + r.singular
+	default:
+		return "", usageErrorf("%s: unknown command %q", r.plural, verb)
+	}
+	if _, err := lookupMethod(method); err != nil {
+		return "", usageErrorf("%s: unknown command %q", r.plural, verb)
+	}
+	return method, nil
+}
+
+// Run runs the command in args, described by Usage, over cc and prints the
+// results using p. Input that is given as "-" is read from stdin.
+func Run(ctx context.Context, cc grpc.ClientConnInterface, stdin io.Reader, p *Printer, args []string) error {
+	if len(args) == 0 {
+		return usageErrorf("missing command")
+	}
+	cmd, args := args[0], args[1:]
+	switch cmd {
+	case "methods":
+		if len(args) != 0 {
+			return usageErrorf("%s: unexpected arguments: %q", cmd, args)
+		}
+		for _, name := range Methods() {
+			if _, err := fmt.Fprintln(p.w, name); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "call":
+		if len(args) != 1 && len(args) != 2 {
+			return usageErrorf("%s: want a method and an optional request, got %q", cmd, args)
+		}
+		var data []byte
+		if len(args) == 2 {
+			var err error
+			if data, err = readInput(args[1], stdin); err != nil {
+				return err
+			}
+		}
+		req, err := NewRequest(args[0], data)
+		if err != nil {
+			return err
+		}
+		return Call(ctx, cc, args[0], req, p.Print)
+	case "buy":
+		fs := newFlagSet(cmd)
+		quantity := fs.Int("quantity", 1, "Number of items to buy.")
+		if err := parseFlags(fs, args); err != nil {
+			return err
+		}
+		if fs.NArg() != 2 {
+			return usageErrorf("%s: want a product and a user, got %q", cmd, fs.Args())
+		}
+		purchase, err := Buy(ctx, pb.NewStreckUClient(cc), fs.Arg(0), fs.Arg(1), int32(*quantity))
+		if err != nil {
+			return err
+		}
+		return p.Print(purchase)
+	}
+	for _, r := range resources {
+		if r.plural == cmd {
+			return runResource(ctx, cc, stdin, p, r, args)
+		}
+	}
+	return usageErrorf("unknown command %q", cmd)
+}
+
+func runResource(ctx context.Context, cc grpc.ClientConnInterface, stdin io.Reader, p *Printer, r resource, args []string) error {
+	if len(args) == 0 {
+		return usageErrorf("%s: missing command", r.plural)
+	}
+	verb, args := args[0], args[1:]
+	method, err := r.method(verb)
+	if err != nil {
+		return err
+	}
+	req, err := NewRequest(method, nil)
+	if err != nil {
+		return err
+	}
+	m := req.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	name := r.plural + " " + verb
+
+	fs := newFlagSet(name)
+	var (
+		force      *bool
+		password   *string
+		updateMask *string
+	)
+	switch verb {
+	case "delete":
+		if fields.ByName("force") != nil {
+			force = fs.Bool("force", false, "Delete the resource even if other resources refer to it.")
+		}
+	case "create":
+		if fields.ByName("password") != nil {
+			password = fs.String("password", "", "Password of the created resource.")
+		}
+	case "update":
+		updateMask = fs.String("update-mask", "", "Comma-separated list of the fields to update. If empty, all fields are updated.")
+	}
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	args = fs.Args()
+
+	// Positional arguments are consumed in the order name or parent, then
+	// the resource.
+	want := 0
+	for _, field := range []string{"name", "parent"} {
+		if fd := fields.ByName(protoreflect.Name(field)); fd != nil {
+			if len(args) <= want {
+				return usageErrorf("%s: missing %s", name, field)
+			}
+			m.Set(fd, protoreflect.ValueOfString(args[want]))
+			want++
+		}
+	}
+	resourceField := fieldOfType(m.Descriptor(), r.singular)
+	if resourceField != nil {
+		if len(args) <= want {
+			return usageErrorf("%s: missing %s", name, resourceField.Name())
+		}
+		data, err := readInput(args[want], stdin)
+		if err != nil {
+			return err
+		}
+		value := m.NewField(resourceField)
+		if err := protojson.Unmarshal(data, value.Message().Interface()); err != nil {
+			return fmt.Errorf("%s: invalid %s: %w", name, resourceField.Name(), err)
+		}
+		m.Set(resourceField, value)
+		want++
+	}
+	if len(args) != want {
+		return usageErrorf("%s: unexpected arguments: %q", name, args[want:])
+	}
+
+	if force != nil && *force {
+		m.Set(fields.ByName("force"), protoreflect.ValueOfBool(true))
+	}
+	if password != nil {
+		m.Set(fields.ByName("password"), protoreflect.ValueOfString(*password))
+	}
+	if updateMask != nil && *updateMask != "" {
+		resource := m.Get(resourceField).Message().Interface()
+		mask, err := fieldmaskpb.New(resource, strings.Split(*updateMask, ",")...)
+		if err != nil {
+			return usageErrorf("%s: invalid update mask: %v", name, err)
+		}
+		m.Set(fields.ByName("update_mask"), protoreflect.ValueOfMessage(mask.ProtoReflect()))
+	}
+	return Call(ctx, cc, method, req, p.Print)
+}
+
+// fieldOfType returns the field of desc whose type is the message with the
+// given name, or nil if there is no such field.
+func fieldOfType(desc protoreflect.MessageDescriptor, message string) protoreflect.FieldDescriptor {
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if md := fd.Message(); md != nil && string(md.Name()) == message {
+			return fd
+		}
+	}
+	return nil
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	return fs
+}
+
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return usageErrorf("%s: %v", fs.Name(), err)
+	}
+	return nil
+}
+
+// readInput returns arg, or the contents of stdin if arg is "-".
+func readInput(arg string, stdin io.Reader) ([]byte, error) {
+	if arg != "-" {
+		return []byte(arg), nil
+	}
+	return ioutil.ReadAll(stdin)
+}
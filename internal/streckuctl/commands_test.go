@@ -0,0 +1,175 @@
+package streckuctl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestRun(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	cc := serveAndDial(ctx, t)
+	client := pb.NewStreckUClient(cc)
+
+	run := func(stdin string, args ...string) string {
+		t.Helper()
+		var b strings.Builder
+		p := NewPrinter(&b, FormatJSON, nil)
+		if err := Run(ctx, cc, strings.NewReader(stdin), p, args); err != nil {
+			t.Fatalf("Run(%q) = %v; want nil", args, err)
+		}
+		return b.String()
+	}
+
+	t.Run("Get", func(t *testing.T) {
+		got := new(pb.Store)
+		if err := protojson.Unmarshal([]byte(run("", "stores", "get", testresources.Bar.Name)), got); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, testresources.Bar, protocmp.Transform()); diff != "" {
+			t.Errorf("-got +want:\n%s", diff)
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		got := new(pb.ListMembershipsResponse)
+		if err := protojson.Unmarshal([]byte(run("", "memberships", "list", testresources.Bar.Name)), got); err != nil {
+			t.Fatal(err)
+		}
+		want := &pb.ListMembershipsResponse{
+			Memberships: []*pb.Membership{testresources.Bar_Alice, barBobDiscount},
+		}
+		if diff := cmp.Diff(got, want, protocmp.Transform(), protocmp.SortRepeatedFields(want, "memberships")); diff != "" {
+			t.Errorf("-got +want:\n%s", diff)
+		}
+	})
+
+	t.Run("CreateUpdateDelete", func(t *testing.T) {
+		created := new(pb.User)
+		out := run(`{"emailAddress": "carol@example.com", "displayName": "Carol"}`, "users", "create", "-password", testresources.CarolPassword, "-")
+		if err := protojson.Unmarshal([]byte(out), created); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.GetUser(ctx, &pb.GetUserRequest{Name: created.Name}); err != nil {
+			t.Fatalf("GetUser(%q) = %v; want nil", created.Name, err)
+		}
+
+		// Only the display name is in the update mask, so the email
+		// address is left as it is.
+		run("", "users", "update", "-update-mask", "display_name", `{"name": "`+created.Name+`", "displayName": "Caroline", "emailAddress": "caroline@example.com"}`)
+		got, err := client.GetUser(ctx, &pb.GetUserRequest{Name: created.Name})
+		if err != nil {
+			t.Fatalf("GetUser(%q) = %v; want nil", created.Name, err)
+		}
+		want := &pb.User{Name: created.Name, EmailAddress: "carol@example.com", DisplayName: "Caroline"}
+		if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+			t.Errorf("-got +want:\n%s", diff)
+		}
+
+		run("", "users", "delete", created.Name)
+		_, err = client.GetUser(ctx, &pb.GetUserRequest{Name: created.Name})
+		if got, want := status.Code(err), codes.NotFound; got != want {
+			t.Errorf("GetUser(%q) code = %v; want %v", created.Name, got, want)
+		}
+	})
+
+	t.Run("Call", func(t *testing.T) {
+		got := new(pb.Product)
+		out := run("", "call", "GetProduct", `{"name": "`+testresources.Beer.Name+`"}`)
+		if err := protojson.Unmarshal([]byte(out), got); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(got, testresources.Beer, protocmp.Transform()); diff != "" {
+			t.Errorf("-got +want:\n%s", diff)
+		}
+	})
+
+	t.Run("Buy", func(t *testing.T) {
+		got := new(pb.Purchase)
+		out := run("", "buy", "-quantity", "3", testresources.Beer.Name, testresources.Bob.EmailAddress)
+		if err := protojson.Unmarshal([]byte(out), got); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := got.Lines[0].Quantity, int32(3); got != want {
+			t.Errorf("quantity = %d; want %d", got, want)
+		}
+	})
+}
+
+func TestRun_UsageErrors(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	cc := serveAndDial(ctx, t)
+	for _, args := range [][]string{
+		{},
+		{"customers", "list"},
+		{"users"},
+		{"users", "rename"},
+		{"lockouts", "get", "lockouts/1"},
+		{"users", "get"},
+		{"users", "get", testresources.Alice.Name, "extra"},
+		{"memberships", "list"},
+		{"users", "create", "-nickname", "alice", "{}"},
+		{"users", "update", "-update-mask", "nickname", "{}"},
+		{"call"},
+		{"buy", testresources.Beer.Name},
+		{"methods", "extra"},
+	} {
+		p := NewPrinter(new(strings.Builder), FormatTable, nil)
+		err := Run(ctx, cc, strings.NewReader(""), p, args)
+		if usageErr := new(UsageError); !errors.As(err, &usageErr) {
+			t.Errorf("Run(%q) = %v; want a usage error", args, err)
+		}
+	}
+}
+
+func TestResource_Method(t *testing.T) {
+	for _, test := range []struct {
+		r    resource
+		verb string
+		want string
+	}{
+		{r: resource{plural: "products", singular: "Product"}, verb: "list", want: "ListProducts"},
+		{r: resource{plural: "products", singular: "Product"}, verb: "get", want: "GetProduct"},
+		{r: resource{plural: "pricingrules", singular: "PricingRule", methodPlural: "PricingRules"}, verb: "list", want: "ListPricingRules"},
+		{r: resource{plural: "pricingrules", singular: "PricingRule", methodPlural: "PricingRules"}, verb: "delete", want: "DeletePricingRule"},
+		{r: resource{plural: "joinrequests", singular: "JoinRequest", methodPlural: "JoinRequests"}, verb: "list", want: "ListJoinRequests"},
+		{r: resource{plural: "joinrequests", singular: "JoinRequest", methodPlural: "JoinRequests"}, verb: "create", want: "CreateJoinRequest"},
+	} {
+		got, err := test.r.method(test.verb)
+		if err != nil {
+			t.Errorf("%s.method(%q) err = %v; want nil", test.r.plural, test.verb, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%s.method(%q) = %q; want %q", test.r.plural, test.verb, got, test.want)
+		}
+	}
+}
+
+func TestResources(t *testing.T) {
+	plurals := make(map[string]bool)
+	for _, r := range resources {
+		plurals[r.plural] = true
+		// Every resource type can at least be listed.
+		if _, err := r.method("list"); err != nil {
+			t.Errorf("%s.method(%q) err = %v; want nil", r.plural, "list", err)
+		}
+	}
+	for _, plural := range []string{"invitations", "joinrequests"} {
+		if !plurals[plural] {
+			t.Errorf("resources does not contain %q", plural)
+		}
+	}
+}
@@ -0,0 +1,396 @@
+package streckuctl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+// Format is the format that a Printer prints messages in.
+type Format int
+
+const (
+	// FormatTable prints messages as rows of a table with one column per
+	// field. The resources of list responses are printed as one row each.
+	FormatTable Format = iota
+	// FormatJSON prints messages in their protojson encoding.
+	FormatJSON
+	// FormatYAML prints messages as YAML documents with the same structure
+	// as their protojson encoding.
+	FormatYAML
+)
+
+var formatNames = []string{
+	FormatTable: "table",
+	FormatJSON:  "json",
+	FormatYAML:  "yaml",
+}
+
+func (f Format) String() string {
+	if f < 0 || int(f) >= len(formatNames) {
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+	return formatNames[f]
+}
+
+// UnknownFormatError is returned by ParseFormat for unknown format names.
+type UnknownFormatError struct {
+	Name string
+}
+
+func (e *UnknownFormatError) Error() string {
+	return fmt.Sprintf("unknown output format %q; want one of %s", e.Name, strings.Join(formatNames, ", "))
+}
+
+func (e *UnknownFormatError) Is(target error) bool {
+	other, ok := target.(*UnknownFormatError)
+	return ok && e.Name == other.Name
+}
+
+// ParseFormat returns the format with the given name, such as "table".
+func ParseFormat(name string) (Format, error) {
+	for f, n := range formatNames {
+		if strings.EqualFold(name, n) {
+			return Format(f), nil
+		}
+	}
+	return 0, &UnknownFormatError{Name: name}
+}
+
+// UnknownFieldError is returned by Printer.Print when a selected field does
+// not exist in the printed messages.
+type UnknownFieldError struct {
+	Message string
+	Field   string
+}
+
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("%s has no field %q", e.Message, e.Field)
+}
+
+func (e *UnknownFieldError) Is(target error) bool {
+	other, ok := target.(*UnknownFieldError)
+	return ok && e.Message == other.Message && e.Field == other.Field
+}
+
+var marshalOptions = protojson.MarshalOptions{
+	EmitUnpopulated: true,
+}
+
+// Printer prints messages to a writer.
+type Printer struct {
+	w      io.Writer
+	format Format
+	fields []string
+
+	printed bool   // whether a message has been printed
+	header  string // the last header printed in FormatTable
+}
+
+// NewPrinter returns a printer that prints messages to w in the given format.
+// If fields is not empty, only the fields with those names are printed, in
+// that order for FormatTable. For list responses, the fields select among
+// the fields of the listed resources.
+func NewPrinter(w io.Writer, format Format, fields []string) *Printer {
+	return &Printer{
+		w:      w,
+		format: format,
+		fields: fields,
+	}
+}
+
+// Print prints m. In FormatTable, the header is only printed again if it
+// differs from the previous one, so that the messages of a stream form a
+// single table.
+func (p *Printer) Print(m proto.Message) error {
+	defer func() { p.printed = true }()
+	switch p.format {
+	case FormatTable:
+		return p.printTable(m)
+	case FormatJSON:
+		b, err := p.marshal(m)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(p.w, "%s\n", b)
+		return err
+	case FormatYAML:
+		b, err := p.marshal(m)
+		if err != nil {
+			return err
+		}
+		var node yaml.Node
+		if err := yaml.Unmarshal(b, &node); err != nil {
+			return err
+		}
+		clearStyle(&node)
+		out, err := yaml.Marshal(&node)
+		if err != nil {
+			return err
+		}
+		if p.printed {
+			if _, err := io.WriteString(p.w, "---\n"); err != nil {
+				return err
+			}
+		}
+		_, err = p.w.Write(out)
+		return err
+	default:
+		return fmt.Errorf("print: unknown format %v", p.format)
+	}
+}
+
+// marshal returns the indented protojson encoding of m, with only the
+// selected fields. The fields are selected in the encoding rather than in m,
+// since protojson emits unpopulated fields whether they were cleared or not.
+func (p *Printer) marshal(m proto.Message) ([]byte, error) {
+	b, err := marshalOptions.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	if len(p.fields) > 0 {
+		desc, list, _ := rows(m.ProtoReflect())
+		selected, err := p.selectFields(desc)
+		if err != nil {
+			return nil, err
+		}
+		keys := make([]string, len(selected))
+		for i, fd := range selected {
+			keys[i] = fd.JSONName()
+		}
+		if list == nil {
+			b, err = selectKeys(b, keys)
+		} else {
+			b, err = selectListKeys(b, m.ProtoReflect().Descriptor(), list, keys)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, b, "", "  "); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// selectKeys returns the JSON object in b with only the given keys, in that
+// order.
+func selectKeys(b []byte, keys []string) ([]byte, error) {
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(b, &values); err != nil {
+		return nil, err
+	}
+	return object(keys, values), nil
+}
+
+// selectListKeys is like selectKeys, but selects the keys of the elements of
+// the list field of a list response in b, whose message is described by desc.
+func selectListKeys(b []byte, desc protoreflect.MessageDescriptor, list protoreflect.FieldDescriptor, keys []string) ([]byte, error) {
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(b, &values); err != nil {
+		return nil, err
+	}
+	var elems []json.RawMessage
+	if err := json.Unmarshal(values[list.JSONName()], &elems); err != nil {
+		return nil, err
+	}
+	for i, elem := range elems {
+		selected, err := selectKeys(elem, keys)
+		if err != nil {
+			return nil, err
+		}
+		elems[i] = selected
+	}
+	listValue, err := json.Marshal(elems)
+	if err != nil {
+		return nil, err
+	}
+	values[list.JSONName()] = listValue
+	fields := desc.Fields()
+	allKeys := make([]string, fields.Len())
+	for i := range allKeys {
+		allKeys[i] = fields.Get(i).JSONName()
+	}
+	return object(allKeys, values), nil
+}
+
+// object returns a JSON object of the given keys that are present in values,
+// in that order.
+func object(keys []string, values map[string]json.RawMessage) []byte {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	for _, key := range keys {
+		value, ok := values[key]
+		if !ok {
+			continue
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		k, _ := json.Marshal(key)
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes()
+}
+
+func (p *Printer) printTable(m proto.Message) error {
+	desc, _, rows := rows(m.ProtoReflect())
+	columns, err := p.selectFields(desc)
+	if err != nil {
+		return err
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+	tw := tabwriter.NewWriter(p.w, 0, 8, 2, ' ', 0)
+	names := make([]string, len(columns))
+	for i, fd := range columns {
+		names[i] = strings.ToUpper(string(fd.Name()))
+	}
+	if header := strings.Join(names, "\t"); header != p.header {
+		fmt.Fprintln(tw, header)
+		p.header = header
+	}
+	for _, row := range rows {
+		cells := make([]string, len(columns))
+		for i, fd := range columns {
+			cells[i] = cell(row, fd)
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+// selectFields returns the fields of desc that p prints.
+func (p *Printer) selectFields(desc protoreflect.MessageDescriptor) ([]protoreflect.FieldDescriptor, error) {
+	all := desc.Fields()
+	if len(p.fields) == 0 {
+		fields := make([]protoreflect.FieldDescriptor, all.Len())
+		for i := range fields {
+			fields[i] = all.Get(i)
+		}
+		return fields, nil
+	}
+	fields := make([]protoreflect.FieldDescriptor, len(p.fields))
+	for i, name := range p.fields {
+		fd := all.ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = all.ByJSONName(name)
+		}
+		if fd == nil {
+			return nil, &UnknownFieldError{Message: string(desc.Name()), Field: name}
+		}
+		fields[i] = fd
+	}
+	return fields, nil
+}
+
+// rows returns the messages that m consists of when printed as a table. For
+// list responses, which are recognized by their names, the rows are the
+// resources in the list field; otherwise m is the only row and the list
+// field is nil. The returned descriptor is that of the rows, which is known
+// even if there are none.
+func rows(m protoreflect.Message) (protoreflect.MessageDescriptor, protoreflect.FieldDescriptor, []protoreflect.Message) {
+	desc := m.Descriptor()
+	name := string(desc.Name())
+	if strings.HasPrefix(name, "List") && strings.HasSuffix(name, "Response") {
+		fields := desc.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if !fd.IsList() || fd.Message() == nil {
+				continue
+			}
+			list := m.Get(fd).List()
+			rows := make([]protoreflect.Message, list.Len())
+			for j := range rows {
+				rows[j] = list.Get(j).Message()
+			}
+			return fd.Message(), fd, rows
+		}
+	}
+	return desc, nil, []protoreflect.Message{m}
+}
+
+// cell formats the value of the field fd of m as the cell of a table.
+// Repeated message fields, which do not fit in a cell, are summarized by
+// their length.
+func cell(m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+	v := m.Get(fd)
+	switch {
+	case fd.IsMap():
+		return strconv.Itoa(v.Map().Len())
+	case fd.IsList():
+		list := v.List()
+		if fd.Message() != nil {
+			return strconv.Itoa(list.Len())
+		}
+		values := make([]string, list.Len())
+		for i := range values {
+			values[i] = scalar(fd, list.Get(i))
+		}
+		return strings.Join(values, ",")
+	case fd.Message() != nil:
+		if !m.Has(fd) {
+			return ""
+		}
+		msg := v.Message()
+		if msg.Descriptor().FullName() == "google.protobuf.Timestamp" {
+			fields := msg.Descriptor().Fields()
+			seconds := msg.Get(fields.ByName("seconds")).Int()
+			nanos := msg.Get(fields.ByName("nanos")).Int()
+			return time.Unix(seconds, nanos).UTC().Format(time.RFC3339)
+		}
+		b, err := protojson.Marshal(msg.Interface())
+		if err != nil {
+			return fmt.Sprintf("<%v>", err)
+		}
+		return string(b)
+	default:
+		return scalar(fd, v)
+	}
+}
+
+func scalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return strconv.Itoa(int(v.Enum()))
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("%d bytes", len(v.Bytes()))
+	case protoreflect.StringKind:
+		s := v.String()
+		if strings.ContainsAny(s, "\t\r\n") {
+			return strconv.Quote(s)
+		}
+		return s
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+// clearStyle makes the YAML encoding of a node parsed from JSON use the
+// block style, and only quote strings where YAML requires it.
+func clearStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearStyle(child)
+	}
+}
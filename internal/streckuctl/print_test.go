@@ -0,0 +1,156 @@
+package streckuctl
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestParseFormat(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		want Format
+	}{
+		{name: "table", want: FormatTable},
+		{name: "json", want: FormatJSON},
+		{name: "YAML", want: FormatYAML},
+	} {
+		got, err := ParseFormat(test.name)
+		if err != nil {
+			t.Errorf("ParseFormat(%q) err = %v; want nil", test.name, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParseFormat(%q) = %v; want %v", test.name, got, test.want)
+		}
+	}
+	want := &UnknownFormatError{Name: "xml"}
+	if _, err := ParseFormat("xml"); !errors.Is(err, want) {
+		t.Errorf("ParseFormat(%q) err = %v; want %v", "xml", err, want)
+	}
+}
+
+func TestPrinter_Print(t *testing.T) {
+	list := &pb.ListStoresResponse{
+		Stores: []*pb.Store{testresources.Bar, testresources.Mall},
+	}
+	for _, test := range []struct {
+		desc   string
+		format Format
+		fields []string
+		msgs   []proto.Message
+		want   string
+	}{
+		{
+			desc:   "Table",
+			format: FormatTable,
+			msgs:   []proto.Message{testresources.Bar_Alice_Beer1},
+			want: `NAME                                                                                        USER                                        LINES  CREATE_TIME           STATE              VOID_TIME  DEVICE
+stores/24a63d85-517b-4f37-9191-7d4d2deee0a7/purchases/8e386dfa-1085-4d0d-99a1-33540cec25c3  users/6f2d193c-1460-491d-8157-7dd9535526c6  1      2020-12-01T18:00:00Z  STATE_UNSPECIFIED             
+`,
+		},
+		{
+			desc:   "TableList",
+			format: FormatTable,
+			fields: []string{"display_name", "swishNumber"},
+			msgs:   []proto.Message{list},
+			want: `DISPLAY_NAME  SWISH_NUMBER
+Bar           1231234567
+Mall          
+`,
+		},
+		{
+			desc:   "TableEmptyList",
+			format: FormatTable,
+			fields: []string{"name"},
+			msgs:   []proto.Message{new(pb.ListStoresResponse)},
+			want:   "NAME\n",
+		},
+		{
+			desc:   "TableHeaderOnce",
+			format: FormatTable,
+			fields: []string{"display_name"},
+			msgs:   []proto.Message{testresources.Bar, testresources.Mall},
+			want:   "DISPLAY_NAME\nBar\nMall\n",
+		},
+		{
+			desc:   "TableEmpty",
+			format: FormatTable,
+			msgs:   []proto.Message{new(emptypb.Empty)},
+			want:   "",
+		},
+		{
+			desc:   "JSON",
+			format: FormatJSON,
+			msgs:   []proto.Message{testresources.Mall},
+			want: `{
+  "name": "stores/5b5afbea-76de-4c4a-bafd-a56ff56122f1",
+  "displayName": "Mall",
+  "swishNumber": "",
+  "visibility": "UNLISTED",
+  "joinPolicy": "OPEN",
+  "defaultDiscount": true,
+  "purchaseUndoWindow": null
+}
+`,
+		},
+		{
+			desc:   "JSONFields",
+			format: FormatJSON,
+			fields: []string{"display_name"},
+			msgs:   []proto.Message{list},
+			want: `{
+  "stores": [
+    {
+      "displayName": "Bar"
+    },
+    {
+      "displayName": "Mall"
+    }
+  ],
+  "nextPageToken": ""
+}
+`,
+		},
+		{
+			desc:   "YAML",
+			format: FormatYAML,
+			fields: []string{"swish_number", "name"},
+			msgs:   []proto.Message{testresources.Bar, testresources.Mall},
+			want: `swishNumber: "1231234567"
+name: stores/24a63d85-517b-4f37-9191-7d4d2deee0a7
+---
+swishNumber: ""
+name: stores/5b5afbea-76de-4c4a-bafd-a56ff56122f1
+`,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			var b strings.Builder
+			p := NewPrinter(&b, test.format, test.fields)
+			for _, m := range test.msgs {
+				if err := p.Print(m); err != nil {
+					t.Fatalf("Print(%v) = %v; want nil", m, err)
+				}
+			}
+			if got := b.String(); got != test.want {
+				t.Errorf("printed\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPrinter_Print_UnknownField(t *testing.T) {
+	for _, format := range []Format{FormatTable, FormatJSON, FormatYAML} {
+		p := NewPrinter(new(strings.Builder), format, []string{"price"})
+		want := &UnknownFieldError{Message: "Store", Field: "price"}
+		if err := p.Print(&pb.ListStoresResponse{}); !errors.Is(err, want) {
+			t.Errorf("%v: Print() = %v; want %v", format, err, want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package streckuctl
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/lockout"
+	"github.com/Saser/strecku/internal/mail"
+	"github.com/Saser/strecku/internal/repositories"
+	"github.com/Saser/strecku/internal/service"
+	"github.com/Saser/strecku/internal/statistics"
+	"github.com/Saser/strecku/internal/tokens"
+	"github.com/Saser/strecku/resources/stores/devices"
+	"github.com/Saser/strecku/resources/stores/devices/apikeys"
+	"github.com/Saser/strecku/resources/stores/invitations"
+	"github.com/Saser/strecku/resources/stores/joinrequests"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"github.com/Saser/strecku/resources/stores/payments"
+	"github.com/Saser/strecku/resources/stores/pricingrules"
+	"github.com/Saser/strecku/resources/stores/products"
+	"github.com/Saser/strecku/resources/stores/purchases"
+	"github.com/Saser/strecku/resources/users/cards"
+	"github.com/Saser/strecku/testresources"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+const bufSize = 1024 * 1024
+
+// barBobDiscount is the membership of Bob in Bar, but with discount.
+var barBobDiscount = func() *pb.Membership {
+	m := proto.Clone(testresources.Bar_Bob).(*pb.Membership)
+	m.Discount = true
+	return m
+}()
+
+// serveAndDial serves a service seeded with test resources and returns a
+// connection to it.
+func serveAndDial(ctx context.Context, t *testing.T) *grpc.ClientConn {
+	t.Helper()
+	userRepo := repositories.NewInMemoryUsers()
+	repositories.SeedUsers(
+		ctx,
+		t,
+		userRepo,
+		[]*pb.User{testresources.Alice, testresources.Bob},
+		[]string{testresources.AlicePassword, testresources.BobPassword},
+	)
+	storeRepo := repositories.NewInMemoryStores()
+	repositories.SeedStores(ctx, t, storeRepo, []*pb.Store{testresources.Bar, testresources.Mall})
+	purchaseRepo := purchases.SeedRepository(t, []*pb.Purchase{testresources.Bar_Alice_Beer1})
+	aggregator, err := statistics.NewAggregator(ctx, purchaseRepo)
+	if err != nil {
+		t.Fatalf("statistics.NewAggregator(ctx, purchaseRepo) = _, %v; want nil", err)
+	}
+	t.Cleanup(aggregator.Close)
+	svc := service.New(
+		userRepo,
+		storeRepo,
+		memberships.SeedRepository(t, []*pb.Membership{testresources.Bar_Alice, barBobDiscount}),
+		products.SeedRepository(t, []*pb.Product{testresources.Beer, testresources.Jeans}),
+		purchaseRepo,
+		payments.SeedRepository(t, []*pb.Payment{testresources.Bar_Alice_Payment}),
+		invitations.NewRepository(),
+		joinrequests.NewRepository(),
+		devices.NewRepository(),
+		apikeys.NewRepository(),
+		cards.NewRepository(),
+		pricingrules.NewRepository(),
+		lockout.NewGuard(),
+		aggregator,
+		new(mail.Memory),
+		tokens.NewStore(),
+	)
+
+	srv := grpc.NewServer()
+	pb.RegisterStreckUServer(srv, svc)
+	lis := bufconn.Listen(bufSize)
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			t.Errorf("srv.Serve(%v) = %v; want nil", lis, err)
+		}
+	}()
+	t.Cleanup(srv.GracefulStop)
+	dial := func(context.Context, string) (net.Conn, error) { return lis.Dial() }
+	cc, err := grpc.DialContext(
+		ctx,
+		"bufconn",
+		grpc.WithBlock(),
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(dial),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { cc.Close() })
+	return cc
+}
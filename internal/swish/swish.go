@@ -0,0 +1,94 @@
+package swish
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Saser/strecku/resources/stores"
+	"github.com/skip2/go-qrcode"
+)
+
+// MaxMessageLength is the maximum length of the message of a Swish payment.
+const MaxMessageLength = 50
+
+var (
+	ErrAmountNotPositive = errors.New("amount is not positive")
+	ErrMessageTooLong    = fmt.Errorf("message is longer than %d characters", MaxMessageLength)
+	ErrMessageInvalid    = errors.New("message contains a semicolon")
+	ErrSizeInvalid       = errors.New("image size is not positive")
+)
+
+// Payload returns the text to encode in a QR code that prefills a payment of
+// the given amount and message to the given Swish number. None of the fields
+// can be edited by the payer.
+func Payload(payee string, amountCents int64, message string) (string, error) {
+	if err := stores.ValidateSwishNumber(payee); err != nil {
+		return "", err
+	}
+	if amountCents <= 0 {
+		return "", ErrAmountNotPositive
+	}
+	if len([]rune(message)) > MaxMessageLength {
+		return "", ErrMessageTooLong
+	}
+	if strings.Contains(message, ";") {
+		return "", ErrMessageInvalid
+	}
+	// The format is "C<payee>;<amount>;<message>;<editable>", where editable
+	// is a bit mask of the fields the payer can change, with 1 for the payee,
+	// 2 for the amount and 4 for the message.
+	return fmt.Sprintf("C%s;%s;%s;0", payee, formatAmount(amountCents), message), nil
+}
+
+// formatAmount formats a positive amount in cents as a decimal number, such as
+// "12.50".
+func formatAmount(cents int64) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
+
+// PNG returns the QR code encoding payload as a PNG image with the given width
+// and height in pixels.
+func PNG(payload string, size int) ([]byte, error) {
+	if size <= 0 {
+		return nil, ErrSizeInvalid
+	}
+	return qrcode.Encode(payload, qrcode.Medium, size)
+}
+
+// SVG returns the QR code encoding payload as an SVG image with the given width
+// and height in pixels.
+func SVG(payload string, size int) ([]byte, error) {
+	if size <= 0 {
+		return nil, ErrSizeInvalid
+	}
+	qr, err := qrcode.New(payload, qrcode.Medium)
+	if err != nil {
+		return nil, err
+	}
+	// The bitmap includes the quiet zone around the code, so the image is
+	// drawn with one unit per module.
+	bitmap := qr.Bitmap()
+	n := len(bitmap)
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size, n, n)
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="#ffffff"/><path fill="#000000" d="`, n, n)
+	for y, row := range bitmap {
+		// Consecutive dark modules are drawn as a single rectangle.
+		for x := 0; x < len(row); {
+			if !row[x] {
+				x++
+				continue
+			}
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+			fmt.Fprintf(&buf, "M%d %dh%dv1h-%dz", start, y, x-start, x-start)
+		}
+	}
+	buf.WriteString(`"/></svg>`)
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
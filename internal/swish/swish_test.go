@@ -0,0 +1,112 @@
+package swish
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/Saser/strecku/resources/stores"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestPayload(t *testing.T) {
+	for _, test := range []struct {
+		desc        string
+		payee       string
+		amountCents int64
+		message     string
+		want        string
+		wantErr     error
+	}{
+		{
+			desc:        "OK",
+			payee:       "1231234567",
+			amountCents: 15050,
+			message:     "3799849742854556",
+			want:        "C1231234567;150.50;3799849742854556;0",
+		},
+		{
+			desc:        "WholeAmount",
+			payee:       "1231234567",
+			amountCents: 100,
+			message:     "",
+			want:        "C1231234567;1.00;;0",
+		},
+		{
+			desc:        "InvalidPayee",
+			payee:       "123",
+			amountCents: 100,
+			wantErr:     stores.ErrSwishNumberInvalid,
+		},
+		{
+			desc:        "ZeroAmount",
+			payee:       "1231234567",
+			amountCents: 0,
+			wantErr:     ErrAmountNotPositive,
+		},
+		{
+			desc:        "MessageTooLong",
+			payee:       "1231234567",
+			amountCents: 100,
+			message:     strings.Repeat("a", MaxMessageLength+1),
+			wantErr:     ErrMessageTooLong,
+		},
+		{
+			desc:        "MessageWithSemicolon",
+			payee:       "1231234567",
+			amountCents: 100,
+			message:     "a;b",
+			wantErr:     ErrMessageInvalid,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := Payload(test.payee, test.amountCents, test.message)
+			if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+				t.Fatalf("Payload(%q, %v, %q) err = %v; want %v", test.payee, test.amountCents, test.message, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("Payload(%q, %v, %q) = %q; want %q", test.payee, test.amountCents, test.message, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPNG(t *testing.T) {
+	const size = 256
+	data, err := PNG("C1231234567;150.50;3799849742854556;0", size)
+	if err != nil {
+		t.Fatalf("PNG(...) err = %v; want nil", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("png.Decode(...) err = %v; want nil", err)
+	}
+	if b := img.Bounds(); b.Dx() != size || b.Dy() != size {
+		t.Errorf("image bounds = %v; want %dx%d", b, size, size)
+	}
+	if _, err := PNG("payload", 0); !cmp.Equal(err, ErrSizeInvalid, cmpopts.EquateErrors()) {
+		t.Errorf("PNG(%q, 0) err = %v; want %v", "payload", err, ErrSizeInvalid)
+	}
+}
+
+func TestSVG(t *testing.T) {
+	data, err := SVG("C1231234567;150.50;3799849742854556;0", 256)
+	if err != nil {
+		t.Fatalf("SVG(...) err = %v; want nil", err)
+	}
+	svg := string(data)
+	for _, want := range []string{
+		`<svg xmlns="http://www.w3.org/2000/svg" width="256" height="256"`,
+		`<path fill="#000000" d="M`,
+		"</svg>\n",
+	} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("SVG(...) = %q; want it to contain %q", svg, want)
+		}
+	}
+	if _, err := SVG("payload", -1); !cmp.Equal(err, ErrSizeInvalid, cmpopts.EquateErrors()) {
+		t.Errorf("SVG(%q, -1) err = %v; want %v", "payload", err, ErrSizeInvalid)
+	}
+}
@@ -24,7 +24,9 @@ func retryConnString(ctx context.Context, tdb *TestDatabase) (string, error) {
 	return connString, nil
 }
 
-func DB(ctx context.Context, t *testing.T, migrationsPath string) *sql.DB {
+// ConnString starts a database with all migrations in migrationsPath applied,
+// which is stopped when the test ends, and returns its connection string.
+func ConnString(ctx context.Context, t *testing.T, migrationsPath string) string {
 	t.Helper()
 	tdb := New(migrationsPath)
 	ctx, cancel := context.WithCancel(ctx)
@@ -42,6 +44,12 @@ func DB(ctx context.Context, t *testing.T, migrationsPath string) *sql.DB {
 	if err != nil {
 		t.Fatalf("ConnString(ctx, tdb) err = %v; want nil", err)
 	}
+	return connString
+}
+
+func DB(ctx context.Context, t *testing.T, migrationsPath string) *sql.DB {
+	t.Helper()
+	connString := ConnString(ctx, t, migrationsPath)
 	db, err := database.Open(ctx, connString)
 	if err != nil {
 		t.Fatalf("database.Open(ctx, %q) err = %v; want nil", connString, err)
@@ -0,0 +1,129 @@
+package tokens
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrInvalidToken is returned when using a token that was never issued,
+// has expired, has already been redeemed or was issued for another purpose.
+// The cases are not told apart, so that callers cannot probe for tokens.
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+// Purpose is what a token can be redeemed for.
+type Purpose int
+
+const (
+	PasswordReset Purpose = iota + 1
+	EmailVerification
+	// KioskPurchase tokens let a kiosk create purchases for a member, after
+	// the member identified themselves with a card and PIN. They are looked
+	// up rather than redeemed, so they can be used until they expire.
+	KioskPurchase
+)
+
+// Grant is what a token grants to whoever redeems it.
+type Grant struct {
+	Purpose Purpose
+	// User is the resource name of the user that the token was issued for.
+	User string
+	// EmailAddress is the email address that the token was sent to.
+	EmailAddress string
+	// Device is the resource name of the device that the token was issued
+	// to, if any.
+	Device string
+	// Membership is the resource name of the membership that the token was
+	// issued for, if any.
+	Membership string
+}
+
+type entry struct {
+	Grant
+	expires time.Time
+}
+
+// Store issues and redeems single-use tokens that expire after a while. Only
+// hashes of the tokens are kept, so the tokens cannot be recovered from the
+// store.
+type Store struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]entry
+}
+
+// NewStore returns an empty store.
+func NewStore() *Store {
+	return &Store{
+		now:     time.Now,
+		entries: make(map[[sha256.Size]byte]entry),
+	}
+}
+
+// Issue returns a new token for g that expires after ttl.
+func (s *Store) Issue(g Grant, ttl time.Duration) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.purge()
+	s.entries[sha256.Sum256([]byte(token))] = entry{
+		Grant:   g,
+		expires: s.now().Add(ttl),
+	}
+	return token, nil
+}
+
+// Redeem returns the grant of token, which must have been issued for the
+// given purpose, and makes the token invalid.
+func (s *Store) Redeem(purpose Purpose, token string) (Grant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := sha256.Sum256([]byte(token))
+	e, ok := s.entries[key]
+	if !ok || e.Purpose != purpose || !s.now().Before(e.expires) {
+		return Grant{}, ErrInvalidToken
+	}
+	delete(s.entries, key)
+	return e.Grant, nil
+}
+
+// Lookup returns the grant of token, which must have been issued for the
+// given purpose. Unlike Redeem, the token stays valid until it expires.
+func (s *Store) Lookup(purpose Purpose, token string) (Grant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[sha256.Sum256([]byte(token))]
+	if !ok || e.Purpose != purpose || !s.now().Before(e.expires) {
+		return Grant{}, ErrInvalidToken
+	}
+	return e.Grant, nil
+}
+
+// Revoke makes every token issued to the user for the given purpose invalid.
+func (s *Store) Revoke(purpose Purpose, user string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, e := range s.entries {
+		if e.Purpose == purpose && e.User == user {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// purge removes expired tokens. s.mu must be held.
+func (s *Store) purge() {
+	now := s.now()
+	for key, e := range s.entries {
+		if !now.Before(e.expires) {
+			delete(s.entries, key)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package tokens
+
+import (
+	"testing"
+	"time"
+)
+
+var (
+	alice = Grant{Purpose: PasswordReset, User: "users/alice", EmailAddress: "alice@example.com"}
+	bob   = Grant{Purpose: PasswordReset, User: "users/bob", EmailAddress: "bob@example.com"}
+)
+
+func issue(t *testing.T, s *Store, g Grant, ttl time.Duration) string {
+	t.Helper()
+	token, err := s.Issue(g, ttl)
+	if err != nil {
+		t.Fatalf("s.Issue(%+v, %v) = _, %v; want nil", g, ttl, err)
+	}
+	return token
+}
+
+func TestStore_Redeem(t *testing.T) {
+	s := NewStore()
+	token := issue(t, s, alice, time.Hour)
+	if other := issue(t, s, alice, time.Hour); other == token {
+		t.Errorf("s.Issue returned %q twice", token)
+	}
+	if _, err := s.Redeem(EmailVerification, token); err != ErrInvalidToken {
+		t.Errorf("s.Redeem(EmailVerification, token) = _, %v; want %v", err, ErrInvalidToken)
+	}
+	g, err := s.Redeem(PasswordReset, token)
+	if err != nil {
+		t.Fatalf("s.Redeem(PasswordReset, token) = _, %v; want nil", err)
+	}
+	if g != alice {
+		t.Errorf("s.Redeem(PasswordReset, token) = %+v; want %+v", g, alice)
+	}
+	if _, err := s.Redeem(PasswordReset, token); err != ErrInvalidToken {
+		t.Errorf("second s.Redeem(PasswordReset, token) = _, %v; want %v", err, ErrInvalidToken)
+	}
+	if _, err := s.Redeem(PasswordReset, "never issued"); err != ErrInvalidToken {
+		t.Errorf("s.Redeem(PasswordReset, %q) = _, %v; want %v", "never issued", err, ErrInvalidToken)
+	}
+}
+
+func TestStore_Lookup(t *testing.T) {
+	now := time.Date(2021, time.January, 1, 12, 0, 0, 0, time.UTC)
+	s := NewStore()
+	s.now = func() time.Time { return now }
+	kiosk := Grant{Purpose: KioskPurchase, User: "users/alice", Device: "stores/bar/devices/kiosk", Membership: "stores/bar/memberships/alice"}
+	token := issue(t, s, kiosk, time.Minute)
+	for i := 0; i < 2; i++ {
+		g, err := s.Lookup(KioskPurchase, token)
+		if err != nil {
+			t.Fatalf("lookup %d: s.Lookup(KioskPurchase, token) = _, %v; want nil", i, err)
+		}
+		if g != kiosk {
+			t.Errorf("lookup %d: s.Lookup(KioskPurchase, token) = %+v; want %+v", i, g, kiosk)
+		}
+	}
+	if _, err := s.Lookup(PasswordReset, token); err != ErrInvalidToken {
+		t.Errorf("s.Lookup(PasswordReset, token) = _, %v; want %v", err, ErrInvalidToken)
+	}
+	if _, err := s.Lookup(KioskPurchase, "never issued"); err != ErrInvalidToken {
+		t.Errorf("s.Lookup(KioskPurchase, %q) = _, %v; want %v", "never issued", err, ErrInvalidToken)
+	}
+	now = now.Add(time.Minute)
+	if _, err := s.Lookup(KioskPurchase, token); err != ErrInvalidToken {
+		t.Errorf("s.Lookup(KioskPurchase, token) after expiry = _, %v; want %v", err, ErrInvalidToken)
+	}
+}
+
+func TestStore_Expiry(t *testing.T) {
+	now := time.Date(2021, time.January, 1, 12, 0, 0, 0, time.UTC)
+	s := NewStore()
+	s.now = func() time.Time { return now }
+	token := issue(t, s, alice, time.Hour)
+	now = now.Add(time.Hour)
+	if _, err := s.Redeem(PasswordReset, token); err != ErrInvalidToken {
+		t.Errorf("s.Redeem(PasswordReset, token) after expiry = _, %v; want %v", err, ErrInvalidToken)
+	}
+	issue(t, s, bob, time.Hour)
+	if got, want := len(s.entries), 1; got != want {
+		t.Errorf("len(s.entries) = %d after issuing following expiry; want %d", got, want)
+	}
+}
+
+func TestStore_Revoke(t *testing.T) {
+	s := NewStore()
+	aliceToken := issue(t, s, alice, time.Hour)
+	bobToken := issue(t, s, bob, time.Hour)
+	s.Revoke(PasswordReset, alice.User)
+	if _, err := s.Redeem(PasswordReset, aliceToken); err != ErrInvalidToken {
+		t.Errorf("s.Redeem(PasswordReset, aliceToken) after revoking = _, %v; want %v", err, ErrInvalidToken)
+	}
+	if _, err := s.Redeem(PasswordReset, bobToken); err != nil {
+		t.Errorf("s.Redeem(PasswordReset, bobToken) = _, %v; want nil", err)
+	}
+}
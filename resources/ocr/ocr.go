@@ -0,0 +1,104 @@
+package ocr
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/google/uuid"
+)
+
+// MaxLength is the maximum length of an OCR number, including the check digit.
+const MaxLength = 25
+
+var (
+	ErrEmpty      = errors.New("OCR number is empty")
+	ErrTooLong    = fmt.Errorf("OCR number is longer than %d digits", MaxLength)
+	ErrNotDigits  = errors.New("OCR number contains characters other than digits")
+	ErrCheckDigit = errors.New("OCR number has an incorrect check digit")
+)
+
+// uuidDigits is the number of digits, excluding the check digit, of OCR
+// numbers created by FromUUID.
+const uuidDigits = 15
+
+// CheckDigit returns the Luhn check digit for the given digits.
+func CheckDigit(digits string) (byte, error) {
+	sum := 0
+	// Starting from the rightmost digit, every other digit is doubled.
+	double := true
+	for i := len(digits) - 1; i >= 0; i-- {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			return 0, ErrNotDigits
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return byte('0' + (10-sum%10)%10), nil
+}
+
+// Generate returns the OCR number made up of base followed by its check digit.
+// OCR numbers are reference numbers used for bank transfers in Sweden, where
+// the last digit is a check digit computed using the Luhn algorithm.
+func Generate(base string) (string, error) {
+	if base == "" {
+		return "", ErrEmpty
+	}
+	if len(base) >= MaxLength {
+		return "", ErrTooLong
+	}
+	check, err := CheckDigit(base)
+	if err != nil {
+		return "", err
+	}
+	return base + string(check), nil
+}
+
+// Validate returns nil if s is a well-formed OCR number with a correct check
+// digit.
+func Validate(s string) error {
+	switch {
+	case len(s) < 2:
+		if s == "" {
+			return ErrEmpty
+		}
+		return ErrCheckDigit
+	case len(s) > MaxLength:
+		return ErrTooLong
+	}
+	check, err := CheckDigit(s[:len(s)-1])
+	if err != nil {
+		return err
+	}
+	last := s[len(s)-1]
+	if last < '0' || last > '9' {
+		return ErrNotDigits
+	}
+	if last != check {
+		return ErrCheckDigit
+	}
+	return nil
+}
+
+// FromUUID deterministically derives an OCR number from id. The OCR numbers
+// always have the same length, and two different UUIDs are very unlikely to
+// result in the same OCR number.
+func FromUUID(id uuid.UUID) string {
+	n := new(big.Int).SetBytes(id[:])
+	mod := new(big.Int).Exp(big.NewInt(10), big.NewInt(uuidDigits), nil)
+	n.Mod(n, mod)
+	base := fmt.Sprintf("%0*s", uuidDigits, n.String())
+	s, err := Generate(base)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
@@ -0,0 +1,73 @@
+package ocr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+func TestGenerate(t *testing.T) {
+	for _, test := range []struct {
+		base    string
+		want    string
+		wantErr error
+	}{
+		{base: "7992739871", want: "79927398713"},
+		{base: "1234", want: "12344"},
+		{base: "0", want: "00"},
+		{base: "", wantErr: ErrEmpty},
+		{base: "12a4", wantErr: ErrNotDigits},
+		{base: strings.Repeat("1", MaxLength), wantErr: ErrTooLong},
+	} {
+		got, err := Generate(test.base)
+		if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+			t.Errorf("Generate(%q) err = %v; want %v", test.base, err, test.wantErr)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Generate(%q) = %q; want %q", test.base, got, test.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want error
+	}{
+		{s: "79927398713", want: nil},
+		{s: "12344", want: nil},
+		{s: "79927398710", want: ErrCheckDigit},
+		{s: "12345", want: ErrCheckDigit},
+		{s: "5", want: ErrCheckDigit},
+		{s: "", want: ErrEmpty},
+		{s: "1234-4", want: ErrNotDigits},
+		{s: "1234x", want: ErrNotDigits},
+		{s: strings.Repeat("0", MaxLength+1), want: ErrTooLong},
+	} {
+		if got := Validate(test.s); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+			t.Errorf("Validate(%q) = %v; want %v", test.s, got, test.want)
+		}
+	}
+}
+
+func TestFromUUID(t *testing.T) {
+	id := uuid.MustParse("bd96a64b-7da2-4254-a315-b82675548a8f")
+	got := FromUUID(id)
+	if err := Validate(got); err != nil {
+		t.Errorf("Validate(%q) = %v; want nil", got, err)
+	}
+	if want := uuidDigits + 1; len(got) != want {
+		t.Errorf("len(%q) = %v; want %v", got, len(got), want)
+	}
+	if again := FromUUID(id); again != got {
+		t.Errorf("FromUUID(%v) = %q, then %q; want the same", id, got, again)
+	}
+	other := uuid.MustParse("ad8a0fc4-1482-4f00-b69c-f6d26104e504")
+	if o := FromUUID(other); o == got {
+		t.Errorf("FromUUID(%v) = FromUUID(%v) = %q; want different", id, other, got)
+	}
+}
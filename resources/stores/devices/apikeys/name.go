@@ -0,0 +1,44 @@
+package apikeys
+
+import (
+	"github.com/Saser/strecku/resources/stores/devices"
+	"github.com/google/uuid"
+)
+
+const CollectionID = "apikeys"
+
+var NameFormat = devices.NameFormat.MustAppend("/" + CollectionID + "/{apikey}")
+
+func GenerateName(device string) string {
+	uuids, err := devices.NameFormat.Parse(device)
+	if err != nil {
+		panic(err)
+	}
+	uuids["apikey"] = uuid.New()
+	name, err := NameFormat.Format(uuids)
+	if err != nil {
+		panic(err)
+	}
+	return name
+}
+
+func ParseName(name string) (store uuid.UUID, device uuid.UUID, apiKey uuid.UUID, err error) {
+	uuids, err := NameFormat.Parse(name)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, uuid.UUID{}, err
+	}
+	return uuids["store"], uuids["device"], uuids["apikey"], nil
+}
+
+func ValidateName(name string) error {
+	_, _, _, err := ParseName(name)
+	return err
+}
+
+func Parent(name string) (string, error) {
+	uuids, err := NameFormat.Parse(name)
+	if err != nil {
+		return "", err
+	}
+	return devices.NameFormat.Format(uuids)
+}
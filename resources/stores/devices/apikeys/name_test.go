@@ -0,0 +1,96 @@
+package apikeys
+
+import (
+	"testing"
+
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/resources/stores"
+	"github.com/Saser/strecku/resources/stores/devices"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+func TestGenerateName(t *testing.T) {
+	device := devices.GenerateName(stores.GenerateName())
+	got := GenerateName(device)
+	if err := ValidateName(got); err != nil {
+		t.Errorf("ValidateName(GenerateName() = %q) = %v; want nil", got, err)
+	}
+}
+
+func TestParseName_ValidateName(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		wantStore := uuid.MustParse("6729f7fa-dc5a-41ae-b00d-5cd67d5e1e15")
+		wantDevice := uuid.MustParse("3b6f1d2a-7c4e-4f8a-9b0c-1d2e3f4a5b6c")
+		wantAPIKey := uuid.MustParse("90e3eaaa-4d9c-423f-b468-bb7322fb5d4f")
+		name := "stores/" + wantStore.String() + "/devices/" + wantDevice.String() + "/apikeys/" + wantAPIKey.String()
+		gotStore, gotDevice, gotAPIKey, err := ParseName(name)
+		if err != nil {
+			t.Errorf("ParseName(%q) err = %v; want nil", name, err)
+		}
+		if !cmp.Equal(gotStore, wantStore) {
+			t.Errorf("ParseName(%q) store = %v; want %v", name, gotStore, wantStore)
+		}
+		if !cmp.Equal(gotDevice, wantDevice) {
+			t.Errorf("ParseName(%q) device = %v; want %v", name, gotDevice, wantDevice)
+		}
+		if !cmp.Equal(gotAPIKey, wantAPIKey) {
+			t.Errorf("ParseName(%q) API key = %v; want %v", name, gotAPIKey, wantAPIKey)
+		}
+		if err := ValidateName(name); err != nil {
+			t.Errorf("ValidateName(%q) = %v; want nil", name, err)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		storeID := "6729f7fa-dc5a-41ae-b00d-5cd67d5e1e15"
+		deviceID := "3b6f1d2a-7c4e-4f8a-9b0c-1d2e3f4a5b6c"
+		apiKeyID := "90e3eaaa-4d9c-423f-b468-bb7322fb5d4f"
+		for _, name := range []string{
+			"",
+			apiKeyID,
+			"stores/" + storeID + "/devices/" + deviceID,
+			"stores/" + storeID + "/apikeys/" + apiKeyID,
+			"stores/" + storeID + "/devices/" + deviceID + "/apikeys/not-a-UUID",
+			"stores/" + storeID + "/devices/not-a-UUID/apikeys/" + apiKeyID,
+		} {
+			_, _, _, err := ParseName(name)
+			if err == nil {
+				t.Errorf("ParseName(%q) err = nil; want non-nil", name)
+			}
+			if err := ValidateName(name); err == nil {
+				t.Errorf("ValidateName(%q) = nil; want non-nil", name)
+			}
+		}
+	})
+}
+
+func TestParent(t *testing.T) {
+	device := "stores/6729f7fa-dc5a-41ae-b00d-5cd67d5e1e15/devices/3b6f1d2a-7c4e-4f8a-9b0c-1d2e3f4a5b6c"
+	apiKeyID := "90e3eaaa-4d9c-423f-b468-bb7322fb5d4f"
+	for _, test := range []struct {
+		name       string
+		wantParent string
+		wantErr    error
+	}{
+		{
+			name:       device + "/apikeys/" + apiKeyID,
+			wantParent: device,
+			wantErr:    nil,
+		},
+		{
+			name:       device + "/keys/" + apiKeyID,
+			wantParent: "",
+			wantErr:    resourcename.ErrInvalidName,
+		},
+	} {
+		parent, err := Parent(test.name)
+		if parent != test.wantParent {
+			t.Errorf("Parent(%q) parent = %q; want %q", test.name, parent, test.wantParent)
+		}
+		if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+			t.Errorf("Parent(%q) err = %v; want %q", test.name, err, test.wantErr)
+		}
+	}
+}
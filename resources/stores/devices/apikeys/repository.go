@@ -0,0 +1,190 @@
+package apikeys
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrUnauthenticated is returned by Authenticate when no API key has the
+// given secret.
+var ErrUnauthenticated = errors.New("invalid API key")
+
+type NotFoundError struct {
+	Name string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("API key not found: %q", e.Name)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	other, ok := target.(*NotFoundError)
+	if !ok {
+		return false
+	}
+	return other.Name == e.Name
+}
+
+type ExistsError struct {
+	Name string
+}
+
+func (e *ExistsError) Error() string {
+	return fmt.Sprintf("API key exists: %q", e.Name)
+}
+
+func (e *ExistsError) Is(target error) bool {
+	other, ok := target.(*ExistsError)
+	if !ok {
+		return false
+	}
+	return other.Name == e.Name
+}
+
+func Clone(apiKey *pb.ApiKey) *pb.ApiKey {
+	return proto.Clone(apiKey).(*pb.ApiKey)
+}
+
+// Repository stores API keys. Only hashes of their secrets are stored, so the
+// secrets cannot be recovered from the repository, and API keys are never
+// returned with their secrets.
+type Repository struct {
+	apiKeys map[string]*pb.ApiKey        // name -> API key, without secret
+	hashes  map[[sha256.Size]byte]string // hash of secret -> name
+}
+
+func NewRepository() *Repository {
+	return newRepository(make(map[string]*pb.ApiKey), make(map[[sha256.Size]byte]string))
+}
+
+// SeedRepository returns a repository containing the API keys in apiKeys,
+// which maps secrets to API keys.
+func SeedRepository(t *testing.T, apiKeys map[string]*pb.ApiKey) *Repository {
+	mAPIKeys := make(map[string]*pb.ApiKey)
+	hashes := make(map[[sha256.Size]byte]string)
+	for secret, apiKey := range apiKeys {
+		if err := Validate(apiKey); err != nil {
+			t.Errorf("Validate(%v) = %v; want nil", apiKey, err)
+		}
+		stored := Clone(apiKey)
+		stored.Secret = ""
+		mAPIKeys[apiKey.Name] = stored
+		hashes[hash(secret)] = apiKey.Name
+	}
+	if t.Failed() {
+		t.FailNow()
+	}
+	return newRepository(mAPIKeys, hashes)
+}
+
+func newRepository(apiKeys map[string]*pb.ApiKey, hashes map[[sha256.Size]byte]string) *Repository {
+	return &Repository{
+		apiKeys: apiKeys,
+		hashes:  hashes,
+	}
+}
+
+func (r *Repository) LookupApiKey(_ context.Context, name string) (*pb.ApiKey, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	apiKey, ok := r.apiKeys[name]
+	if !ok {
+		return nil, &NotFoundError{Name: name}
+	}
+	return Clone(apiKey), nil
+}
+
+func (r *Repository) ListApiKeys(ctx context.Context) ([]*pb.ApiKey, error) {
+	return r.FilterApiKeys(ctx, func(*pb.ApiKey) bool { return true })
+}
+
+func (r *Repository) FilterApiKeys(_ context.Context, predicate func(*pb.ApiKey) bool) ([]*pb.ApiKey, error) {
+	var filtered []*pb.ApiKey
+	for _, apiKey := range r.apiKeys {
+		if predicate(apiKey) {
+			filtered = append(filtered, Clone(apiKey))
+		}
+	}
+	return filtered, nil
+}
+
+// Authenticate returns the API key with the given secret.
+func (r *Repository) Authenticate(_ context.Context, secret string) (*pb.ApiKey, error) {
+	name, ok := r.hashes[hash(secret)]
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	return Clone(r.apiKeys[name]), nil
+}
+
+// CreateApiKey stores apiKey, whose secret must be set.
+func (r *Repository) CreateApiKey(_ context.Context, apiKey *pb.ApiKey) error {
+	if err := Validate(apiKey); err != nil {
+		return err
+	}
+	if apiKey.Secret == "" {
+		return ErrSecretMissing
+	}
+	name := apiKey.Name
+	if _, exists := r.apiKeys[name]; exists {
+		return &ExistsError{Name: name}
+	}
+	r.store(apiKey)
+	return nil
+}
+
+// UpdateApiKey replaces the stored API key with updated. If the secret of
+// updated is set, it replaces the old secret, which stops working.
+func (r *Repository) UpdateApiKey(_ context.Context, updated *pb.ApiKey) error {
+	if err := Validate(updated); err != nil {
+		return err
+	}
+	name := updated.Name
+	if _, ok := r.apiKeys[name]; !ok {
+		return &NotFoundError{Name: name}
+	}
+	if updated.Secret == "" {
+		r.apiKeys[name] = Clone(updated)
+		return nil
+	}
+	r.forgetSecret(name)
+	r.store(updated)
+	return nil
+}
+
+func (r *Repository) DeleteApiKey(_ context.Context, name string) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	if _, exists := r.apiKeys[name]; !exists {
+		return &NotFoundError{Name: name}
+	}
+	r.forgetSecret(name)
+	delete(r.apiKeys, name)
+	return nil
+}
+
+// store stores apiKey and the hash of its secret.
+func (r *Repository) store(apiKey *pb.ApiKey) {
+	stored := Clone(apiKey)
+	stored.Secret = ""
+	r.apiKeys[apiKey.Name] = stored
+	r.hashes[hash(apiKey.Secret)] = apiKey.Name
+}
+
+// forgetSecret removes the hash of the secret of the API key with the given
+// name.
+func (r *Repository) forgetSecret(name string) {
+	for h, other := range r.hashes {
+		if other == name {
+			delete(r.hashes, h)
+		}
+	}
+}
@@ -0,0 +1,369 @@
+package apikeys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func apiKeyLess(k1, k2 *pb.ApiKey) bool {
+	return k1.Name < k2.Name
+}
+
+// seed returns a repository containing Bar_Kiosk_ApiKey and
+// Mall_Kiosk_ApiKey.
+func seed(t *testing.T) *Repository {
+	return SeedRepository(t, map[string]*pb.ApiKey{
+		testresources.Bar_Kiosk_ApiKeySecret:  testresources.Bar_Kiosk_ApiKey,
+		testresources.Mall_Kiosk_ApiKeySecret: testresources.Mall_Kiosk_ApiKey,
+	})
+}
+
+func TestNotFoundError_Error(t *testing.T) {
+	err := &NotFoundError{Name: testresources.Bar_Kiosk_ApiKey.Name}
+	want := fmt.Sprintf("API key not found: %q", testresources.Bar_Kiosk_ApiKey.Name)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestNotFoundError_Is(t *testing.T) {
+	for _, test := range []struct {
+		err    *NotFoundError
+		target error
+		want   bool
+	}{
+		{
+			err:    &NotFoundError{Name: testresources.Bar_Kiosk_ApiKey.Name},
+			target: &NotFoundError{Name: testresources.Bar_Kiosk_ApiKey.Name},
+			want:   true,
+		},
+		{
+			err:    &NotFoundError{Name: testresources.Bar_Kiosk_ApiKey.Name},
+			target: &NotFoundError{Name: testresources.Mall_Kiosk_ApiKey.Name},
+			want:   false,
+		},
+		{
+			err:    &NotFoundError{Name: testresources.Bar_Kiosk_ApiKey.Name},
+			target: fmt.Errorf("API key not found: %q", testresources.Bar_Kiosk_ApiKey.Name),
+			want:   false,
+		},
+	} {
+		if got := test.err.Is(test.target); got != test.want {
+			t.Errorf("test.err.Is(%v) = %v; want %v", test.target, got, test.want)
+		}
+	}
+}
+
+func TestExistsError_Error(t *testing.T) {
+	err := &ExistsError{Name: testresources.Bar_Kiosk_ApiKey.Name}
+	want := fmt.Sprintf("API key exists: %q", testresources.Bar_Kiosk_ApiKey.Name)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestExistsError_Is(t *testing.T) {
+	for _, test := range []struct {
+		err    *ExistsError
+		target error
+		want   bool
+	}{
+		{
+			err:    &ExistsError{Name: testresources.Bar_Kiosk_ApiKey.Name},
+			target: &ExistsError{Name: testresources.Bar_Kiosk_ApiKey.Name},
+			want:   true,
+		},
+		{
+			err:    &ExistsError{Name: testresources.Bar_Kiosk_ApiKey.Name},
+			target: &ExistsError{Name: testresources.Mall_Kiosk_ApiKey.Name},
+			want:   false,
+		},
+	} {
+		if got := test.err.Is(test.target); got != test.want {
+			t.Errorf("test.err.Is(%v) = %v; want %v", test.target, got, test.want)
+		}
+	}
+}
+
+func TestNewRepository(t *testing.T) {
+	NewRepository()
+}
+
+func TestRepository_LookupApiKey(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, map[string]*pb.ApiKey{
+		testresources.Bar_Kiosk_ApiKeySecret: testresources.Bar_Kiosk_ApiKey,
+	})
+	for _, test := range []struct {
+		desc       string
+		name       string
+		wantAPIKey *pb.ApiKey
+		wantErr    error
+	}{
+		{
+			desc:       "OK",
+			name:       testresources.Bar_Kiosk_ApiKey.Name,
+			wantAPIKey: testresources.Bar_Kiosk_ApiKey,
+			wantErr:    nil,
+		},
+		{
+			desc:       "EmptyName",
+			name:       "",
+			wantAPIKey: nil,
+			wantErr:    resourcename.ErrInvalidName,
+		},
+		{
+			desc:       "NotFound",
+			name:       testresources.Mall_Kiosk_ApiKey.Name,
+			wantAPIKey: nil,
+			wantErr:    &NotFoundError{Name: testresources.Mall_Kiosk_ApiKey.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			apiKey, err := r.LookupApiKey(ctx, test.name)
+			if diff := cmp.Diff(apiKey, test.wantAPIKey, protocmp.Transform()); diff != "" {
+				t.Errorf("r.LookupApiKey(%v, %q) apiKey != test.wantAPIKey (-got +want)\n%s", ctx, test.name, diff)
+			}
+			if got, want := err, test.wantErr; !cmp.Equal(got, want, cmpopts.EquateErrors()) {
+				t.Errorf("r.LookupApiKey(%v, %q) err = %v; want %v", ctx, test.name, got, want)
+			}
+		})
+	}
+}
+
+func TestRepository_ListApiKeys(t *testing.T) {
+	ctx := context.Background()
+	r := seed(t)
+	want := []*pb.ApiKey{
+		testresources.Bar_Kiosk_ApiKey,
+		testresources.Mall_Kiosk_ApiKey,
+	}
+	apiKeys, err := r.ListApiKeys(ctx)
+	if diff := cmp.Diff(
+		apiKeys, want, protocmp.Transform(),
+		cmpopts.SortSlices(apiKeyLess),
+	); diff != "" {
+		t.Errorf("r.ListApiKeys(%v) apiKeys != want (-got +want)\n%s", ctx, diff)
+	}
+	if err != nil {
+		t.Errorf("r.ListApiKeys(%v) err = %v; want nil", ctx, err)
+	}
+}
+
+func TestRepository_FilterApiKeys(t *testing.T) {
+	ctx := context.Background()
+	r := seed(t)
+	apiKeys, err := r.FilterApiKeys(ctx, func(apiKey *pb.ApiKey) bool {
+		return apiKey.Name == testresources.Mall_Kiosk_ApiKey.Name
+	})
+	if diff := cmp.Diff(apiKeys, []*pb.ApiKey{testresources.Mall_Kiosk_ApiKey}, protocmp.Transform()); diff != "" {
+		t.Errorf("r.FilterApiKeys(%v, predicate) apiKeys != want (-got +want)\n%s", ctx, diff)
+	}
+	if err != nil {
+		t.Errorf("r.FilterApiKeys(%v, predicate) err = %v; want nil", ctx, err)
+	}
+}
+
+func TestRepository_Authenticate(t *testing.T) {
+	ctx := context.Background()
+	r := seed(t)
+	for _, test := range []struct {
+		desc       string
+		secret     string
+		wantAPIKey *pb.ApiKey
+		wantErr    error
+	}{
+		{
+			desc:       "Bar",
+			secret:     testresources.Bar_Kiosk_ApiKeySecret,
+			wantAPIKey: testresources.Bar_Kiosk_ApiKey,
+			wantErr:    nil,
+		},
+		{
+			desc:       "Mall",
+			secret:     testresources.Mall_Kiosk_ApiKeySecret,
+			wantAPIKey: testresources.Mall_Kiosk_ApiKey,
+			wantErr:    nil,
+		},
+		{
+			desc:       "Unknown",
+			secret:     "not a secret",
+			wantAPIKey: nil,
+			wantErr:    ErrUnauthenticated,
+		},
+		{
+			desc:       "Empty",
+			secret:     "",
+			wantAPIKey: nil,
+			wantErr:    ErrUnauthenticated,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			apiKey, err := r.Authenticate(ctx, test.secret)
+			if diff := cmp.Diff(apiKey, test.wantAPIKey, protocmp.Transform()); diff != "" {
+				t.Errorf("r.Authenticate(%v, %q) apiKey != test.wantAPIKey (-got +want)\n%s", ctx, test.secret, diff)
+			}
+			if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+				t.Errorf("r.Authenticate(%v, %q) err = %v; want %v", ctx, test.secret, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestRepository_CreateApiKey(t *testing.T) {
+	ctx := context.Background()
+	withSecret := func(apiKey *pb.ApiKey, secret string) *pb.ApiKey {
+		apiKey = Clone(apiKey)
+		apiKey.Secret = secret
+		return apiKey
+	}
+	for _, test := range []struct {
+		desc   string
+		apiKey *pb.ApiKey
+		want   error
+	}{
+		{
+			desc:   "OK",
+			apiKey: withSecret(testresources.Mall_Kiosk_ApiKey, testresources.Mall_Kiosk_ApiKeySecret),
+			want:   nil,
+		},
+		{
+			desc:   "MissingSecret",
+			apiKey: testresources.Mall_Kiosk_ApiKey,
+			want:   ErrSecretMissing,
+		},
+		{
+			desc:   "DuplicateName",
+			apiKey: withSecret(testresources.Bar_Kiosk_ApiKey, "another secret"),
+			want:   &ExistsError{Name: testresources.Bar_Kiosk_ApiKey.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			r := SeedRepository(t, map[string]*pb.ApiKey{
+				testresources.Bar_Kiosk_ApiKeySecret: testresources.Bar_Kiosk_ApiKey,
+			})
+			if got := r.CreateApiKey(ctx, test.apiKey); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+				t.Errorf("r.CreateApiKey(%v, %v) = %v; want %v", ctx, test.apiKey, got, test.want)
+			}
+			if test.want != nil {
+				return
+			}
+			apiKey, err := r.Authenticate(ctx, test.apiKey.Secret)
+			if err != nil {
+				t.Errorf("r.Authenticate(%v, %q) err = %v; want nil", ctx, test.apiKey.Secret, err)
+			}
+			if got := apiKey.GetSecret(); got != "" {
+				t.Errorf("r.Authenticate(%v, %q) returned secret %q; want none", ctx, test.apiKey.Secret, got)
+			}
+		})
+	}
+}
+
+func TestRepository_UpdateApiKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NewSecret", func(t *testing.T) {
+		r := seed(t)
+		updated := Clone(testresources.Bar_Kiosk_ApiKey)
+		updated.Secret = "new secret"
+		updated.RotateTime = timestamppb.Now()
+		if err := r.UpdateApiKey(ctx, updated); err != nil {
+			t.Errorf("r.UpdateApiKey(%v, %v) = %v; want nil", ctx, updated, err)
+		}
+		if _, err := r.Authenticate(ctx, testresources.Bar_Kiosk_ApiKeySecret); !cmp.Equal(err, ErrUnauthenticated, cmpopts.EquateErrors()) {
+			t.Errorf("r.Authenticate(%v, old secret) err = %v; want %v", ctx, err, ErrUnauthenticated)
+		}
+		apiKey, err := r.Authenticate(ctx, "new secret")
+		if err != nil {
+			t.Errorf("r.Authenticate(%v, new secret) err = %v; want nil", ctx, err)
+		}
+		updated.Secret = ""
+		if diff := cmp.Diff(apiKey, updated, protocmp.Transform()); diff != "" {
+			t.Errorf("r.Authenticate(%v, new secret) apiKey != updated (-got +want)\n%s", ctx, diff)
+		}
+	})
+
+	t.Run("SameSecret", func(t *testing.T) {
+		r := seed(t)
+		updated := Clone(testresources.Bar_Kiosk_ApiKey)
+		updated.RotateTime = timestamppb.Now()
+		if err := r.UpdateApiKey(ctx, updated); err != nil {
+			t.Errorf("r.UpdateApiKey(%v, %v) = %v; want nil", ctx, updated, err)
+		}
+		apiKey, err := r.Authenticate(ctx, testresources.Bar_Kiosk_ApiKeySecret)
+		if err != nil {
+			t.Errorf("r.Authenticate(%v, old secret) err = %v; want nil", ctx, err)
+		}
+		if diff := cmp.Diff(apiKey, updated, protocmp.Transform()); diff != "" {
+			t.Errorf("r.Authenticate(%v, old secret) apiKey != updated (-got +want)\n%s", ctx, diff)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		r := SeedRepository(t, map[string]*pb.ApiKey{
+			testresources.Bar_Kiosk_ApiKeySecret: testresources.Bar_Kiosk_ApiKey,
+		})
+		for _, test := range []struct {
+			desc   string
+			modify func(apiKey *pb.ApiKey)
+			want   error
+		}{
+			{
+				desc:   "EmptyName",
+				modify: func(apiKey *pb.ApiKey) { apiKey.Name = "" },
+				want:   resourcename.ErrInvalidName,
+			},
+			{
+				desc:   "MissingCreateTime",
+				modify: func(apiKey *pb.ApiKey) { apiKey.CreateTime = nil },
+				want:   ErrCreateTimeMissing,
+			},
+			{
+				desc:   "NotFound",
+				modify: func(apiKey *pb.ApiKey) { apiKey.Name = testresources.Mall_Kiosk_ApiKey.Name },
+				want:   &NotFoundError{Name: testresources.Mall_Kiosk_ApiKey.Name},
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				updated := Clone(testresources.Bar_Kiosk_ApiKey)
+				test.modify(updated)
+				if got := r.UpdateApiKey(ctx, updated); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+					t.Errorf("r.UpdateApiKey(%v, %v) = %v; want %v", ctx, updated, got, test.want)
+				}
+			})
+		}
+	})
+}
+
+func TestRepository_DeleteApiKey(t *testing.T) {
+	ctx := context.Background()
+	r := seed(t)
+	name := testresources.Bar_Kiosk_ApiKey.Name
+	if err := r.DeleteApiKey(ctx, name); err != nil {
+		t.Errorf("r.DeleteApiKey(%v, %q) = %v; want nil", ctx, name, err)
+	}
+	if _, err := r.LookupApiKey(ctx, name); !cmp.Equal(err, &NotFoundError{Name: name}, cmpopts.EquateErrors()) {
+		t.Errorf("r.LookupApiKey(%v, %q) err = %v; want not found", ctx, name, err)
+	}
+	if _, err := r.Authenticate(ctx, testresources.Bar_Kiosk_ApiKeySecret); !cmp.Equal(err, ErrUnauthenticated, cmpopts.EquateErrors()) {
+		t.Errorf("r.Authenticate(%v, deleted secret) err = %v; want %v", ctx, err, ErrUnauthenticated)
+	}
+	if _, err := r.Authenticate(ctx, testresources.Mall_Kiosk_ApiKeySecret); err != nil {
+		t.Errorf("r.Authenticate(%v, other secret) err = %v; want nil", ctx, err)
+	}
+	if got, want := r.DeleteApiKey(ctx, name), error(&NotFoundError{Name: name}); !cmp.Equal(got, want, cmpopts.EquateErrors()) {
+		t.Errorf("second r.DeleteApiKey(%v, %q) = %v; want %v", ctx, name, got, want)
+	}
+	if got, want := r.DeleteApiKey(ctx, ""), resourcename.ErrInvalidName; !cmp.Equal(got, want, cmpopts.EquateErrors()) {
+		t.Errorf("r.DeleteApiKey(%v, %q) = %v; want %v", ctx, "", got, want)
+	}
+}
@@ -0,0 +1,22 @@
+package apikeys
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// GenerateSecret returns a new random secret for an API key.
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hash returns the hash of secret that is stored instead of the secret. The
+// secrets are random and long enough that a fast hash without salt is enough.
+func hash(secret string) [sha256.Size]byte {
+	return sha256.Sum256([]byte(secret))
+}
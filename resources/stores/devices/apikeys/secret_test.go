@@ -0,0 +1,20 @@
+package apikeys
+
+import "testing"
+
+func TestGenerateSecret(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 10; i++ {
+		secret, err := GenerateSecret()
+		if err != nil {
+			t.Fatalf("GenerateSecret() err = %v; want nil", err)
+		}
+		if got, want := len(secret), 43; got != want {
+			t.Errorf("len(GenerateSecret() = %q) = %d; want %d", secret, got, want)
+		}
+		if seen[secret] {
+			t.Errorf("GenerateSecret() = %q, which was returned before", secret)
+		}
+		seen[secret] = true
+	}
+}
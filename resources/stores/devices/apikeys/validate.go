@@ -0,0 +1,26 @@
+package apikeys
+
+import (
+	"errors"
+
+	pb "github.com/Saser/strecku/api/v1"
+)
+
+var (
+	ErrCreateTimeMissing      = errors.New("create time is missing")
+	ErrRotateTimeBeforeCreate = errors.New("rotate time is before create time")
+	ErrSecretMissing          = errors.New("secret is missing")
+)
+
+func Validate(apiKey *pb.ApiKey) error {
+	if err := ValidateName(apiKey.Name); err != nil {
+		return err
+	}
+	if apiKey.CreateTime == nil {
+		return ErrCreateTimeMissing
+	}
+	if apiKey.RotateTime != nil && apiKey.RotateTime.AsTime().Before(apiKey.CreateTime.AsTime()) {
+		return ErrRotateTimeBeforeCreate
+	}
+	return nil
+}
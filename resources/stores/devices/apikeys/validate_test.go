@@ -0,0 +1,56 @@
+package apikeys
+
+import (
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		desc   string
+		modify func(apiKey *pb.ApiKey)
+		want   error
+	}{
+		{
+			desc:   "OK",
+			modify: func(*pb.ApiKey) {},
+			want:   nil,
+		},
+		{
+			desc:   "OK_Rotated",
+			modify: func(apiKey *pb.ApiKey) { apiKey.RotateTime = timestamppb.Now() },
+			want:   nil,
+		},
+		{
+			desc:   "InvalidName",
+			modify: func(apiKey *pb.ApiKey) { apiKey.Name = testresources.Bar_Kiosk.Name },
+			want:   resourcename.ErrInvalidName,
+		},
+		{
+			desc:   "MissingCreateTime",
+			modify: func(apiKey *pb.ApiKey) { apiKey.CreateTime = nil },
+			want:   ErrCreateTimeMissing,
+		},
+		{
+			desc: "RotateTimeBeforeCreate",
+			modify: func(apiKey *pb.ApiKey) {
+				apiKey.RotateTime = timestamppb.New(apiKey.CreateTime.AsTime().Add(-1))
+			},
+			want: ErrRotateTimeBeforeCreate,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			apiKey := Clone(testresources.Bar_Kiosk_ApiKey)
+			test.modify(apiKey)
+			if got := Validate(apiKey); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+				t.Errorf("Validate(%v) = %v; want %v", apiKey, got, test.want)
+			}
+		})
+	}
+}
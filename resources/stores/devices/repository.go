@@ -0,0 +1,134 @@
+package devices
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type NotFoundError struct {
+	Name string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("device not found: %q", e.Name)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	other, ok := target.(*NotFoundError)
+	if !ok {
+		return false
+	}
+	return other.Name == e.Name
+}
+
+type ExistsError struct {
+	Name string
+}
+
+func (e *ExistsError) Error() string {
+	return fmt.Sprintf("device exists: %q", e.Name)
+}
+
+func (e *ExistsError) Is(target error) bool {
+	other, ok := target.(*ExistsError)
+	if !ok {
+		return false
+	}
+	return other.Name == e.Name
+}
+
+func Clone(device *pb.Device) *pb.Device {
+	return proto.Clone(device).(*pb.Device)
+}
+
+type Repository struct {
+	devices map[string]*pb.Device // name -> device
+}
+
+func NewRepository() *Repository {
+	return newRepository(make(map[string]*pb.Device))
+}
+
+func SeedRepository(t *testing.T, devices []*pb.Device) *Repository {
+	mDevices := make(map[string]*pb.Device)
+	for _, device := range devices {
+		if err := Validate(device); err != nil {
+			t.Errorf("Validate(%v) = %v; want nil", device, err)
+		}
+		mDevices[device.Name] = device
+	}
+	if t.Failed() {
+		t.FailNow()
+	}
+	return newRepository(mDevices)
+}
+
+func newRepository(devices map[string]*pb.Device) *Repository {
+	return &Repository{
+		devices: devices,
+	}
+}
+
+func (r *Repository) LookupDevice(_ context.Context, name string) (*pb.Device, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	device, ok := r.devices[name]
+	if !ok {
+		return nil, &NotFoundError{Name: name}
+	}
+	return Clone(device), nil
+}
+
+func (r *Repository) ListDevices(ctx context.Context) ([]*pb.Device, error) {
+	return r.FilterDevices(ctx, func(*pb.Device) bool { return true })
+}
+
+func (r *Repository) FilterDevices(_ context.Context, predicate func(*pb.Device) bool) ([]*pb.Device, error) {
+	var filtered []*pb.Device
+	for _, device := range r.devices {
+		if predicate(device) {
+			filtered = append(filtered, Clone(device))
+		}
+	}
+	return filtered, nil
+}
+
+func (r *Repository) CreateDevice(_ context.Context, device *pb.Device) error {
+	if err := Validate(device); err != nil {
+		return err
+	}
+	name := device.Name
+	if _, exists := r.devices[name]; exists {
+		return &ExistsError{Name: name}
+	}
+	r.devices[name] = Clone(device)
+	return nil
+}
+
+func (r *Repository) UpdateDevice(_ context.Context, updated *pb.Device) error {
+	if err := Validate(updated); err != nil {
+		return err
+	}
+	name := updated.Name
+	if _, ok := r.devices[name]; !ok {
+		return &NotFoundError{Name: name}
+	}
+	r.devices[name] = Clone(updated)
+	return nil
+}
+
+func (r *Repository) DeleteDevice(_ context.Context, name string) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	if _, exists := r.devices[name]; !exists {
+		return &NotFoundError{Name: name}
+	}
+	delete(r.devices, name)
+	return nil
+}
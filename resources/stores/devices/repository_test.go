@@ -0,0 +1,363 @@
+package devices
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func deviceLess(p1, p2 *pb.Device) bool {
+	return p1.Name < p2.Name
+}
+
+func TestNotFoundError_Error(t *testing.T) {
+	err := &NotFoundError{Name: testresources.Bar_Kiosk.Name}
+	want := fmt.Sprintf("device not found: %q", testresources.Bar_Kiosk.Name)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestNotFoundError_Is(t *testing.T) {
+	for _, test := range []struct {
+		err    *NotFoundError
+		target error
+		want   bool
+	}{
+		{
+			err:    &NotFoundError{Name: testresources.Bar_Kiosk.Name},
+			target: &NotFoundError{Name: testresources.Bar_Kiosk.Name},
+			want:   true,
+		},
+		{
+			err:    &NotFoundError{Name: testresources.Bar_Kiosk.Name},
+			target: &NotFoundError{Name: testresources.Mall_Kiosk.Name},
+			want:   false,
+		},
+		{
+			err:    &NotFoundError{Name: testresources.Bar_Kiosk.Name},
+			target: fmt.Errorf("device not found: %q", testresources.Bar_Kiosk.Name),
+			want:   false,
+		},
+	} {
+		if got := test.err.Is(test.target); got != test.want {
+			t.Errorf("test.err.Is(%v) = %v; want %v", test.target, got, test.want)
+		}
+	}
+}
+
+func TestExistsError_Error(t *testing.T) {
+	err := &ExistsError{Name: testresources.Bar_Kiosk.Name}
+	want := fmt.Sprintf("device exists: %q", testresources.Bar_Kiosk.Name)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestExistsError_Is(t *testing.T) {
+	for _, test := range []struct {
+		err    *ExistsError
+		target error
+		want   bool
+	}{
+		{
+			err:    &ExistsError{Name: testresources.Bar_Kiosk.Name},
+			target: &ExistsError{Name: testresources.Bar_Kiosk.Name},
+			want:   true,
+		},
+		{
+			err:    &ExistsError{Name: testresources.Bar_Kiosk.Name},
+			target: &ExistsError{Name: testresources.Mall_Kiosk.Name},
+			want:   false,
+		},
+		{
+			err:    &ExistsError{Name: testresources.Bar_Kiosk.Name},
+			target: fmt.Errorf("device exists: %q", testresources.Bar_Kiosk.Name),
+			want:   false,
+		},
+	} {
+		if got := test.err.Is(test.target); got != test.want {
+			t.Errorf("test.err.Is(%v) = %v; want %v", test.target, got, test.want)
+		}
+	}
+}
+
+func TestNewRepository(t *testing.T) {
+	NewRepository()
+}
+
+func TestRepository_LookupDevice(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.Device{testresources.Bar_Kiosk})
+	for _, test := range []struct {
+		desc       string
+		name       string
+		wantDevice *pb.Device
+		wantErr    error
+	}{
+		{
+			desc:       "OK",
+			name:       testresources.Bar_Kiosk.Name,
+			wantDevice: testresources.Bar_Kiosk,
+			wantErr:    nil,
+		},
+		{
+			desc:       "EmptyName",
+			name:       "",
+			wantDevice: nil,
+			wantErr:    resourcename.ErrInvalidName,
+		},
+		{
+			desc:       "NotFound",
+			name:       testresources.Mall_Kiosk.Name,
+			wantDevice: nil,
+			wantErr:    &NotFoundError{Name: testresources.Mall_Kiosk.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			device, err := r.LookupDevice(ctx, test.name)
+			if diff := cmp.Diff(device, test.wantDevice, protocmp.Transform()); diff != "" {
+				t.Errorf("r.LookupDevice(%v, %q) device != test.wantDevice (-got +want)\n%s", ctx, test.name, diff)
+			}
+			if got, want := err, test.wantErr; !cmp.Equal(got, want, cmpopts.EquateErrors()) {
+				t.Errorf("r.LookupDevice(%v, %q) err = %v; want %v", ctx, test.name, got, want)
+			}
+		})
+	}
+}
+
+func TestRepository_ListDevices(t *testing.T) {
+	ctx := context.Background()
+	want := []*pb.Device{
+		testresources.Bar_Kiosk,
+		testresources.Mall_Kiosk,
+		testresources.Bar_Register,
+	}
+	r := SeedRepository(t, want)
+	devices, err := r.ListDevices(ctx)
+	if diff := cmp.Diff(
+		devices, want, protocmp.Transform(),
+		cmpopts.SortSlices(deviceLess),
+	); diff != "" {
+		t.Errorf("r.ListDevices(%v) devices != want (-got +want)\n%s", ctx, diff)
+	}
+	if err != nil {
+		t.Errorf("r.ListDevices(%v) err = %v; want nil", ctx, err)
+	}
+}
+
+func TestRepository_FilterDevices(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.Device{
+		testresources.Bar_Kiosk,
+		testresources.Mall_Kiosk,
+		testresources.Bar_Register,
+	})
+	for _, test := range []struct {
+		name      string
+		predicate func(*pb.Device) bool
+		want      []*pb.Device
+	}{
+		{
+			name:      "NoneMatching",
+			predicate: func(*pb.Device) bool { return false },
+			want:      nil,
+		},
+		{
+			name: "OneMatching",
+			predicate: func(device *pb.Device) bool {
+				return device.Name == testresources.Bar_Kiosk.Name
+			},
+			want: []*pb.Device{
+				testresources.Bar_Kiosk,
+			},
+		},
+		{
+			name: "MultipleMatching",
+			predicate: func(device *pb.Device) bool {
+				return device.Name == testresources.Bar_Kiosk.Name || device.Name == testresources.Mall_Kiosk.Name
+			},
+			want: []*pb.Device{
+				testresources.Bar_Kiosk,
+				testresources.Mall_Kiosk,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			devices, err := r.FilterDevices(ctx, test.predicate)
+			if diff := cmp.Diff(
+				devices, test.want, protocmp.Transform(),
+				cmpopts.EquateEmpty(),
+				cmpopts.SortSlices(deviceLess),
+			); diff != "" {
+				t.Errorf("r.FilterDevices(%v, test.predicate) devices != test.want (-got +want)\n%s", ctx, diff)
+			}
+			if got, want := err, error(nil); !cmp.Equal(got, want) {
+				t.Errorf("r.FilterDevices(%v, test.predicate) err = %v; want %v", ctx, got, want)
+			}
+		})
+	}
+}
+
+func TestRepository_CreateDevice(t *testing.T) {
+	ctx := context.Background()
+	duplicateName := Clone(testresources.Mall_Kiosk)
+	duplicateName.Name = testresources.Bar_Kiosk.Name
+	for _, test := range []struct {
+		desc   string
+		device *pb.Device
+		want   error
+	}{
+		{
+			desc:   "OneDeviceOK",
+			device: testresources.Mall_Kiosk,
+			want:   nil,
+		},
+		{
+			desc:   "DuplicateName",
+			device: duplicateName,
+			want:   &ExistsError{Name: testresources.Bar_Kiosk.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			r := SeedRepository(t, []*pb.Device{testresources.Bar_Kiosk})
+			if got := r.CreateDevice(ctx, test.device); !cmp.Equal(got, test.want) {
+				t.Errorf("r.CreateDevice(%v, %v) = %v; want %v", ctx, test.device, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRepository_UpdateDevice(t *testing.T) {
+	ctx := context.Background()
+	// Test scenario where the update is successful.
+	t.Run("OK", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.Device{testresources.Bar_Kiosk})
+		updated := Clone(testresources.Bar_Kiosk)
+		updated.Scopes = []string{ScopeProductsRead}
+		if err := r.UpdateDevice(ctx, updated); err != nil {
+			t.Errorf("r.UpdateDevice(%v, %v) = %v; want nil", ctx, updated, err)
+		}
+		device, err := r.LookupDevice(ctx, updated.Name)
+		if diff := cmp.Diff(device, updated, protocmp.Transform()); diff != "" {
+			t.Errorf("r.LookupDevice(%v, %q) device != updated (-got +want)\n%s", ctx, updated.Name, diff)
+		}
+		if err != nil {
+			t.Errorf("r.LookupDevice(%v, %q) err = %v; want nil", ctx, updated.Name, err)
+		}
+	})
+
+	// Test scenario where the update fails.
+	t.Run("Errors", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.Device{testresources.Bar_Kiosk})
+		for _, test := range []struct {
+			desc   string
+			modify func(device *pb.Device)
+			want   error
+		}{
+			{
+				desc:   "EmptyName",
+				modify: func(device *pb.Device) { device.Name = "" },
+				want:   resourcename.ErrInvalidName,
+			},
+			{
+				desc:   "EmptyDisplayName",
+				modify: func(device *pb.Device) { device.DisplayName = "" },
+				want:   ErrDisplayNameEmpty,
+			},
+			{
+				desc:   "UnknownScope",
+				modify: func(device *pb.Device) { device.Scopes = []string{"products.write"} },
+				want:   ErrScopeUnknown,
+			},
+			{
+				desc:   "NotFound",
+				modify: func(device *pb.Device) { device.Name = testresources.Mall_Kiosk.Name },
+				want:   &NotFoundError{Name: testresources.Mall_Kiosk.Name},
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				updated := Clone(testresources.Bar_Kiosk)
+				test.modify(updated)
+				if got := r.UpdateDevice(ctx, updated); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+					t.Errorf("r.UpdateDevice(%v, %v) = %v; want %v", ctx, updated, got, test.want)
+				}
+			})
+		}
+	})
+}
+
+func TestRepository_DeleteDevice(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("OK", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.Device{
+			testresources.Bar_Kiosk,
+			testresources.Mall_Kiosk,
+		})
+		if err := r.DeleteDevice(ctx, testresources.Bar_Kiosk.Name); err != nil {
+			t.Errorf("r.DeleteDevice(%v, %q) = %v; want nil", ctx, testresources.Bar_Kiosk.Name, err)
+		}
+		for _, test := range []struct {
+			desc       string
+			name       string
+			wantDevice *pb.Device
+			wantErr    error
+		}{
+			{
+				desc:       "LookupDeleted",
+				name:       testresources.Bar_Kiosk.Name,
+				wantDevice: nil,
+				wantErr:    &NotFoundError{Name: testresources.Bar_Kiosk.Name},
+			},
+			{
+				desc:       "LookupExisting",
+				name:       testresources.Mall_Kiosk.Name,
+				wantDevice: testresources.Mall_Kiosk,
+				wantErr:    nil,
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				device, err := r.LookupDevice(ctx, test.name)
+				if diff := cmp.Diff(device, test.wantDevice, protocmp.Transform()); diff != "" {
+					t.Errorf("r.LookupDevice(%v, %q) device != test.wantDevice (-got +want)\n%s", ctx, test.name, diff)
+				}
+				if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+					t.Errorf("r.LookupDevice(%v, %q) err = %v; want %v", ctx, test.name, err, test.wantErr)
+				}
+			})
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.Device{testresources.Bar_Kiosk})
+		for _, test := range []struct {
+			desc string
+			name string
+			want error
+		}{
+			{
+				desc: "EmptyName",
+				name: "",
+				want: resourcename.ErrInvalidName,
+			},
+			{
+				desc: "NotFound",
+				name: testresources.Mall_Kiosk.Name,
+				want: &NotFoundError{Name: testresources.Mall_Kiosk.Name},
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				if got := r.DeleteDevice(ctx, test.name); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+					t.Errorf("r.DeleteDevice(%v, %q) = %v; want %v", ctx, test.name, got, test.want)
+				}
+			})
+		}
+	})
+}
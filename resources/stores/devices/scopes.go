@@ -0,0 +1,48 @@
+package devices
+
+// Scopes grant devices access to a few related methods. The methods allowed
+// by each scope are listed in the documentation of the Device message.
+const (
+	ScopeStoresRead      = "stores.read"
+	ScopeUsersRead       = "users.read"
+	ScopeMembershipsRead = "memberships.read"
+	ScopeProductsRead    = "products.read"
+	ScopePurchasesRead   = "purchases.read"
+	ScopePurchasesCreate = "purchases.create"
+	ScopePaymentsRead    = "payments.read"
+	ScopePaymentsCreate  = "payments.create"
+	ScopeCardsIdentify   = "cards.identify"
+)
+
+// Scopes contains every known scope.
+var Scopes = []string{
+	ScopeStoresRead,
+	ScopeUsersRead,
+	ScopeMembershipsRead,
+	ScopeProductsRead,
+	ScopePurchasesRead,
+	ScopePurchasesCreate,
+	ScopePaymentsRead,
+	ScopePaymentsCreate,
+	ScopeCardsIdentify,
+}
+
+// KnownScope returns whether scope is one of Scopes.
+func KnownScope(scope string) bool {
+	for _, known := range Scopes {
+		if scope == known {
+			return true
+		}
+	}
+	return false
+}
+
+// HasScope returns whether scope is among scopes.
+func HasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
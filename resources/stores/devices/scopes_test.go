@@ -0,0 +1,33 @@
+package devices
+
+import "testing"
+
+func TestKnownScope(t *testing.T) {
+	for _, scope := range Scopes {
+		if !KnownScope(scope) {
+			t.Errorf("KnownScope(%q) = false; want true", scope)
+		}
+	}
+	for _, scope := range []string{"", "products.write", "PRODUCTS.READ"} {
+		if KnownScope(scope) {
+			t.Errorf("KnownScope(%q) = true; want false", scope)
+		}
+	}
+}
+
+func TestHasScope(t *testing.T) {
+	scopes := []string{ScopeProductsRead, ScopePurchasesCreate}
+	for _, test := range []struct {
+		scope string
+		want  bool
+	}{
+		{scope: ScopeProductsRead, want: true},
+		{scope: ScopePurchasesCreate, want: true},
+		{scope: ScopePurchasesRead, want: false},
+		{scope: "", want: false},
+	} {
+		if got := HasScope(scopes, test.scope); got != test.want {
+			t.Errorf("HasScope(%q, %q) = %v; want %v", scopes, test.scope, got, test.want)
+		}
+	}
+}
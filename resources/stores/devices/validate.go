@@ -0,0 +1,41 @@
+package devices
+
+import (
+	"errors"
+
+	pb "github.com/Saser/strecku/api/v1"
+)
+
+var (
+	ErrDisplayNameEmpty  = errors.New("display name is empty")
+	ErrScopesEmpty       = errors.New("scopes are empty")
+	ErrScopeUnknown      = errors.New("unknown scope")
+	ErrScopeDuplicate    = errors.New("duplicate scope")
+	ErrCreateTimeMissing = errors.New("create time is missing")
+)
+
+func Validate(device *pb.Device) error {
+	if err := ValidateName(device.Name); err != nil {
+		return err
+	}
+	if device.DisplayName == "" {
+		return ErrDisplayNameEmpty
+	}
+	if len(device.Scopes) == 0 {
+		return ErrScopesEmpty
+	}
+	seen := make(map[string]bool)
+	for _, scope := range device.Scopes {
+		if !KnownScope(scope) {
+			return ErrScopeUnknown
+		}
+		if seen[scope] {
+			return ErrScopeDuplicate
+		}
+		seen[scope] = true
+	}
+	if device.CreateTime == nil {
+		return ErrCreateTimeMissing
+	}
+	return nil
+}
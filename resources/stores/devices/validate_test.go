@@ -0,0 +1,63 @@
+package devices
+
+import (
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		desc   string
+		modify func(device *pb.Device)
+		want   error
+	}{
+		{
+			desc:   "OK",
+			modify: func(*pb.Device) {},
+			want:   nil,
+		},
+		{
+			desc:   "InvalidName",
+			modify: func(device *pb.Device) { device.Name = testresources.Bar.Name },
+			want:   resourcename.ErrInvalidName,
+		},
+		{
+			desc:   "EmptyDisplayName",
+			modify: func(device *pb.Device) { device.DisplayName = "" },
+			want:   ErrDisplayNameEmpty,
+		},
+		{
+			desc:   "EmptyScopes",
+			modify: func(device *pb.Device) { device.Scopes = nil },
+			want:   ErrScopesEmpty,
+		},
+		{
+			desc:   "UnknownScope",
+			modify: func(device *pb.Device) { device.Scopes = append(device.Scopes, "products.write") },
+			want:   ErrScopeUnknown,
+		},
+		{
+			desc:   "DuplicateScope",
+			modify: func(device *pb.Device) { device.Scopes = append(device.Scopes, device.Scopes[0]) },
+			want:   ErrScopeDuplicate,
+		},
+		{
+			desc:   "MissingCreateTime",
+			modify: func(device *pb.Device) { device.CreateTime = nil },
+			want:   ErrCreateTimeMissing,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			device := Clone(testresources.Bar_Kiosk)
+			test.modify(device)
+			if got := Validate(device); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+				t.Errorf("Validate(%v) = %v; want %v", device, got, test.want)
+			}
+		})
+	}
+}
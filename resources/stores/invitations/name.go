@@ -0,0 +1,44 @@
+package invitations
+
+import (
+	"github.com/Saser/strecku/resources/stores"
+	"github.com/google/uuid"
+)
+
+const CollectionID = "invitations"
+
+var NameFormat = stores.NameFormat.MustAppend("/" + CollectionID + "/{invitation}")
+
+func GenerateName(store string) string {
+	uuids, err := stores.NameFormat.Parse(store)
+	if err != nil {
+		panic(err)
+	}
+	uuids["invitation"] = uuid.New()
+	name, err := NameFormat.Format(uuids)
+	if err != nil {
+		panic(err)
+	}
+	return name
+}
+
+func ParseName(name string) (store uuid.UUID, invitation uuid.UUID, err error) {
+	uuids, err := NameFormat.Parse(name)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+	return uuids["store"], uuids["invitation"], nil
+}
+
+func ValidateName(name string) error {
+	_, _, err := ParseName(name)
+	return err
+}
+
+func Parent(name string) (string, error) {
+	uuids, err := NameFormat.Parse(name)
+	if err != nil {
+		return "", err
+	}
+	return stores.NameFormat.Format(uuids)
+}
@@ -0,0 +1,134 @@
+package invitations
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type NotFoundError struct {
+	Name string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("invitation not found: %q", e.Name)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	other, ok := target.(*NotFoundError)
+	if !ok {
+		return false
+	}
+	return other.Name == e.Name
+}
+
+type ExistsError struct {
+	Name string
+}
+
+func (e *ExistsError) Error() string {
+	return fmt.Sprintf("invitation exists: %q", e.Name)
+}
+
+func (e *ExistsError) Is(target error) bool {
+	other, ok := target.(*ExistsError)
+	if !ok {
+		return false
+	}
+	return other.Name == e.Name
+}
+
+func Clone(invitation *pb.Invitation) *pb.Invitation {
+	return proto.Clone(invitation).(*pb.Invitation)
+}
+
+type Repository struct {
+	invitations map[string]*pb.Invitation // name -> invitation
+}
+
+func NewRepository() *Repository {
+	return newRepository(make(map[string]*pb.Invitation))
+}
+
+func SeedRepository(t *testing.T, invitations []*pb.Invitation) *Repository {
+	mInvitations := make(map[string]*pb.Invitation)
+	for _, invitation := range invitations {
+		if err := Validate(invitation); err != nil {
+			t.Errorf("Validate(%v) = %v; want nil", invitation, err)
+		}
+		mInvitations[invitation.Name] = invitation
+	}
+	if t.Failed() {
+		t.FailNow()
+	}
+	return newRepository(mInvitations)
+}
+
+func newRepository(invitations map[string]*pb.Invitation) *Repository {
+	return &Repository{
+		invitations: invitations,
+	}
+}
+
+func (r *Repository) LookupInvitation(_ context.Context, name string) (*pb.Invitation, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	invitation, ok := r.invitations[name]
+	if !ok {
+		return nil, &NotFoundError{Name: name}
+	}
+	return Clone(invitation), nil
+}
+
+func (r *Repository) ListInvitations(ctx context.Context) ([]*pb.Invitation, error) {
+	return r.FilterInvitations(ctx, func(*pb.Invitation) bool { return true })
+}
+
+func (r *Repository) FilterInvitations(_ context.Context, predicate func(*pb.Invitation) bool) ([]*pb.Invitation, error) {
+	var filtered []*pb.Invitation
+	for _, invitation := range r.invitations {
+		if predicate(invitation) {
+			filtered = append(filtered, Clone(invitation))
+		}
+	}
+	return filtered, nil
+}
+
+func (r *Repository) CreateInvitation(_ context.Context, invitation *pb.Invitation) error {
+	if err := Validate(invitation); err != nil {
+		return err
+	}
+	name := invitation.Name
+	if _, exists := r.invitations[name]; exists {
+		return &ExistsError{Name: name}
+	}
+	r.invitations[name] = Clone(invitation)
+	return nil
+}
+
+func (r *Repository) UpdateInvitation(_ context.Context, updated *pb.Invitation) error {
+	if err := Validate(updated); err != nil {
+		return err
+	}
+	name := updated.Name
+	if _, ok := r.invitations[name]; !ok {
+		return &NotFoundError{Name: name}
+	}
+	r.invitations[name] = Clone(updated)
+	return nil
+}
+
+func (r *Repository) DeleteInvitation(_ context.Context, name string) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	if _, exists := r.invitations[name]; !exists {
+		return &NotFoundError{Name: name}
+	}
+	delete(r.invitations, name)
+	return nil
+}
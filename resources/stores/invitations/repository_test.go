@@ -0,0 +1,363 @@
+package invitations
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func invitationLess(p1, p2 *pb.Invitation) bool {
+	return p1.Name < p2.Name
+}
+
+func TestNotFoundError_Error(t *testing.T) {
+	err := &NotFoundError{Name: testresources.Bar_Carol_Invitation.Name}
+	want := fmt.Sprintf("invitation not found: %q", testresources.Bar_Carol_Invitation.Name)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestNotFoundError_Is(t *testing.T) {
+	for _, test := range []struct {
+		err    *NotFoundError
+		target error
+		want   bool
+	}{
+		{
+			err:    &NotFoundError{Name: testresources.Bar_Carol_Invitation.Name},
+			target: &NotFoundError{Name: testresources.Bar_Carol_Invitation.Name},
+			want:   true,
+		},
+		{
+			err:    &NotFoundError{Name: testresources.Bar_Carol_Invitation.Name},
+			target: &NotFoundError{Name: testresources.Mall_Bob_Invitation.Name},
+			want:   false,
+		},
+		{
+			err:    &NotFoundError{Name: testresources.Bar_Carol_Invitation.Name},
+			target: fmt.Errorf("invitation not found: %q", testresources.Bar_Carol_Invitation.Name),
+			want:   false,
+		},
+	} {
+		if got := test.err.Is(test.target); got != test.want {
+			t.Errorf("test.err.Is(%v) = %v; want %v", test.target, got, test.want)
+		}
+	}
+}
+
+func TestExistsError_Error(t *testing.T) {
+	err := &ExistsError{Name: testresources.Bar_Carol_Invitation.Name}
+	want := fmt.Sprintf("invitation exists: %q", testresources.Bar_Carol_Invitation.Name)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestExistsError_Is(t *testing.T) {
+	for _, test := range []struct {
+		err    *ExistsError
+		target error
+		want   bool
+	}{
+		{
+			err:    &ExistsError{Name: testresources.Bar_Carol_Invitation.Name},
+			target: &ExistsError{Name: testresources.Bar_Carol_Invitation.Name},
+			want:   true,
+		},
+		{
+			err:    &ExistsError{Name: testresources.Bar_Carol_Invitation.Name},
+			target: &ExistsError{Name: testresources.Mall_Bob_Invitation.Name},
+			want:   false,
+		},
+		{
+			err:    &ExistsError{Name: testresources.Bar_Carol_Invitation.Name},
+			target: fmt.Errorf("invitation exists: %q", testresources.Bar_Carol_Invitation.Name),
+			want:   false,
+		},
+	} {
+		if got := test.err.Is(test.target); got != test.want {
+			t.Errorf("test.err.Is(%v) = %v; want %v", test.target, got, test.want)
+		}
+	}
+}
+
+func TestNewRepository(t *testing.T) {
+	NewRepository()
+}
+
+func TestRepository_LookupInvitation(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.Invitation{testresources.Bar_Carol_Invitation})
+	for _, test := range []struct {
+		desc           string
+		name           string
+		wantInvitation *pb.Invitation
+		wantErr        error
+	}{
+		{
+			desc:           "OK",
+			name:           testresources.Bar_Carol_Invitation.Name,
+			wantInvitation: testresources.Bar_Carol_Invitation,
+			wantErr:        nil,
+		},
+		{
+			desc:           "EmptyName",
+			name:           "",
+			wantInvitation: nil,
+			wantErr:        resourcename.ErrInvalidName,
+		},
+		{
+			desc:           "NotFound",
+			name:           testresources.Mall_Bob_Invitation.Name,
+			wantInvitation: nil,
+			wantErr:        &NotFoundError{Name: testresources.Mall_Bob_Invitation.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			invitation, err := r.LookupInvitation(ctx, test.name)
+			if diff := cmp.Diff(invitation, test.wantInvitation, protocmp.Transform()); diff != "" {
+				t.Errorf("r.LookupInvitation(%v, %q) invitation != test.wantInvitation (-got +want)\n%s", ctx, test.name, diff)
+			}
+			if got, want := err, test.wantErr; !cmp.Equal(got, want, cmpopts.EquateErrors()) {
+				t.Errorf("r.LookupInvitation(%v, %q) err = %v; want %v", ctx, test.name, got, want)
+			}
+		})
+	}
+}
+
+func TestRepository_ListInvitations(t *testing.T) {
+	ctx := context.Background()
+	want := []*pb.Invitation{
+		testresources.Bar_Carol_Invitation,
+		testresources.Mall_Bob_Invitation,
+		testresources.Bar_Expired_Invitation,
+	}
+	r := SeedRepository(t, want)
+	invitations, err := r.ListInvitations(ctx)
+	if diff := cmp.Diff(
+		invitations, want, protocmp.Transform(),
+		cmpopts.SortSlices(invitationLess),
+	); diff != "" {
+		t.Errorf("r.ListInvitations(%v) invitations != want (-got +want)\n%s", ctx, diff)
+	}
+	if err != nil {
+		t.Errorf("r.ListInvitations(%v) err = %v; want nil", ctx, err)
+	}
+}
+
+func TestRepository_FilterInvitations(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.Invitation{
+		testresources.Bar_Carol_Invitation,
+		testresources.Mall_Bob_Invitation,
+		testresources.Bar_Expired_Invitation,
+	})
+	for _, test := range []struct {
+		name      string
+		predicate func(*pb.Invitation) bool
+		want      []*pb.Invitation
+	}{
+		{
+			name:      "NoneMatching",
+			predicate: func(*pb.Invitation) bool { return false },
+			want:      nil,
+		},
+		{
+			name: "OneMatching",
+			predicate: func(invitation *pb.Invitation) bool {
+				return invitation.Name == testresources.Bar_Carol_Invitation.Name
+			},
+			want: []*pb.Invitation{
+				testresources.Bar_Carol_Invitation,
+			},
+		},
+		{
+			name: "MultipleMatching",
+			predicate: func(invitation *pb.Invitation) bool {
+				return invitation.Name == testresources.Bar_Carol_Invitation.Name || invitation.Name == testresources.Mall_Bob_Invitation.Name
+			},
+			want: []*pb.Invitation{
+				testresources.Bar_Carol_Invitation,
+				testresources.Mall_Bob_Invitation,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			invitations, err := r.FilterInvitations(ctx, test.predicate)
+			if diff := cmp.Diff(
+				invitations, test.want, protocmp.Transform(),
+				cmpopts.EquateEmpty(),
+				cmpopts.SortSlices(invitationLess),
+			); diff != "" {
+				t.Errorf("r.FilterInvitations(%v, test.predicate) invitations != test.want (-got +want)\n%s", ctx, diff)
+			}
+			if got, want := err, error(nil); !cmp.Equal(got, want) {
+				t.Errorf("r.FilterInvitations(%v, test.predicate) err = %v; want %v", ctx, got, want)
+			}
+		})
+	}
+}
+
+func TestRepository_CreateInvitation(t *testing.T) {
+	ctx := context.Background()
+	duplicateName := Clone(testresources.Mall_Bob_Invitation)
+	duplicateName.Name = testresources.Bar_Carol_Invitation.Name
+	for _, test := range []struct {
+		desc       string
+		invitation *pb.Invitation
+		want       error
+	}{
+		{
+			desc:       "OneInvitationOK",
+			invitation: testresources.Mall_Bob_Invitation,
+			want:       nil,
+		},
+		{
+			desc:       "DuplicateName",
+			invitation: duplicateName,
+			want:       &ExistsError{Name: testresources.Bar_Carol_Invitation.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			r := SeedRepository(t, []*pb.Invitation{testresources.Bar_Carol_Invitation})
+			if got := r.CreateInvitation(ctx, test.invitation); !cmp.Equal(got, test.want) {
+				t.Errorf("r.CreateInvitation(%v, %v) = %v; want %v", ctx, test.invitation, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRepository_UpdateInvitation(t *testing.T) {
+	ctx := context.Background()
+	// Test scenario where the update is successful.
+	t.Run("OK", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.Invitation{testresources.Bar_Carol_Invitation})
+		updated := Clone(testresources.Bar_Carol_Invitation)
+		updated.State = pb.Invitation_DECLINED
+		if err := r.UpdateInvitation(ctx, updated); err != nil {
+			t.Errorf("r.UpdateInvitation(%v, %v) = %v; want nil", ctx, updated, err)
+		}
+		invitation, err := r.LookupInvitation(ctx, updated.Name)
+		if diff := cmp.Diff(invitation, updated, protocmp.Transform()); diff != "" {
+			t.Errorf("r.LookupInvitation(%v, %q) invitation != updated (-got +want)\n%s", ctx, updated.Name, diff)
+		}
+		if err != nil {
+			t.Errorf("r.LookupInvitation(%v, %q) err = %v; want nil", ctx, updated.Name, err)
+		}
+	})
+
+	// Test scenario where the update fails.
+	t.Run("Errors", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.Invitation{testresources.Bar_Carol_Invitation})
+		for _, test := range []struct {
+			desc   string
+			modify func(invitation *pb.Invitation)
+			want   error
+		}{
+			{
+				desc:   "EmptyName",
+				modify: func(invitation *pb.Invitation) { invitation.Name = "" },
+				want:   resourcename.ErrInvalidName,
+			},
+			{
+				desc:   "EmptyEmailAddress",
+				modify: func(invitation *pb.Invitation) { invitation.EmailAddress = "" },
+				want:   ErrEmailAddressEmpty,
+			},
+			{
+				desc:   "UnspecifiedState",
+				modify: func(invitation *pb.Invitation) { invitation.State = pb.Invitation_STATE_UNSPECIFIED },
+				want:   ErrStateUnspecified,
+			},
+			{
+				desc:   "NotFound",
+				modify: func(invitation *pb.Invitation) { invitation.Name = testresources.Mall_Bob_Invitation.Name },
+				want:   &NotFoundError{Name: testresources.Mall_Bob_Invitation.Name},
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				updated := Clone(testresources.Bar_Carol_Invitation)
+				test.modify(updated)
+				if got := r.UpdateInvitation(ctx, updated); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+					t.Errorf("r.UpdateInvitation(%v, %v) = %v; want %v", ctx, updated, got, test.want)
+				}
+			})
+		}
+	})
+}
+
+func TestRepository_DeleteInvitation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("OK", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.Invitation{
+			testresources.Bar_Carol_Invitation,
+			testresources.Mall_Bob_Invitation,
+		})
+		if err := r.DeleteInvitation(ctx, testresources.Bar_Carol_Invitation.Name); err != nil {
+			t.Errorf("r.DeleteInvitation(%v, %q) = %v; want nil", ctx, testresources.Bar_Carol_Invitation.Name, err)
+		}
+		for _, test := range []struct {
+			desc           string
+			name           string
+			wantInvitation *pb.Invitation
+			wantErr        error
+		}{
+			{
+				desc:           "LookupDeleted",
+				name:           testresources.Bar_Carol_Invitation.Name,
+				wantInvitation: nil,
+				wantErr:        &NotFoundError{Name: testresources.Bar_Carol_Invitation.Name},
+			},
+			{
+				desc:           "LookupExisting",
+				name:           testresources.Mall_Bob_Invitation.Name,
+				wantInvitation: testresources.Mall_Bob_Invitation,
+				wantErr:        nil,
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				invitation, err := r.LookupInvitation(ctx, test.name)
+				if diff := cmp.Diff(invitation, test.wantInvitation, protocmp.Transform()); diff != "" {
+					t.Errorf("r.LookupInvitation(%v, %q) invitation != test.wantInvitation (-got +want)\n%s", ctx, test.name, diff)
+				}
+				if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+					t.Errorf("r.LookupInvitation(%v, %q) err = %v; want %v", ctx, test.name, err, test.wantErr)
+				}
+			})
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.Invitation{testresources.Bar_Carol_Invitation})
+		for _, test := range []struct {
+			desc string
+			name string
+			want error
+		}{
+			{
+				desc: "EmptyName",
+				name: "",
+				want: resourcename.ErrInvalidName,
+			},
+			{
+				desc: "NotFound",
+				name: testresources.Mall_Bob_Invitation.Name,
+				want: &NotFoundError{Name: testresources.Mall_Bob_Invitation.Name},
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				if got := r.DeleteInvitation(ctx, test.name); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+					t.Errorf("r.DeleteInvitation(%v, %q) = %v; want %v", ctx, test.name, got, test.want)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package invitations
+
+import (
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+)
+
+// DefaultTTL is how long invitations are valid, if they are created without an
+// expire time.
+const DefaultTTL = 14 * 24 * time.Hour
+
+// State returns the state of invitation at the given time. Pending invitations
+// whose expire time has passed are expired; all other invitations have the
+// state that is stored in them.
+func State(invitation *pb.Invitation, now time.Time) pb.Invitation_State {
+	if invitation.State == pb.Invitation_PENDING && invitation.ExpireTime != nil && !now.Before(invitation.ExpireTime.AsTime()) {
+		return pb.Invitation_EXPIRED
+	}
+	return invitation.State
+}
@@ -0,0 +1,39 @@
+package invitations
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+)
+
+func TestState(t *testing.T) {
+	expireTime := testresources.Bar_Carol_Invitation.ExpireTime.AsTime()
+	for _, test := range []struct {
+		desc  string
+		state pb.Invitation_State
+		now   time.Time
+		want  pb.Invitation_State
+	}{
+		{desc: "Pending", state: pb.Invitation_PENDING, now: expireTime.Add(-time.Second), want: pb.Invitation_PENDING},
+		{desc: "PendingAtExpireTime", state: pb.Invitation_PENDING, now: expireTime, want: pb.Invitation_EXPIRED},
+		{desc: "PendingAfterExpireTime", state: pb.Invitation_PENDING, now: expireTime.Add(time.Second), want: pb.Invitation_EXPIRED},
+		{desc: "AcceptedAfterExpireTime", state: pb.Invitation_ACCEPTED, now: expireTime.Add(time.Second), want: pb.Invitation_ACCEPTED},
+		{desc: "DeclinedAfterExpireTime", state: pb.Invitation_DECLINED, now: expireTime.Add(time.Second), want: pb.Invitation_DECLINED},
+	} {
+		invitation := Clone(testresources.Bar_Carol_Invitation)
+		invitation.State = test.state
+		if got := State(invitation, test.now); got != test.want {
+			t.Errorf("%s: State(%v, %v) = %v; want %v", test.desc, invitation, test.now, got, test.want)
+		}
+	}
+
+	t.Run("NoExpireTime", func(t *testing.T) {
+		invitation := Clone(testresources.Bar_Carol_Invitation)
+		invitation.ExpireTime = nil
+		if got, want := State(invitation, expireTime.Add(time.Second)), pb.Invitation_PENDING; got != want {
+			t.Errorf("State(%v) = %v; want %v", invitation, got, want)
+		}
+	})
+}
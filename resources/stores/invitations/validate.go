@@ -0,0 +1,54 @@
+package invitations
+
+import (
+	"errors"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/memberships"
+)
+
+var (
+	ErrEmailAddressEmpty      = errors.New("email address is empty")
+	ErrCreateTimeMissing      = errors.New("create time is missing")
+	ErrExpireTimeBeforeCreate = errors.New("expire time is before create time")
+	ErrStateUnspecified       = errors.New("state is unspecified")
+	ErrMembershipWrongStore   = errors.New("membership belongs to another store")
+	ErrMembershipNotAccepted  = errors.New("membership is set, but invitation is not accepted")
+	ErrMembershipMissing      = errors.New("invitation is accepted, but membership is not set")
+)
+
+func Validate(invitation *pb.Invitation) error {
+	if err := ValidateName(invitation.Name); err != nil {
+		return err
+	}
+	if invitation.EmailAddress == "" {
+		return ErrEmailAddressEmpty
+	}
+	if invitation.CreateTime == nil {
+		return ErrCreateTimeMissing
+	}
+	if invitation.ExpireTime != nil && invitation.ExpireTime.AsTime().Before(invitation.CreateTime.AsTime()) {
+		return ErrExpireTimeBeforeCreate
+	}
+	switch invitation.State {
+	case pb.Invitation_STATE_UNSPECIFIED:
+		return ErrStateUnspecified
+	case pb.Invitation_ACCEPTED:
+		if invitation.Membership == "" {
+			return ErrMembershipMissing
+		}
+		membershipStore, err := memberships.Parent(invitation.Membership)
+		if err != nil {
+			return err
+		}
+		store, _ := Parent(invitation.Name)
+		if membershipStore != store {
+			return ErrMembershipWrongStore
+		}
+	default:
+		if invitation.Membership != "" {
+			return ErrMembershipNotAccepted
+		}
+	}
+	return nil
+}
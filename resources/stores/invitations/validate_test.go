@@ -0,0 +1,87 @@
+package invitations
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		desc   string
+		modify func(invitation *pb.Invitation)
+		want   error
+	}{
+		{
+			desc:   "OK",
+			modify: func(*pb.Invitation) {},
+			want:   nil,
+		},
+		{
+			desc: "OK_Accepted",
+			modify: func(invitation *pb.Invitation) {
+				invitation.State = pb.Invitation_ACCEPTED
+				invitation.Membership = testresources.Bar_Alice.Name
+			},
+			want: nil,
+		},
+		{
+			desc:   "OK_NoExpireTime",
+			modify: func(invitation *pb.Invitation) { invitation.ExpireTime = nil },
+			want:   nil,
+		},
+		{
+			desc:   "EmptyEmailAddress",
+			modify: func(invitation *pb.Invitation) { invitation.EmailAddress = "" },
+			want:   ErrEmailAddressEmpty,
+		},
+		{
+			desc:   "MissingCreateTime",
+			modify: func(invitation *pb.Invitation) { invitation.CreateTime = nil },
+			want:   ErrCreateTimeMissing,
+		},
+		{
+			desc: "ExpireTimeBeforeCreateTime",
+			modify: func(invitation *pb.Invitation) {
+				invitation.ExpireTime = timestamppb.New(invitation.CreateTime.AsTime().Add(-time.Hour))
+			},
+			want: ErrExpireTimeBeforeCreate,
+		},
+		{
+			desc:   "UnspecifiedState",
+			modify: func(invitation *pb.Invitation) { invitation.State = pb.Invitation_STATE_UNSPECIFIED },
+			want:   ErrStateUnspecified,
+		},
+		{
+			desc:   "AcceptedWithoutMembership",
+			modify: func(invitation *pb.Invitation) { invitation.State = pb.Invitation_ACCEPTED },
+			want:   ErrMembershipMissing,
+		},
+		{
+			desc: "AcceptedWithMembershipInOtherStore",
+			modify: func(invitation *pb.Invitation) {
+				invitation.State = pb.Invitation_ACCEPTED
+				invitation.Membership = testresources.Mall_Alice.Name
+			},
+			want: ErrMembershipWrongStore,
+		},
+		{
+			desc:   "PendingWithMembership",
+			modify: func(invitation *pb.Invitation) { invitation.Membership = testresources.Bar_Alice.Name },
+			want:   ErrMembershipNotAccepted,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			invitation := Clone(testresources.Bar_Carol_Invitation)
+			test.modify(invitation)
+			if got := Validate(invitation); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+				t.Errorf("Validate(%v) = %v; want %v", invitation, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package joinrequests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type NotFoundError struct {
+	Name string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("join request not found: %q", e.Name)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	other, ok := target.(*NotFoundError)
+	if !ok {
+		return false
+	}
+	return other.Name == e.Name
+}
+
+type ExistsError struct {
+	Name string
+}
+
+func (e *ExistsError) Error() string {
+	return fmt.Sprintf("join request exists: %q", e.Name)
+}
+
+func (e *ExistsError) Is(target error) bool {
+	other, ok := target.(*ExistsError)
+	if !ok {
+		return false
+	}
+	return other.Name == e.Name
+}
+
+func Clone(joinRequest *pb.JoinRequest) *pb.JoinRequest {
+	return proto.Clone(joinRequest).(*pb.JoinRequest)
+}
+
+type Repository struct {
+	joinRequests map[string]*pb.JoinRequest // name -> join request
+}
+
+func NewRepository() *Repository {
+	return newRepository(make(map[string]*pb.JoinRequest))
+}
+
+func SeedRepository(t *testing.T, joinRequests []*pb.JoinRequest) *Repository {
+	mJoinRequests := make(map[string]*pb.JoinRequest)
+	for _, joinRequest := range joinRequests {
+		if err := Validate(joinRequest); err != nil {
+			t.Errorf("Validate(%v) = %v; want nil", joinRequest, err)
+		}
+		mJoinRequests[joinRequest.Name] = joinRequest
+	}
+	if t.Failed() {
+		t.FailNow()
+	}
+	return newRepository(mJoinRequests)
+}
+
+func newRepository(joinRequests map[string]*pb.JoinRequest) *Repository {
+	return &Repository{
+		joinRequests: joinRequests,
+	}
+}
+
+func (r *Repository) LookupJoinRequest(_ context.Context, name string) (*pb.JoinRequest, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	joinRequest, ok := r.joinRequests[name]
+	if !ok {
+		return nil, &NotFoundError{Name: name}
+	}
+	return Clone(joinRequest), nil
+}
+
+func (r *Repository) ListJoinRequests(ctx context.Context) ([]*pb.JoinRequest, error) {
+	return r.FilterJoinRequests(ctx, func(*pb.JoinRequest) bool { return true })
+}
+
+func (r *Repository) FilterJoinRequests(_ context.Context, predicate func(*pb.JoinRequest) bool) ([]*pb.JoinRequest, error) {
+	var filtered []*pb.JoinRequest
+	for _, joinRequest := range r.joinRequests {
+		if predicate(joinRequest) {
+			filtered = append(filtered, Clone(joinRequest))
+		}
+	}
+	return filtered, nil
+}
+
+func (r *Repository) CreateJoinRequest(_ context.Context, joinRequest *pb.JoinRequest) error {
+	if err := Validate(joinRequest); err != nil {
+		return err
+	}
+	name := joinRequest.Name
+	if _, exists := r.joinRequests[name]; exists {
+		return &ExistsError{Name: name}
+	}
+	r.joinRequests[name] = Clone(joinRequest)
+	return nil
+}
+
+func (r *Repository) UpdateJoinRequest(_ context.Context, updated *pb.JoinRequest) error {
+	if err := Validate(updated); err != nil {
+		return err
+	}
+	name := updated.Name
+	if _, ok := r.joinRequests[name]; !ok {
+		return &NotFoundError{Name: name}
+	}
+	r.joinRequests[name] = Clone(updated)
+	return nil
+}
+
+func (r *Repository) DeleteJoinRequest(_ context.Context, name string) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	if _, exists := r.joinRequests[name]; !exists {
+		return &NotFoundError{Name: name}
+	}
+	delete(r.joinRequests, name)
+	return nil
+}
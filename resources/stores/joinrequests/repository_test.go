@@ -0,0 +1,363 @@
+package joinrequests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func joinRequestLess(p1, p2 *pb.JoinRequest) bool {
+	return p1.Name < p2.Name
+}
+
+func TestNotFoundError_Error(t *testing.T) {
+	err := &NotFoundError{Name: testresources.Bar_Carol_JoinRequest.Name}
+	want := fmt.Sprintf("join request not found: %q", testresources.Bar_Carol_JoinRequest.Name)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestNotFoundError_Is(t *testing.T) {
+	for _, test := range []struct {
+		err    *NotFoundError
+		target error
+		want   bool
+	}{
+		{
+			err:    &NotFoundError{Name: testresources.Bar_Carol_JoinRequest.Name},
+			target: &NotFoundError{Name: testresources.Bar_Carol_JoinRequest.Name},
+			want:   true,
+		},
+		{
+			err:    &NotFoundError{Name: testresources.Bar_Carol_JoinRequest.Name},
+			target: &NotFoundError{Name: testresources.Mall_Bob_JoinRequest.Name},
+			want:   false,
+		},
+		{
+			err:    &NotFoundError{Name: testresources.Bar_Carol_JoinRequest.Name},
+			target: fmt.Errorf("join request not found: %q", testresources.Bar_Carol_JoinRequest.Name),
+			want:   false,
+		},
+	} {
+		if got := test.err.Is(test.target); got != test.want {
+			t.Errorf("test.err.Is(%v) = %v; want %v", test.target, got, test.want)
+		}
+	}
+}
+
+func TestExistsError_Error(t *testing.T) {
+	err := &ExistsError{Name: testresources.Bar_Carol_JoinRequest.Name}
+	want := fmt.Sprintf("join request exists: %q", testresources.Bar_Carol_JoinRequest.Name)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestExistsError_Is(t *testing.T) {
+	for _, test := range []struct {
+		err    *ExistsError
+		target error
+		want   bool
+	}{
+		{
+			err:    &ExistsError{Name: testresources.Bar_Carol_JoinRequest.Name},
+			target: &ExistsError{Name: testresources.Bar_Carol_JoinRequest.Name},
+			want:   true,
+		},
+		{
+			err:    &ExistsError{Name: testresources.Bar_Carol_JoinRequest.Name},
+			target: &ExistsError{Name: testresources.Mall_Bob_JoinRequest.Name},
+			want:   false,
+		},
+		{
+			err:    &ExistsError{Name: testresources.Bar_Carol_JoinRequest.Name},
+			target: fmt.Errorf("join request exists: %q", testresources.Bar_Carol_JoinRequest.Name),
+			want:   false,
+		},
+	} {
+		if got := test.err.Is(test.target); got != test.want {
+			t.Errorf("test.err.Is(%v) = %v; want %v", test.target, got, test.want)
+		}
+	}
+}
+
+func TestNewRepository(t *testing.T) {
+	NewRepository()
+}
+
+func TestRepository_LookupJoinRequest(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.JoinRequest{testresources.Bar_Carol_JoinRequest})
+	for _, test := range []struct {
+		desc            string
+		name            string
+		wantJoinRequest *pb.JoinRequest
+		wantErr         error
+	}{
+		{
+			desc:            "OK",
+			name:            testresources.Bar_Carol_JoinRequest.Name,
+			wantJoinRequest: testresources.Bar_Carol_JoinRequest,
+			wantErr:         nil,
+		},
+		{
+			desc:            "EmptyName",
+			name:            "",
+			wantJoinRequest: nil,
+			wantErr:         resourcename.ErrInvalidName,
+		},
+		{
+			desc:            "NotFound",
+			name:            testresources.Mall_Bob_JoinRequest.Name,
+			wantJoinRequest: nil,
+			wantErr:         &NotFoundError{Name: testresources.Mall_Bob_JoinRequest.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			joinRequest, err := r.LookupJoinRequest(ctx, test.name)
+			if diff := cmp.Diff(joinRequest, test.wantJoinRequest, protocmp.Transform()); diff != "" {
+				t.Errorf("r.LookupJoinRequest(%v, %q) joinRequest != test.wantJoinRequest (-got +want)\n%s", ctx, test.name, diff)
+			}
+			if got, want := err, test.wantErr; !cmp.Equal(got, want, cmpopts.EquateErrors()) {
+				t.Errorf("r.LookupJoinRequest(%v, %q) err = %v; want %v", ctx, test.name, got, want)
+			}
+		})
+	}
+}
+
+func TestRepository_ListJoinRequests(t *testing.T) {
+	ctx := context.Background()
+	want := []*pb.JoinRequest{
+		testresources.Bar_Carol_JoinRequest,
+		testresources.Mall_Bob_JoinRequest,
+		testresources.Bar_Bob_JoinRequest,
+	}
+	r := SeedRepository(t, want)
+	joinRequests, err := r.ListJoinRequests(ctx)
+	if diff := cmp.Diff(
+		joinRequests, want, protocmp.Transform(),
+		cmpopts.SortSlices(joinRequestLess),
+	); diff != "" {
+		t.Errorf("r.ListJoinRequests(%v) joinRequests != want (-got +want)\n%s", ctx, diff)
+	}
+	if err != nil {
+		t.Errorf("r.ListJoinRequests(%v) err = %v; want nil", ctx, err)
+	}
+}
+
+func TestRepository_FilterJoinRequests(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.JoinRequest{
+		testresources.Bar_Carol_JoinRequest,
+		testresources.Mall_Bob_JoinRequest,
+		testresources.Bar_Bob_JoinRequest,
+	})
+	for _, test := range []struct {
+		name      string
+		predicate func(*pb.JoinRequest) bool
+		want      []*pb.JoinRequest
+	}{
+		{
+			name:      "NoneMatching",
+			predicate: func(*pb.JoinRequest) bool { return false },
+			want:      nil,
+		},
+		{
+			name: "OneMatching",
+			predicate: func(joinRequest *pb.JoinRequest) bool {
+				return joinRequest.Name == testresources.Bar_Carol_JoinRequest.Name
+			},
+			want: []*pb.JoinRequest{
+				testresources.Bar_Carol_JoinRequest,
+			},
+		},
+		{
+			name: "MultipleMatching",
+			predicate: func(joinRequest *pb.JoinRequest) bool {
+				return joinRequest.Name == testresources.Bar_Carol_JoinRequest.Name || joinRequest.Name == testresources.Mall_Bob_JoinRequest.Name
+			},
+			want: []*pb.JoinRequest{
+				testresources.Bar_Carol_JoinRequest,
+				testresources.Mall_Bob_JoinRequest,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			joinRequests, err := r.FilterJoinRequests(ctx, test.predicate)
+			if diff := cmp.Diff(
+				joinRequests, test.want, protocmp.Transform(),
+				cmpopts.EquateEmpty(),
+				cmpopts.SortSlices(joinRequestLess),
+			); diff != "" {
+				t.Errorf("r.FilterJoinRequests(%v, test.predicate) joinRequests != test.want (-got +want)\n%s", ctx, diff)
+			}
+			if got, want := err, error(nil); !cmp.Equal(got, want) {
+				t.Errorf("r.FilterJoinRequests(%v, test.predicate) err = %v; want %v", ctx, got, want)
+			}
+		})
+	}
+}
+
+func TestRepository_CreateJoinRequest(t *testing.T) {
+	ctx := context.Background()
+	duplicateName := Clone(testresources.Mall_Bob_JoinRequest)
+	duplicateName.Name = testresources.Bar_Carol_JoinRequest.Name
+	for _, test := range []struct {
+		desc        string
+		joinRequest *pb.JoinRequest
+		want        error
+	}{
+		{
+			desc:        "OneJoinRequestOK",
+			joinRequest: testresources.Mall_Bob_JoinRequest,
+			want:        nil,
+		},
+		{
+			desc:        "DuplicateName",
+			joinRequest: duplicateName,
+			want:        &ExistsError{Name: testresources.Bar_Carol_JoinRequest.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			r := SeedRepository(t, []*pb.JoinRequest{testresources.Bar_Carol_JoinRequest})
+			if got := r.CreateJoinRequest(ctx, test.joinRequest); !cmp.Equal(got, test.want) {
+				t.Errorf("r.CreateJoinRequest(%v, %v) = %v; want %v", ctx, test.joinRequest, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRepository_UpdateJoinRequest(t *testing.T) {
+	ctx := context.Background()
+	// Test scenario where the update is successful.
+	t.Run("OK", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.JoinRequest{testresources.Bar_Carol_JoinRequest})
+		updated := Clone(testresources.Bar_Carol_JoinRequest)
+		updated.State = pb.JoinRequest_REJECTED
+		if err := r.UpdateJoinRequest(ctx, updated); err != nil {
+			t.Errorf("r.UpdateJoinRequest(%v, %v) = %v; want nil", ctx, updated, err)
+		}
+		joinRequest, err := r.LookupJoinRequest(ctx, updated.Name)
+		if diff := cmp.Diff(joinRequest, updated, protocmp.Transform()); diff != "" {
+			t.Errorf("r.LookupJoinRequest(%v, %q) joinRequest != updated (-got +want)\n%s", ctx, updated.Name, diff)
+		}
+		if err != nil {
+			t.Errorf("r.LookupJoinRequest(%v, %q) err = %v; want nil", ctx, updated.Name, err)
+		}
+	})
+
+	// Test scenario where the update fails.
+	t.Run("Errors", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.JoinRequest{testresources.Bar_Carol_JoinRequest})
+		for _, test := range []struct {
+			desc   string
+			modify func(joinRequest *pb.JoinRequest)
+			want   error
+		}{
+			{
+				desc:   "EmptyName",
+				modify: func(joinRequest *pb.JoinRequest) { joinRequest.Name = "" },
+				want:   resourcename.ErrInvalidName,
+			},
+			{
+				desc:   "InvalidUser",
+				modify: func(joinRequest *pb.JoinRequest) { joinRequest.User = "" },
+				want:   resourcename.ErrInvalidName,
+			},
+			{
+				desc:   "UnspecifiedState",
+				modify: func(joinRequest *pb.JoinRequest) { joinRequest.State = pb.JoinRequest_STATE_UNSPECIFIED },
+				want:   ErrStateUnspecified,
+			},
+			{
+				desc:   "NotFound",
+				modify: func(joinRequest *pb.JoinRequest) { joinRequest.Name = testresources.Mall_Bob_JoinRequest.Name },
+				want:   &NotFoundError{Name: testresources.Mall_Bob_JoinRequest.Name},
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				updated := Clone(testresources.Bar_Carol_JoinRequest)
+				test.modify(updated)
+				if got := r.UpdateJoinRequest(ctx, updated); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+					t.Errorf("r.UpdateJoinRequest(%v, %v) = %v; want %v", ctx, updated, got, test.want)
+				}
+			})
+		}
+	})
+}
+
+func TestRepository_DeleteJoinRequest(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("OK", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.JoinRequest{
+			testresources.Bar_Carol_JoinRequest,
+			testresources.Mall_Bob_JoinRequest,
+		})
+		if err := r.DeleteJoinRequest(ctx, testresources.Bar_Carol_JoinRequest.Name); err != nil {
+			t.Errorf("r.DeleteJoinRequest(%v, %q) = %v; want nil", ctx, testresources.Bar_Carol_JoinRequest.Name, err)
+		}
+		for _, test := range []struct {
+			desc            string
+			name            string
+			wantJoinRequest *pb.JoinRequest
+			wantErr         error
+		}{
+			{
+				desc:            "LookupDeleted",
+				name:            testresources.Bar_Carol_JoinRequest.Name,
+				wantJoinRequest: nil,
+				wantErr:         &NotFoundError{Name: testresources.Bar_Carol_JoinRequest.Name},
+			},
+			{
+				desc:            "LookupExisting",
+				name:            testresources.Mall_Bob_JoinRequest.Name,
+				wantJoinRequest: testresources.Mall_Bob_JoinRequest,
+				wantErr:         nil,
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				joinRequest, err := r.LookupJoinRequest(ctx, test.name)
+				if diff := cmp.Diff(joinRequest, test.wantJoinRequest, protocmp.Transform()); diff != "" {
+					t.Errorf("r.LookupJoinRequest(%v, %q) joinRequest != test.wantJoinRequest (-got +want)\n%s", ctx, test.name, diff)
+				}
+				if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+					t.Errorf("r.LookupJoinRequest(%v, %q) err = %v; want %v", ctx, test.name, err, test.wantErr)
+				}
+			})
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.JoinRequest{testresources.Bar_Carol_JoinRequest})
+		for _, test := range []struct {
+			desc string
+			name string
+			want error
+		}{
+			{
+				desc: "EmptyName",
+				name: "",
+				want: resourcename.ErrInvalidName,
+			},
+			{
+				desc: "NotFound",
+				name: testresources.Mall_Bob_JoinRequest.Name,
+				want: &NotFoundError{Name: testresources.Mall_Bob_JoinRequest.Name},
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				if got := r.DeleteJoinRequest(ctx, test.name); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+					t.Errorf("r.DeleteJoinRequest(%v, %q) = %v; want %v", ctx, test.name, got, test.want)
+				}
+			})
+		}
+	})
+}
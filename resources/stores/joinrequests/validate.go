@@ -0,0 +1,50 @@
+package joinrequests
+
+import (
+	"errors"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/memberships"
+	"github.com/Saser/strecku/resources/users"
+)
+
+var (
+	ErrCreateTimeMissing     = errors.New("create time is missing")
+	ErrStateUnspecified      = errors.New("state is unspecified")
+	ErrMembershipWrongStore  = errors.New("membership belongs to another store")
+	ErrMembershipNotApproved = errors.New("membership is set, but join request is not approved")
+	ErrMembershipMissing     = errors.New("join request is approved, but membership is not set")
+)
+
+func Validate(joinRequest *pb.JoinRequest) error {
+	if err := ValidateName(joinRequest.Name); err != nil {
+		return err
+	}
+	if err := users.ValidateName(joinRequest.User); err != nil {
+		return err
+	}
+	if joinRequest.CreateTime == nil {
+		return ErrCreateTimeMissing
+	}
+	switch joinRequest.State {
+	case pb.JoinRequest_STATE_UNSPECIFIED:
+		return ErrStateUnspecified
+	case pb.JoinRequest_APPROVED:
+		if joinRequest.Membership == "" {
+			return ErrMembershipMissing
+		}
+		membershipStore, err := memberships.Parent(joinRequest.Membership)
+		if err != nil {
+			return err
+		}
+		store, _ := Parent(joinRequest.Name)
+		if membershipStore != store {
+			return ErrMembershipWrongStore
+		}
+	default:
+		if joinRequest.Membership != "" {
+			return ErrMembershipNotApproved
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package joinrequests
+
+import (
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		desc   string
+		modify func(joinRequest *pb.JoinRequest)
+		want   error
+	}{
+		{
+			desc:   "OK",
+			modify: func(*pb.JoinRequest) {},
+			want:   nil,
+		},
+		{
+			desc: "OK_Approved",
+			modify: func(joinRequest *pb.JoinRequest) {
+				joinRequest.State = pb.JoinRequest_APPROVED
+				joinRequest.Membership = testresources.Bar_Alice.Name
+			},
+			want: nil,
+		},
+		{
+			desc:   "InvalidUser",
+			modify: func(joinRequest *pb.JoinRequest) { joinRequest.User = testresources.Bar.Name },
+			want:   resourcename.ErrInvalidName,
+		},
+		{
+			desc:   "MissingCreateTime",
+			modify: func(joinRequest *pb.JoinRequest) { joinRequest.CreateTime = nil },
+			want:   ErrCreateTimeMissing,
+		},
+		{
+			desc:   "UnspecifiedState",
+			modify: func(joinRequest *pb.JoinRequest) { joinRequest.State = pb.JoinRequest_STATE_UNSPECIFIED },
+			want:   ErrStateUnspecified,
+		},
+		{
+			desc:   "ApprovedWithoutMembership",
+			modify: func(joinRequest *pb.JoinRequest) { joinRequest.State = pb.JoinRequest_APPROVED },
+			want:   ErrMembershipMissing,
+		},
+		{
+			desc: "ApprovedWithMembershipInOtherStore",
+			modify: func(joinRequest *pb.JoinRequest) {
+				joinRequest.State = pb.JoinRequest_APPROVED
+				joinRequest.Membership = testresources.Mall_Alice.Name
+			},
+			want: ErrMembershipWrongStore,
+		},
+		{
+			desc:   "PendingWithMembership",
+			modify: func(joinRequest *pb.JoinRequest) { joinRequest.Membership = testresources.Bar_Alice.Name },
+			want:   ErrMembershipNotApproved,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			joinRequest := Clone(testresources.Bar_Carol_JoinRequest)
+			test.modify(joinRequest)
+			if got := Validate(joinRequest); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+				t.Errorf("Validate(%v) = %v; want %v", joinRequest, got, test.want)
+			}
+		})
+	}
+}
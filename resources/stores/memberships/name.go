@@ -1,6 +1,7 @@
 package memberships
 
 import (
+	"github.com/Saser/strecku/resources/ocr"
 	"github.com/Saser/strecku/resources/stores"
 	"github.com/google/uuid"
 )
@@ -42,3 +43,13 @@ func Parent(name string) (string, error) {
 	}
 	return stores.NameFormat.Format(uuids)
 }
+
+// PaymentReference returns the payment reference of the membership with the
+// given name, which is an OCR number derived from the membership UUID.
+func PaymentReference(name string) (string, error) {
+	_, membership, err := ParseName(name)
+	if err != nil {
+		return "", err
+	}
+	return ocr.FromUUID(membership), nil
+}
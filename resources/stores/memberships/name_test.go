@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/resources/ocr"
 	"github.com/Saser/strecku/resources/stores"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -89,3 +90,20 @@ func TestParent(t *testing.T) {
 		}
 	}
 }
+
+func TestPaymentReference(t *testing.T) {
+	name := GenerateName(stores.GenerateName())
+	got, err := PaymentReference(name)
+	if err != nil {
+		t.Fatalf("PaymentReference(%q) err = %v; want nil", name, err)
+	}
+	if err := ocr.Validate(got); err != nil {
+		t.Errorf("ocr.Validate(%q) = %v; want nil", got, err)
+	}
+	if again, err := PaymentReference(name); again != got || err != nil {
+		t.Errorf("PaymentReference(%q) = %q, %v; want %q, nil", name, again, err, got)
+	}
+	if _, err := PaymentReference("stores/not-a-UUID"); !cmp.Equal(err, resourcename.ErrInvalidName, cmpopts.EquateErrors()) {
+		t.Errorf("PaymentReference(%q) err = %v; want %v", "stores/not-a-UUID", err, resourcename.ErrInvalidName)
+	}
+}
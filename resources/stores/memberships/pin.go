@@ -0,0 +1,51 @@
+package memberships
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	MinPinLength = 4
+	MaxPinLength = 8
+)
+
+var (
+	ErrPinLength    = errors.New("PIN must be between 4 and 8 digits long")
+	ErrPinNotDigits = errors.New("PIN must only contain digits")
+	ErrPinNotSet    = errors.New("membership has no PIN")
+	ErrPinMismatch  = errors.New("wrong PIN")
+)
+
+// pinCost is the bcrypt cost of PIN hashes. PINs are short, so guessing them
+// must also be prevented by rate limiting the attempts.
+var pinCost = bcrypt.DefaultCost
+
+// ValidatePin checks that pin consists of between MinPinLength and
+// MaxPinLength digits.
+func ValidatePin(pin string) error {
+	if len(pin) < MinPinLength || len(pin) > MaxPinLength {
+		return ErrPinLength
+	}
+	for _, r := range pin {
+		if r < '0' || r > '9' {
+			return ErrPinNotDigits
+		}
+	}
+	return nil
+}
+
+func hashPin(pin string) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(pin), pinCost)
+}
+
+func comparePin(hash []byte, pin string) error {
+	if err := bcrypt.CompareHashAndPassword(hash, []byte(pin)); err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return ErrPinMismatch
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package memberships
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func init() {
+	// Hashing with the default cost makes the tests needlessly slow.
+	pinCost = bcrypt.MinCost
+}
+
+func TestValidatePin(t *testing.T) {
+	for _, test := range []struct {
+		pin  string
+		want error
+	}{
+		{pin: "1234", want: nil},
+		{pin: "12345678", want: nil},
+		{pin: "", want: ErrPinLength},
+		{pin: "123", want: ErrPinLength},
+		{pin: "123456789", want: ErrPinLength},
+		{pin: "12a4", want: ErrPinNotDigits},
+		{pin: "١٢٣٤", want: ErrPinNotDigits},
+	} {
+		if got := ValidatePin(test.pin); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+			t.Errorf("ValidatePin(%q) = %v; want %v", test.pin, got, test.want)
+		}
+	}
+}
+
+func TestHashPin_ComparePin(t *testing.T) {
+	hash, err := hashPin("1234")
+	if err != nil {
+		t.Fatalf("hashPin(%q) err = %v; want nil", "1234", err)
+	}
+	for _, test := range []struct {
+		pin  string
+		want error
+	}{
+		{pin: "1234", want: nil},
+		{pin: "4321", want: ErrPinMismatch},
+		{pin: "", want: ErrPinMismatch},
+	} {
+		if got := comparePin(hash, test.pin); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+			t.Errorf("comparePin(hash, %q) = %v; want %v", test.pin, got, test.want)
+		}
+	}
+}
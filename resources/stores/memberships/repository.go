@@ -74,6 +74,20 @@ type composite struct {
 type Repository struct {
 	memberships map[string]*pb.Membership // name -> membership
 	names       map[composite]string      // (parent name, user name) -> membership name
+	pins        map[string][]byte         // membership name -> PIN hash
+
+	// Timer, if set, is called with the name of each operation when it
+	// starts, and the function it returns is called when the operation has
+	// completed.
+	Timer func(operation string) func()
+}
+
+// timeOperation starts timing an operation using r.Timer, if set.
+func (r *Repository) timeOperation(operation string) func() {
+	if r.Timer == nil {
+		return func() {}
+	}
+	return r.Timer(operation)
 }
 
 func NewRepository() *Repository {
@@ -105,10 +119,12 @@ func newRepository(memberships map[string]*pb.Membership, names map[composite]st
 	return &Repository{
 		memberships: memberships,
 		names:       names,
+		pins:        make(map[string][]byte),
 	}
 }
 
 func (r *Repository) LookupMembership(_ context.Context, name string) (*pb.Membership, error) {
+	defer r.timeOperation("LookupMembership")()
 	if err := ValidateName(name); err != nil {
 		return nil, err
 	}
@@ -120,6 +136,7 @@ func (r *Repository) LookupMembership(_ context.Context, name string) (*pb.Membe
 }
 
 func (r *Repository) LookupMembershipIn(ctx context.Context, parent string, user string) (*pb.Membership, error) {
+	defer r.timeOperation("LookupMembershipIn")()
 	if err := stores.ValidateName(parent); err != nil {
 		return nil, err
 	}
@@ -133,11 +150,17 @@ func (r *Repository) LookupMembershipIn(ctx context.Context, parent string, user
 	return r.LookupMembership(ctx, name)
 }
 
-func (r *Repository) ListMemberships(ctx context.Context) ([]*pb.Membership, error) {
-	return r.FilterMemberships(ctx, func(*pb.Membership) bool { return true })
+func (r *Repository) ListMemberships(_ context.Context) ([]*pb.Membership, error) {
+	defer r.timeOperation("ListMemberships")()
+	var all []*pb.Membership
+	for _, membership := range r.memberships {
+		all = append(all, Clone(membership))
+	}
+	return all, nil
 }
 
 func (r *Repository) FilterMemberships(_ context.Context, predicate func(*pb.Membership) bool) ([]*pb.Membership, error) {
+	defer r.timeOperation("FilterMemberships")()
 	var filtered []*pb.Membership
 	for _, membership := range r.memberships {
 		if predicate(membership) {
@@ -148,6 +171,7 @@ func (r *Repository) FilterMemberships(_ context.Context, predicate func(*pb.Mem
 }
 
 func (r *Repository) CreateMembership(_ context.Context, membership *pb.Membership) error {
+	defer r.timeOperation("CreateMembership")()
 	name := membership.Name
 	if _, exists := r.memberships[name]; exists {
 		return &ExistsError{Name: name}
@@ -160,12 +184,15 @@ func (r *Repository) CreateMembership(_ context.Context, membership *pb.Membersh
 			User:   membership.User,
 		}
 	}
-	r.memberships[name] = Clone(membership)
+	membership = Clone(membership)
+	membership.PinSet = false
+	r.memberships[name] = membership
 	r.names[key] = name
 	return nil
 }
 
 func (r *Repository) UpdateMembership(_ context.Context, updated *pb.Membership) error {
+	defer r.timeOperation("UpdateMembership")()
 	membership, ok := r.memberships[updated.Name]
 	if !ok {
 		return &NotFoundError{Name: updated.Name}
@@ -173,11 +200,63 @@ func (r *Repository) UpdateMembership(_ context.Context, updated *pb.Membership)
 	if updated.User != membership.User {
 		return ErrUpdateUser
 	}
-	r.memberships[updated.Name] = Clone(updated)
+	updated = Clone(updated)
+	_, updated.PinSet = r.pins[updated.Name]
+	r.memberships[updated.Name] = updated
+	return nil
+}
+
+// SetPin sets the PIN of the membership with the given name, replacing any
+// previous PIN. An empty PIN removes the PIN.
+func (r *Repository) SetPin(_ context.Context, name string, pin string) error {
+	defer r.timeOperation("SetPin")()
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	membership, ok := r.memberships[name]
+	if !ok {
+		return &NotFoundError{Name: name}
+	}
+	membership = Clone(membership)
+	if pin == "" {
+		delete(r.pins, name)
+		membership.PinSet = false
+		r.memberships[name] = membership
+		return nil
+	}
+	if err := ValidatePin(pin); err != nil {
+		return err
+	}
+	hash, err := hashPin(pin)
+	if err != nil {
+		return err
+	}
+	r.pins[name] = hash
+	membership.PinSet = true
+	r.memberships[name] = membership
 	return nil
 }
 
+// CheckPin checks pin against the PIN of the membership with the given name.
+// It returns ErrPinNotSet if the membership has no PIN, and ErrPinMismatch if
+// the PIN is wrong.
+func (r *Repository) CheckPin(_ context.Context, name string, pin string) error {
+	defer r.timeOperation("CheckPin")()
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	if _, ok := r.memberships[name]; !ok {
+		return &NotFoundError{Name: name}
+	}
+	hash, ok := r.pins[name]
+	if !ok {
+		return ErrPinNotSet
+	}
+	return comparePin(hash, pin)
+}
+
 func (r *Repository) DeleteMembership(_ context.Context, name string) error {
+	defer r.timeOperation("DeleteMembership")()
 	if err := ValidateName(name); err != nil {
 		return err
 	}
@@ -188,5 +267,6 @@ func (r *Repository) DeleteMembership(_ context.Context, name string) error {
 	parent, _ := Parent(name)
 	delete(r.memberships, name)
 	delete(r.names, composite{parent: parent, user: membership.User})
+	delete(r.pins, name)
 	return nil
 }
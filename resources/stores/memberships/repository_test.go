@@ -567,3 +567,124 @@ func TestRepository_DeleteMembership(t *testing.T) {
 		}
 	})
 }
+
+func TestRepository_SetPin_CheckPin(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.Membership{testresources.Bar_Alice, testresources.Bar_Bob})
+	if got, want := r.CheckPin(ctx, testresources.Bar_Alice.Name, "1234"), ErrPinNotSet; !cmp.Equal(got, want, cmpopts.EquateErrors()) {
+		t.Errorf("r.CheckPin(%v, %q, %q) = %v; want %v", ctx, testresources.Bar_Alice.Name, "1234", got, want)
+	}
+	if err := r.SetPin(ctx, testresources.Bar_Alice.Name, "1234"); err != nil {
+		t.Errorf("r.SetPin(%v, %q, %q) = %v; want nil", ctx, testresources.Bar_Alice.Name, "1234", err)
+	}
+	for _, test := range []struct {
+		desc string
+		name string
+		pin  string
+		want error
+	}{
+		{
+			desc: "OK",
+			name: testresources.Bar_Alice.Name,
+			pin:  "1234",
+			want: nil,
+		},
+		{
+			desc: "Mismatch",
+			name: testresources.Bar_Alice.Name,
+			pin:  "4321",
+			want: ErrPinMismatch,
+		},
+		{
+			desc: "NotSet",
+			name: testresources.Bar_Bob.Name,
+			pin:  "1234",
+			want: ErrPinNotSet,
+		},
+		{
+			desc: "EmptyName",
+			name: "",
+			pin:  "1234",
+			want: resourcename.ErrInvalidName,
+		},
+		{
+			desc: "NotFound",
+			name: testresources.Mall_Alice.Name,
+			pin:  "1234",
+			want: &NotFoundError{Name: testresources.Mall_Alice.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			if got := r.CheckPin(ctx, test.name, test.pin); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+				t.Errorf("r.CheckPin(%v, %q, %q) = %v; want %v", ctx, test.name, test.pin, got, test.want)
+			}
+		})
+	}
+	membership, err := r.LookupMembership(ctx, testresources.Bar_Alice.Name)
+	if err != nil {
+		t.Fatalf("r.LookupMembership(%v, %q) err = %v; want nil", ctx, testresources.Bar_Alice.Name, err)
+	}
+	if !membership.PinSet {
+		t.Errorf("r.LookupMembership(%v, %q) PinSet = false; want true", ctx, testresources.Bar_Alice.Name)
+	}
+	if testresources.Bar_Alice.PinSet {
+		t.Error("r.SetPin modified the seeded membership")
+	}
+	// Updating the membership keeps the PIN.
+	membership.PinSet = false
+	if err := r.UpdateMembership(ctx, membership); err != nil {
+		t.Errorf("r.UpdateMembership(%v, %v) = %v; want nil", ctx, membership, err)
+	}
+	if err := r.CheckPin(ctx, testresources.Bar_Alice.Name, "1234"); err != nil {
+		t.Errorf("r.CheckPin(%v, %q, %q) = %v; want nil", ctx, testresources.Bar_Alice.Name, "1234", err)
+	}
+	// An empty PIN removes the PIN.
+	if err := r.SetPin(ctx, testresources.Bar_Alice.Name, ""); err != nil {
+		t.Errorf("r.SetPin(%v, %q, %q) = %v; want nil", ctx, testresources.Bar_Alice.Name, "", err)
+	}
+	if got, want := r.CheckPin(ctx, testresources.Bar_Alice.Name, "1234"), ErrPinNotSet; !cmp.Equal(got, want, cmpopts.EquateErrors()) {
+		t.Errorf("r.CheckPin(%v, %q, %q) = %v; want %v", ctx, testresources.Bar_Alice.Name, "1234", got, want)
+	}
+}
+
+func TestRepository_SetPin_Errors(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.Membership{testresources.Bar_Alice})
+	for _, test := range []struct {
+		desc string
+		name string
+		pin  string
+		want error
+	}{
+		{
+			desc: "TooShort",
+			name: testresources.Bar_Alice.Name,
+			pin:  "123",
+			want: ErrPinLength,
+		},
+		{
+			desc: "NotDigits",
+			name: testresources.Bar_Alice.Name,
+			pin:  "abcd",
+			want: ErrPinNotDigits,
+		},
+		{
+			desc: "EmptyName",
+			name: "",
+			pin:  "1234",
+			want: resourcename.ErrInvalidName,
+		},
+		{
+			desc: "NotFound",
+			name: testresources.Bar_Bob.Name,
+			pin:  "1234",
+			want: &NotFoundError{Name: testresources.Bar_Bob.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			if got := r.SetPin(ctx, test.name, test.pin); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+				t.Errorf("r.SetPin(%v, %q, %q) = %v; want %v", ctx, test.name, test.pin, got, test.want)
+			}
+		})
+	}
+}
@@ -1,10 +1,14 @@
 package memberships
 
 import (
+	"errors"
+
 	pb "github.com/Saser/strecku/api/v1"
 	"github.com/Saser/strecku/resources/users"
 )
 
+var ErrPaymentReferenceMismatch = errors.New("payment reference does not match name")
+
 func Validate(membership *pb.Membership) error {
 	if err := ValidateName(membership.Name); err != nil {
 		return err
@@ -12,5 +16,14 @@ func Validate(membership *pb.Membership) error {
 	if err := users.ValidateName(membership.User); err != nil {
 		return err
 	}
+	if membership.PaymentReference != "" {
+		want, err := PaymentReference(membership.Name)
+		if err != nil {
+			return err
+		}
+		if membership.PaymentReference != want {
+			return ErrPaymentReferenceMismatch
+		}
+	}
 	return nil
 }
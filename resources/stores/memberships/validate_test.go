@@ -24,6 +24,14 @@ func TestValidate(t *testing.T) {
 			},
 			want: resourcename.ErrInvalidName,
 		},
+		{
+			membership: &pb.Membership{
+				Name:             testresources.Bar_Alice.Name,
+				User:             testresources.Alice.Name,
+				PaymentReference: testresources.Bar_Bob.PaymentReference,
+			},
+			want: ErrPaymentReferenceMismatch,
+		},
 	} {
 		if got := Validate(test.membership); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
 			t.Errorf("Validate(%v) = %v; want %v", test.membership, got, test.want)
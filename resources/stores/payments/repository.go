@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/events"
 )
 
 var ErrUpdateUser = errors.New("user cannot be updated")
@@ -45,6 +46,20 @@ func (e *ExistsError) Is(target error) bool {
 
 type Repository struct {
 	payments map[string]*pb.Payment // name -> payment
+	bus      *events.Bus
+
+	// Timer, if set, is called with the name of each operation when it
+	// starts, and the function it returns is called when the operation has
+	// completed.
+	Timer func(operation string) func()
+}
+
+// timeOperation starts timing an operation using r.Timer, if set.
+func (r *Repository) timeOperation(operation string) func() {
+	if r.Timer == nil {
+		return func() {}
+	}
+	return r.Timer(operation)
 }
 
 func NewRepository() *Repository {
@@ -68,10 +83,18 @@ func SeedRepository(t *testing.T, payments []*pb.Payment) *Repository {
 func newRepository(payments map[string]*pb.Payment) *Repository {
 	return &Repository{
 		payments: payments,
+		bus:      events.NewBus(events.DefaultHistorySize, events.DefaultBufferSize),
 	}
 }
 
+// Events returns the bus that events are published to when payments are
+// created, updated and deleted.
+func (r *Repository) Events() *events.Bus {
+	return r.bus
+}
+
 func (r *Repository) LookupPayment(_ context.Context, name string) (*pb.Payment, error) {
+	defer r.timeOperation("LookupPayment")()
 	if err := ValidateName(name); err != nil {
 		return nil, err
 	}
@@ -82,11 +105,17 @@ func (r *Repository) LookupPayment(_ context.Context, name string) (*pb.Payment,
 	return Clone(payment), nil
 }
 
-func (r *Repository) ListPayments(ctx context.Context) ([]*pb.Payment, error) {
-	return r.FilterPayments(ctx, func(*pb.Payment) bool { return true })
+func (r *Repository) ListPayments(_ context.Context) ([]*pb.Payment, error) {
+	defer r.timeOperation("ListPayments")()
+	var all []*pb.Payment
+	for _, payment := range r.payments {
+		all = append(all, Clone(payment))
+	}
+	return all, nil
 }
 
 func (r *Repository) FilterPayments(_ context.Context, predicate func(*pb.Payment) bool) ([]*pb.Payment, error) {
+	defer r.timeOperation("FilterPayments")()
 	var filtered []*pb.Payment
 	for _, payment := range r.payments {
 		if predicate(payment) {
@@ -97,6 +126,7 @@ func (r *Repository) FilterPayments(_ context.Context, predicate func(*pb.Paymen
 }
 
 func (r *Repository) CreatePayment(_ context.Context, payment *pb.Payment) error {
+	defer r.timeOperation("CreatePayment")()
 	if err := Validate(payment); err != nil {
 		return err
 	}
@@ -105,10 +135,16 @@ func (r *Repository) CreatePayment(_ context.Context, payment *pb.Payment) error
 		return &ExistsError{Name: name}
 	}
 	r.payments[name] = Clone(payment)
+	r.bus.Publish(events.Event{
+		Type:     events.Created,
+		Name:     name,
+		Resource: Clone(payment),
+	})
 	return nil
 }
 
 func (r *Repository) UpdatePayment(_ context.Context, updated *pb.Payment) error {
+	defer r.timeOperation("UpdatePayment")()
 	if err := Validate(updated); err != nil {
 		return err
 	}
@@ -121,16 +157,29 @@ func (r *Repository) UpdatePayment(_ context.Context, updated *pb.Payment) error
 		return ErrUpdateUser
 	}
 	r.payments[name] = Clone(updated)
+	r.bus.Publish(events.Event{
+		Type:     events.Updated,
+		Name:     name,
+		Resource: Clone(updated),
+		Previous: payment,
+	})
 	return nil
 }
 
 func (r *Repository) DeletePayment(_ context.Context, name string) error {
+	defer r.timeOperation("DeletePayment")()
 	if err := ValidateName(name); err != nil {
 		return err
 	}
-	if _, exists := r.payments[name]; !exists {
+	payment, exists := r.payments[name]
+	if !exists {
 		return &NotFoundError{Name: name}
 	}
 	delete(r.payments, name)
+	r.bus.Publish(events.Event{
+		Type:     events.Deleted,
+		Name:     name,
+		Resource: payment,
+	})
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/events"
 	"github.com/Saser/strecku/resourcename"
 	"github.com/Saser/strecku/testresources"
 	"github.com/google/go-cmp/cmp"
@@ -371,3 +372,34 @@ func TestRepository_DeletePayment(t *testing.T) {
 		}
 	})
 }
+
+func TestRepository_Events(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.Payment{testresources.Bar_Alice_Payment})
+	sub, err := r.Events().Subscribe(0, nil)
+	if err != nil {
+		t.Fatalf("r.Events().Subscribe(0, nil) = %v; want nil", err)
+	}
+	defer sub.Close()
+	if err := r.CreatePayment(ctx, testresources.Bar_Bob_Payment); err != nil {
+		t.Fatalf("r.CreatePayment(%v, %v) = %v; want nil", ctx, testresources.Bar_Bob_Payment, err)
+	}
+	if err := r.UpdatePayment(ctx, testresources.Bar_Bob_Payment); err != nil {
+		t.Fatalf("r.UpdatePayment(%v, %v) = %v; want nil", ctx, testresources.Bar_Bob_Payment, err)
+	}
+	if err := r.DeletePayment(ctx, testresources.Bar_Alice_Payment.Name); err != nil {
+		t.Fatalf("r.DeletePayment(%v, %q) = %v; want nil", ctx, testresources.Bar_Alice_Payment.Name, err)
+	}
+	want := []events.Event{
+		{Sequence: 1, Type: events.Created, Name: testresources.Bar_Bob_Payment.Name, Resource: testresources.Bar_Bob_Payment},
+		{Sequence: 2, Type: events.Updated, Name: testresources.Bar_Bob_Payment.Name, Resource: testresources.Bar_Bob_Payment, Previous: testresources.Bar_Bob_Payment},
+		{Sequence: 3, Type: events.Deleted, Name: testresources.Bar_Alice_Payment.Name, Resource: testresources.Bar_Alice_Payment},
+	}
+	var got []events.Event
+	for range want {
+		got = append(got, <-sub.Events())
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("events mismatch (-got +want):\n%s", diff)
+	}
+}
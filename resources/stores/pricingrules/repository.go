@@ -0,0 +1,134 @@
+package pricingrules
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type NotFoundError struct {
+	Name string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("pricing rule not found: %q", e.Name)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	other, ok := target.(*NotFoundError)
+	if !ok {
+		return false
+	}
+	return other.Name == e.Name
+}
+
+type ExistsError struct {
+	Name string
+}
+
+func (e *ExistsError) Error() string {
+	return fmt.Sprintf("pricing rule exists: %q", e.Name)
+}
+
+func (e *ExistsError) Is(target error) bool {
+	other, ok := target.(*ExistsError)
+	if !ok {
+		return false
+	}
+	return other.Name == e.Name
+}
+
+func Clone(rule *pb.PricingRule) *pb.PricingRule {
+	return proto.Clone(rule).(*pb.PricingRule)
+}
+
+type Repository struct {
+	rules map[string]*pb.PricingRule // name -> pricing rule
+}
+
+func NewRepository() *Repository {
+	return newRepository(make(map[string]*pb.PricingRule))
+}
+
+func SeedRepository(t *testing.T, rules []*pb.PricingRule) *Repository {
+	mRules := make(map[string]*pb.PricingRule)
+	for _, rule := range rules {
+		if err := Validate(rule); err != nil {
+			t.Errorf("Validate(%v) = %v; want nil", rule, err)
+		}
+		mRules[rule.Name] = rule
+	}
+	if t.Failed() {
+		t.FailNow()
+	}
+	return newRepository(mRules)
+}
+
+func newRepository(rules map[string]*pb.PricingRule) *Repository {
+	return &Repository{
+		rules: rules,
+	}
+}
+
+func (r *Repository) LookupPricingRule(_ context.Context, name string) (*pb.PricingRule, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	rule, ok := r.rules[name]
+	if !ok {
+		return nil, &NotFoundError{Name: name}
+	}
+	return Clone(rule), nil
+}
+
+func (r *Repository) ListPricingRules(ctx context.Context) ([]*pb.PricingRule, error) {
+	return r.FilterPricingRules(ctx, func(*pb.PricingRule) bool { return true })
+}
+
+func (r *Repository) FilterPricingRules(_ context.Context, predicate func(*pb.PricingRule) bool) ([]*pb.PricingRule, error) {
+	var filtered []*pb.PricingRule
+	for _, rule := range r.rules {
+		if predicate(rule) {
+			filtered = append(filtered, Clone(rule))
+		}
+	}
+	return filtered, nil
+}
+
+func (r *Repository) CreatePricingRule(_ context.Context, rule *pb.PricingRule) error {
+	if err := Validate(rule); err != nil {
+		return err
+	}
+	name := rule.Name
+	if _, exists := r.rules[name]; exists {
+		return &ExistsError{Name: name}
+	}
+	r.rules[name] = Clone(rule)
+	return nil
+}
+
+func (r *Repository) UpdatePricingRule(_ context.Context, updated *pb.PricingRule) error {
+	if err := Validate(updated); err != nil {
+		return err
+	}
+	name := updated.Name
+	if _, ok := r.rules[name]; !ok {
+		return &NotFoundError{Name: name}
+	}
+	r.rules[name] = Clone(updated)
+	return nil
+}
+
+func (r *Repository) DeletePricingRule(_ context.Context, name string) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	if _, exists := r.rules[name]; !exists {
+		return &NotFoundError{Name: name}
+	}
+	delete(r.rules, name)
+	return nil
+}
@@ -0,0 +1,366 @@
+package pricingrules
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func ruleLess(p1, p2 *pb.PricingRule) bool {
+	return p1.Name < p2.Name
+}
+
+func TestNotFoundError_Error(t *testing.T) {
+	err := &NotFoundError{Name: testresources.Bar_HappyHour.Name}
+	want := fmt.Sprintf("pricing rule not found: %q", testresources.Bar_HappyHour.Name)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestNotFoundError_Is(t *testing.T) {
+	for _, test := range []struct {
+		err    *NotFoundError
+		target error
+		want   bool
+	}{
+		{
+			err:    &NotFoundError{Name: testresources.Bar_HappyHour.Name},
+			target: &NotFoundError{Name: testresources.Bar_HappyHour.Name},
+			want:   true,
+		},
+		{
+			err:    &NotFoundError{Name: testresources.Bar_HappyHour.Name},
+			target: &NotFoundError{Name: testresources.Bar_SecondCocktailHalfPrice.Name},
+			want:   false,
+		},
+		{
+			err:    &NotFoundError{Name: testresources.Bar_HappyHour.Name},
+			target: fmt.Errorf("pricing rule not found: %q", testresources.Bar_HappyHour.Name),
+			want:   false,
+		},
+	} {
+		if got := test.err.Is(test.target); got != test.want {
+			t.Errorf("test.err.Is(%v) = %v; want %v", test.target, got, test.want)
+		}
+	}
+}
+
+func TestExistsError_Error(t *testing.T) {
+	err := &ExistsError{Name: testresources.Bar_HappyHour.Name}
+	want := fmt.Sprintf("pricing rule exists: %q", testresources.Bar_HappyHour.Name)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestExistsError_Is(t *testing.T) {
+	for _, test := range []struct {
+		err    *ExistsError
+		target error
+		want   bool
+	}{
+		{
+			err:    &ExistsError{Name: testresources.Bar_HappyHour.Name},
+			target: &ExistsError{Name: testresources.Bar_HappyHour.Name},
+			want:   true,
+		},
+		{
+			err:    &ExistsError{Name: testresources.Bar_HappyHour.Name},
+			target: &ExistsError{Name: testresources.Bar_SecondCocktailHalfPrice.Name},
+			want:   false,
+		},
+		{
+			err:    &ExistsError{Name: testresources.Bar_HappyHour.Name},
+			target: fmt.Errorf("pricing rule exists: %q", testresources.Bar_HappyHour.Name),
+			want:   false,
+		},
+	} {
+		if got := test.err.Is(test.target); got != test.want {
+			t.Errorf("test.err.Is(%v) = %v; want %v", test.target, got, test.want)
+		}
+	}
+}
+
+func TestNewRepository(t *testing.T) {
+	NewRepository()
+}
+
+func TestRepository_LookupPricingRule(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.PricingRule{testresources.Bar_HappyHour})
+	for _, test := range []struct {
+		desc     string
+		name     string
+		wantRule *pb.PricingRule
+		wantErr  error
+	}{
+		{
+			desc:     "OK",
+			name:     testresources.Bar_HappyHour.Name,
+			wantRule: testresources.Bar_HappyHour,
+			wantErr:  nil,
+		},
+		{
+			desc:     "EmptyName",
+			name:     "",
+			wantRule: nil,
+			wantErr:  resourcename.ErrInvalidName,
+		},
+		{
+			desc:     "NotFound",
+			name:     testresources.Bar_SecondCocktailHalfPrice.Name,
+			wantRule: nil,
+			wantErr:  &NotFoundError{Name: testresources.Bar_SecondCocktailHalfPrice.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := r.LookupPricingRule(ctx, test.name)
+			if diff := cmp.Diff(got, test.wantRule, protocmp.Transform()); diff != "" {
+				t.Errorf("r.LookupPricingRule(%v, %q) rule != test.wantRule (-got +want)\n%s", ctx, test.name, diff)
+			}
+			if got, want := err, test.wantErr; !cmp.Equal(got, want, cmpopts.EquateErrors()) {
+				t.Errorf("r.LookupPricingRule(%v, %q) err = %v; want %v", ctx, test.name, got, want)
+			}
+		})
+	}
+}
+
+func TestRepository_ListPricingRules(t *testing.T) {
+	ctx := context.Background()
+	want := []*pb.PricingRule{
+		testresources.Bar_HappyHour,
+		testresources.Bar_SecondCocktailHalfPrice,
+		testresources.Mall_StaffDiscount,
+		testresources.Mall_JeansSale,
+	}
+	r := SeedRepository(t, want)
+	rules, err := r.ListPricingRules(ctx)
+	if diff := cmp.Diff(
+		rules, want, protocmp.Transform(),
+		cmpopts.SortSlices(ruleLess),
+	); diff != "" {
+		t.Errorf("r.ListPricingRules(%v) rules != want (-got +want)\n%s", ctx, diff)
+	}
+	if err != nil {
+		t.Errorf("r.ListPricingRules(%v) err = %v; want nil", ctx, err)
+	}
+}
+
+func TestRepository_FilterPricingRules(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.PricingRule{
+		testresources.Bar_HappyHour,
+		testresources.Bar_SecondCocktailHalfPrice,
+		testresources.Mall_StaffDiscount,
+		testresources.Mall_JeansSale,
+	})
+	for _, test := range []struct {
+		name      string
+		predicate func(*pb.PricingRule) bool
+		want      []*pb.PricingRule
+	}{
+		{
+			name:      "NoneMatching",
+			predicate: func(*pb.PricingRule) bool { return false },
+			want:      nil,
+		},
+		{
+			name:      "OneMatching",
+			predicate: func(rule *pb.PricingRule) bool { return rule.Name == testresources.Bar_HappyHour.Name },
+			want: []*pb.PricingRule{
+				testresources.Bar_HappyHour,
+			},
+		},
+		{
+			name: "MultipleMatching",
+			predicate: func(rule *pb.PricingRule) bool {
+				return rule.Name == testresources.Bar_HappyHour.Name || rule.Name == testresources.Bar_SecondCocktailHalfPrice.Name
+			},
+			want: []*pb.PricingRule{
+				testresources.Bar_HappyHour,
+				testresources.Bar_SecondCocktailHalfPrice,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			rules, err := r.FilterPricingRules(ctx, test.predicate)
+			if diff := cmp.Diff(
+				rules, test.want, protocmp.Transform(),
+				cmpopts.EquateEmpty(),
+				cmpopts.SortSlices(ruleLess),
+			); diff != "" {
+				t.Errorf("r.FilterPricingRules(%v, test.predicate) rules != test.want (-got +want)\n%s", ctx, diff)
+			}
+			if got, want := err, error(nil); !cmp.Equal(got, want) {
+				t.Errorf("r.FilterPricingRules(%v, test.predicate) err = %v; want %v", ctx, got, want)
+			}
+		})
+	}
+}
+
+func TestRepository_CreatePricingRule(t *testing.T) {
+	ctx := context.Background()
+	duplicateName := Clone(testresources.Bar_SecondCocktailHalfPrice)
+	duplicateName.Name = testresources.Bar_HappyHour.Name
+	for _, test := range []struct {
+		desc string
+		rule *pb.PricingRule
+		want error
+	}{
+		{
+			desc: "OK",
+			rule: testresources.Bar_SecondCocktailHalfPrice,
+			want: nil,
+		},
+		{
+			desc: "DuplicateName",
+			rule: duplicateName,
+			want: &ExistsError{Name: testresources.Bar_HappyHour.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			r := SeedRepository(t, []*pb.PricingRule{testresources.Bar_HappyHour})
+			if got := r.CreatePricingRule(ctx, test.rule); !cmp.Equal(got, test.want) {
+				t.Errorf("r.CreatePricingRule(%v, %v) = %v; want %v", ctx, test.rule, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRepository_UpdatePricingRule(t *testing.T) {
+	ctx := context.Background()
+	// Test scenario where the update is successful.
+	t.Run("OK", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.PricingRule{testresources.Bar_HappyHour})
+		oldRule := Clone(testresources.Bar_HappyHour)
+		newRule := Clone(oldRule)
+		newRule.DisplayName = "Longer happy hour"
+		newRule.DailyStartMinute = 15 * 60
+		newRule.DailyEndMinute = 20 * 60
+		if err := r.UpdatePricingRule(ctx, newRule); err != nil {
+			t.Errorf("r.UpdatePricingRule(%v, %v) = %v; want nil", ctx, newRule, err)
+		}
+		got, err := r.LookupPricingRule(ctx, newRule.Name)
+		if diff := cmp.Diff(got, newRule, protocmp.Transform()); diff != "" {
+			t.Errorf("r.LookupPricingRule(%v, %q) rule != newRule (-got +want)\n%s", ctx, newRule.Name, diff)
+		}
+		if err != nil {
+			t.Errorf("r.LookupPricingRule(%v, %q) err = %v; want nil", ctx, newRule.Name, err)
+		}
+	})
+
+	// Test scenario where the update fails.
+	t.Run("Errors", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.PricingRule{testresources.Bar_HappyHour})
+		for _, test := range []struct {
+			desc   string
+			modify func(rule *pb.PricingRule)
+			want   error
+		}{
+			{
+				desc:   "EmptyName",
+				modify: func(rule *pb.PricingRule) { rule.Name = "" },
+				want:   resourcename.ErrInvalidName,
+			},
+			{
+				desc:   "EmptyDisplayName",
+				modify: func(rule *pb.PricingRule) { rule.DisplayName = "" },
+				want:   ErrDisplayNameEmpty,
+			},
+			{
+				desc:   "EffectMissing",
+				modify: func(rule *pb.PricingRule) { rule.Effect = nil },
+				want:   ErrEffectMissing,
+			},
+			{
+				desc:   "NotFound",
+				modify: func(rule *pb.PricingRule) { rule.Name = testresources.Bar_SecondCocktailHalfPrice.Name },
+				want:   &NotFoundError{Name: testresources.Bar_SecondCocktailHalfPrice.Name},
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				updated := Clone(testresources.Bar_HappyHour)
+				test.modify(updated)
+				if got := r.UpdatePricingRule(ctx, updated); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+					t.Errorf("r.UpdatePricingRule(%v, %v) = %v; want %v", ctx, updated, got, test.want)
+				}
+			})
+		}
+	})
+}
+
+func TestRepository_DeletePricingRule(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("OK", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.PricingRule{
+			testresources.Bar_HappyHour,
+			testresources.Bar_SecondCocktailHalfPrice,
+		})
+		if err := r.DeletePricingRule(ctx, testresources.Bar_HappyHour.Name); err != nil {
+			t.Errorf("r.DeletePricingRule(%v, %q) = %v; want nil", ctx, testresources.Bar_HappyHour.Name, err)
+		}
+		for _, test := range []struct {
+			desc     string
+			name     string
+			wantRule *pb.PricingRule
+			wantErr  error
+		}{
+			{
+				desc:     "LookupDeleted",
+				name:     testresources.Bar_HappyHour.Name,
+				wantRule: nil,
+				wantErr:  &NotFoundError{Name: testresources.Bar_HappyHour.Name},
+			},
+			{
+				desc:     "LookupExisting",
+				name:     testresources.Bar_SecondCocktailHalfPrice.Name,
+				wantRule: testresources.Bar_SecondCocktailHalfPrice,
+				wantErr:  nil,
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				got, err := r.LookupPricingRule(ctx, test.name)
+				if diff := cmp.Diff(got, test.wantRule, protocmp.Transform()); diff != "" {
+					t.Errorf("r.LookupPricingRule(%v, %q) rule != test.wantRule (-got +want)\n%s", ctx, test.name, diff)
+				}
+				if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+					t.Errorf("r.LookupPricingRule(%v, %q) err = %v; want %v", ctx, test.name, err, test.wantErr)
+				}
+			})
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.PricingRule{testresources.Bar_HappyHour})
+		for _, test := range []struct {
+			desc string
+			name string
+			want error
+		}{
+			{
+				desc: "EmptyName",
+				name: "",
+				want: resourcename.ErrInvalidName,
+			},
+			{
+				desc: "NotFound",
+				name: testresources.Bar_SecondCocktailHalfPrice.Name,
+				want: &NotFoundError{Name: testresources.Bar_SecondCocktailHalfPrice.Name},
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				if got := r.DeletePricingRule(ctx, test.name); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+					t.Errorf("r.DeletePricingRule(%v, %q) = %v; want %v", ctx, test.name, got, test.want)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package pricingrules
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/products"
+)
+
+var (
+	ErrDisplayNameEmpty      = errors.New("display name is empty")
+	ErrProductWrongStore     = errors.New("product belongs to another store")
+	ErrEndBeforeStart        = errors.New("end time is not after start time")
+	ErrTimeZoneUnknown       = errors.New("time zone is unknown")
+	ErrWeekdayUnknown        = errors.New("weekday is unknown")
+	ErrDailyMinuteOutOfRange = errors.New("daily minute is not between 0 and 1439")
+	ErrMinQuantityNegative   = errors.New("minimum quantity is negative")
+	ErrUnitIntervalNegative  = errors.New("unit interval is negative")
+	ErrEffectMissing         = errors.New("effect is missing")
+	ErrPercentOffOutOfRange  = errors.New("percent off is not between 1 and 100")
+	ErrFixedPricePositive    = errors.New("fixed price is positive")
+)
+
+// minutesPerDay is the number of minutes in a day, ignoring changes to and
+// from daylight saving time.
+const minutesPerDay = 24 * 60
+
+func Validate(rule *pb.PricingRule) error {
+	if err := ValidateName(rule.Name); err != nil {
+		return err
+	}
+	if rule.DisplayName == "" {
+		return ErrDisplayNameEmpty
+	}
+	parent, _ := Parent(rule.Name)
+	for _, product := range rule.Products {
+		if err := products.ValidateName(product); err != nil {
+			return err
+		}
+		if store, _ := products.Parent(product); store != parent {
+			return ErrProductWrongStore
+		}
+	}
+	if rule.StartTime != nil && rule.EndTime != nil && !rule.EndTime.AsTime().After(rule.StartTime.AsTime()) {
+		return ErrEndBeforeStart
+	}
+	if _, err := time.LoadLocation(rule.TimeZone); err != nil {
+		return ErrTimeZoneUnknown
+	}
+	for _, weekday := range rule.Weekdays {
+		if _, ok := pb.PricingRule_Weekday_name[int32(weekday)]; !ok || weekday == pb.PricingRule_WEEKDAY_UNSPECIFIED {
+			return ErrWeekdayUnknown
+		}
+	}
+	for _, minute := range []int32{rule.DailyStartMinute, rule.DailyEndMinute} {
+		if minute < 0 || minute >= minutesPerDay {
+			return ErrDailyMinuteOutOfRange
+		}
+	}
+	if rule.MinQuantity < 0 {
+		return ErrMinQuantityNegative
+	}
+	if rule.UnitInterval < 0 {
+		return ErrUnitIntervalNegative
+	}
+	switch effect := rule.Effect.(type) {
+	case *pb.PricingRule_PercentOff:
+		if effect.PercentOff < 1 || effect.PercentOff > 100 {
+			return ErrPercentOffOutOfRange
+		}
+	case *pb.PricingRule_FixedPriceCents:
+		if effect.FixedPriceCents > 0 {
+			return ErrFixedPricePositive
+		}
+	default:
+		return ErrEffectMissing
+	}
+	return nil
+}
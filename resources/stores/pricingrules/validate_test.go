@@ -0,0 +1,117 @@
+package pricingrules
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		desc   string
+		modify func(rule *pb.PricingRule)
+		want   error
+	}{
+		{
+			desc:   "OK",
+			modify: func(rule *pb.PricingRule) {},
+			want:   nil,
+		},
+		{
+			desc:   "EmptyDisplayName",
+			modify: func(rule *pb.PricingRule) { rule.DisplayName = "" },
+			want:   ErrDisplayNameEmpty,
+		},
+		{
+			desc:   "ProductWrongStore",
+			modify: func(rule *pb.PricingRule) { rule.Products = []string{testresources.Jeans.Name} },
+			want:   ErrProductWrongStore,
+		},
+		{
+			desc: "EndBeforeStart",
+			modify: func(rule *pb.PricingRule) {
+				rule.StartTime = timestamppb.New(time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC))
+				rule.EndTime = timestamppb.New(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC))
+			},
+			want: ErrEndBeforeStart,
+		},
+		{
+			desc: "EndEqualToStart",
+			modify: func(rule *pb.PricingRule) {
+				rule.StartTime = timestamppb.New(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC))
+				rule.EndTime = rule.StartTime
+			},
+			want: ErrEndBeforeStart,
+		},
+		{
+			desc:   "TimeZoneUnknown",
+			modify: func(rule *pb.PricingRule) { rule.TimeZone = "Europe/Atlantis" },
+			want:   ErrTimeZoneUnknown,
+		},
+		{
+			desc: "WeekdayUnspecified",
+			modify: func(rule *pb.PricingRule) {
+				rule.Weekdays = []pb.PricingRule_Weekday{pb.PricingRule_WEEKDAY_UNSPECIFIED}
+			},
+			want: ErrWeekdayUnknown,
+		},
+		{
+			desc:   "WeekdayUnknown",
+			modify: func(rule *pb.PricingRule) { rule.Weekdays = []pb.PricingRule_Weekday{8} },
+			want:   ErrWeekdayUnknown,
+		},
+		{
+			desc:   "DailyStartMinuteNegative",
+			modify: func(rule *pb.PricingRule) { rule.DailyStartMinute = -1 },
+			want:   ErrDailyMinuteOutOfRange,
+		},
+		{
+			desc:   "DailyEndMinuteTooLarge",
+			modify: func(rule *pb.PricingRule) { rule.DailyEndMinute = 24 * 60 },
+			want:   ErrDailyMinuteOutOfRange,
+		},
+		{
+			desc:   "MinQuantityNegative",
+			modify: func(rule *pb.PricingRule) { rule.MinQuantity = -1 },
+			want:   ErrMinQuantityNegative,
+		},
+		{
+			desc:   "UnitIntervalNegative",
+			modify: func(rule *pb.PricingRule) { rule.UnitInterval = -1 },
+			want:   ErrUnitIntervalNegative,
+		},
+		{
+			desc:   "EffectMissing",
+			modify: func(rule *pb.PricingRule) { rule.Effect = nil },
+			want:   ErrEffectMissing,
+		},
+		{
+			desc:   "PercentOffZero",
+			modify: func(rule *pb.PricingRule) { rule.Effect = &pb.PricingRule_PercentOff{PercentOff: 0} },
+			want:   ErrPercentOffOutOfRange,
+		},
+		{
+			desc:   "PercentOffTooLarge",
+			modify: func(rule *pb.PricingRule) { rule.Effect = &pb.PricingRule_PercentOff{PercentOff: 101} },
+			want:   ErrPercentOffOutOfRange,
+		},
+		{
+			desc:   "FixedPricePositive",
+			modify: func(rule *pb.PricingRule) { rule.Effect = &pb.PricingRule_FixedPriceCents{FixedPriceCents: 100} },
+			want:   ErrFixedPricePositive,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			rule := Clone(testresources.Bar_HappyHour)
+			test.modify(rule)
+			if got := Validate(rule); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+				t.Errorf("Validate(%v) = %v; want %v", rule, got, test.want)
+			}
+		})
+	}
+}
@@ -47,6 +47,19 @@ func Clone(product *pb.Product) *pb.Product {
 
 type Repository struct {
 	products map[string]*pb.Product // name -> product
+
+	// Timer, if set, is called with the name of each operation when it
+	// starts, and the function it returns is called when the operation has
+	// completed.
+	Timer func(operation string) func()
+}
+
+// timeOperation starts timing an operation using r.Timer, if set.
+func (r *Repository) timeOperation(operation string) func() {
+	if r.Timer == nil {
+		return func() {}
+	}
+	return r.Timer(operation)
 }
 
 func NewRepository() *Repository {
@@ -74,6 +87,7 @@ func newRepository(products map[string]*pb.Product) *Repository {
 }
 
 func (r *Repository) LookupProduct(_ context.Context, name string) (*pb.Product, error) {
+	defer r.timeOperation("LookupProduct")()
 	if err := ValidateName(name); err != nil {
 		return nil, err
 	}
@@ -84,11 +98,17 @@ func (r *Repository) LookupProduct(_ context.Context, name string) (*pb.Product,
 	return Clone(product), nil
 }
 
-func (r *Repository) ListProducts(ctx context.Context) ([]*pb.Product, error) {
-	return r.FilterProducts(ctx, func(*pb.Product) bool { return true })
+func (r *Repository) ListProducts(_ context.Context) ([]*pb.Product, error) {
+	defer r.timeOperation("ListProducts")()
+	var all []*pb.Product
+	for _, product := range r.products {
+		all = append(all, Clone(product))
+	}
+	return all, nil
 }
 
 func (r *Repository) FilterProducts(_ context.Context, predicate func(*pb.Product) bool) ([]*pb.Product, error) {
+	defer r.timeOperation("FilterProducts")()
 	var filtered []*pb.Product
 	for _, product := range r.products {
 		if predicate(product) {
@@ -99,6 +119,7 @@ func (r *Repository) FilterProducts(_ context.Context, predicate func(*pb.Produc
 }
 
 func (r *Repository) CreateProduct(_ context.Context, product *pb.Product) error {
+	defer r.timeOperation("CreateProduct")()
 	if err := Validate(product); err != nil {
 		return err
 	}
@@ -111,6 +132,7 @@ func (r *Repository) CreateProduct(_ context.Context, product *pb.Product) error
 }
 
 func (r *Repository) UpdateProduct(_ context.Context, updated *pb.Product) error {
+	defer r.timeOperation("UpdateProduct")()
 	if err := Validate(updated); err != nil {
 		return err
 	}
@@ -123,6 +145,7 @@ func (r *Repository) UpdateProduct(_ context.Context, updated *pb.Product) error
 }
 
 func (r *Repository) DeleteProduct(_ context.Context, name string) error {
+	defer r.timeOperation("DeleteProduct")()
 	if err := ValidateName(name); err != nil {
 		return err
 	}
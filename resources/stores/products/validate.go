@@ -11,6 +11,7 @@ var (
 	ErrFullPricePositive                = errors.New("full price is positive")
 	ErrDiscountPricePositive            = errors.New("discount price is positive")
 	ErrDiscountPriceHigherThanFullPrice = errors.New("discount price is higher than the full price")
+	ErrDepositPositive                  = errors.New("deposit is positive")
 )
 
 func Validate(product *pb.Product) error {
@@ -29,5 +30,8 @@ func Validate(product *pb.Product) error {
 	if product.DiscountPriceCents < product.FullPriceCents {
 		return ErrDiscountPriceHigherThanFullPrice
 	}
+	if product.DepositCents > 0 {
+		return ErrDepositPositive
+	}
 	return nil
 }
@@ -50,6 +50,26 @@ func TestValidate(t *testing.T) {
 			},
 			want: ErrDiscountPriceHigherThanFullPrice,
 		},
+		{
+			product: &pb.Product{
+				Name:               testresources.Beer.Name,
+				DisplayName:        testresources.Beer.DisplayName,
+				FullPriceCents:     testresources.Beer.FullPriceCents,
+				DiscountPriceCents: testresources.Beer.DiscountPriceCents,
+				DepositCents:       -100,
+			},
+			want: nil,
+		},
+		{
+			product: &pb.Product{
+				Name:               testresources.Beer.Name,
+				DisplayName:        testresources.Beer.DisplayName,
+				FullPriceCents:     testresources.Beer.FullPriceCents,
+				DiscountPriceCents: testresources.Beer.DiscountPriceCents,
+				DepositCents:       100,
+			},
+			want: ErrDepositPositive,
+		},
 	} {
 		if got := Validate(test.product); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
 			t.Errorf("Validate(%v) = %v; want %v", test.product, got, test.want)
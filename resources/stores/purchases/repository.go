@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/events"
 )
 
 var ErrUpdateUser = errors.New("user cannot be updated")
@@ -45,6 +46,20 @@ func (e *ExistsError) Is(target error) bool {
 
 type Repository struct {
 	purchases map[string]*pb.Purchase // name -> purchase
+	bus       *events.Bus
+
+	// Timer, if set, is called with the name of each operation when it
+	// starts, and the function it returns is called when the operation has
+	// completed.
+	Timer func(operation string) func()
+}
+
+// timeOperation starts timing an operation using r.Timer, if set.
+func (r *Repository) timeOperation(operation string) func() {
+	if r.Timer == nil {
+		return func() {}
+	}
+	return r.Timer(operation)
 }
 
 func NewRepository() *Repository {
@@ -68,10 +83,18 @@ func SeedRepository(t *testing.T, purchases []*pb.Purchase) *Repository {
 func newRepository(purchases map[string]*pb.Purchase) *Repository {
 	return &Repository{
 		purchases: purchases,
+		bus:       events.NewBus(events.DefaultHistorySize, events.DefaultBufferSize),
 	}
 }
 
+// Events returns the bus that events are published to when purchases are
+// created, updated and deleted.
+func (r *Repository) Events() *events.Bus {
+	return r.bus
+}
+
 func (r *Repository) LookupPurchase(_ context.Context, name string) (*pb.Purchase, error) {
+	defer r.timeOperation("LookupPurchase")()
 	if err := ValidateName(name); err != nil {
 		return nil, err
 	}
@@ -79,24 +102,31 @@ func (r *Repository) LookupPurchase(_ context.Context, name string) (*pb.Purchas
 	if !ok {
 		return nil, &NotFoundError{Name: name}
 	}
-	return purchase, nil
+	return Clone(purchase), nil
 }
 
-func (r *Repository) ListPurchases(ctx context.Context) ([]*pb.Purchase, error) {
-	return r.FilterPurchases(ctx, func(*pb.Purchase) bool { return true })
+func (r *Repository) ListPurchases(_ context.Context) ([]*pb.Purchase, error) {
+	defer r.timeOperation("ListPurchases")()
+	var all []*pb.Purchase
+	for _, purchase := range r.purchases {
+		all = append(all, Clone(purchase))
+	}
+	return all, nil
 }
 
 func (r *Repository) FilterPurchases(_ context.Context, predicate func(*pb.Purchase) bool) ([]*pb.Purchase, error) {
+	defer r.timeOperation("FilterPurchases")()
 	var filtered []*pb.Purchase
 	for _, purchase := range r.purchases {
 		if predicate(purchase) {
-			filtered = append(filtered, purchase)
+			filtered = append(filtered, Clone(purchase))
 		}
 	}
 	return filtered, nil
 }
 
 func (r *Repository) CreatePurchase(_ context.Context, purchase *pb.Purchase) error {
+	defer r.timeOperation("CreatePurchase")()
 	if err := Validate(purchase); err != nil {
 		return err
 	}
@@ -104,11 +134,17 @@ func (r *Repository) CreatePurchase(_ context.Context, purchase *pb.Purchase) er
 	if _, exists := r.purchases[name]; exists {
 		return &ExistsError{Name: name}
 	}
-	r.purchases[name] = purchase
+	r.purchases[name] = Clone(purchase)
+	r.bus.Publish(events.Event{
+		Type:     events.Created,
+		Name:     name,
+		Resource: Clone(purchase),
+	})
 	return nil
 }
 
 func (r *Repository) UpdatePurchase(_ context.Context, updated *pb.Purchase) error {
+	defer r.timeOperation("UpdatePurchase")()
 	if err := Validate(updated); err != nil {
 		return err
 	}
@@ -120,17 +156,30 @@ func (r *Repository) UpdatePurchase(_ context.Context, updated *pb.Purchase) err
 	if updated.User != purchase.User {
 		return ErrUpdateUser
 	}
-	r.purchases[name] = updated
+	r.purchases[name] = Clone(updated)
+	r.bus.Publish(events.Event{
+		Type:     events.Updated,
+		Name:     name,
+		Resource: Clone(updated),
+		Previous: purchase,
+	})
 	return nil
 }
 
 func (r *Repository) DeletePurchase(_ context.Context, name string) error {
+	defer r.timeOperation("DeletePurchase")()
 	if err := ValidateName(name); err != nil {
 		return err
 	}
-	if _, exists := r.purchases[name]; !exists {
+	purchase, exists := r.purchases[name]
+	if !exists {
 		return &NotFoundError{Name: name}
 	}
 	delete(r.purchases, name)
+	r.bus.Publish(events.Event{
+		Type:     events.Deleted,
+		Name:     name,
+		Resource: purchase,
+	})
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/internal/events"
 	"github.com/Saser/strecku/resourcename"
 	"github.com/Saser/strecku/testresources"
 	"github.com/google/go-cmp/cmp"
@@ -409,3 +410,34 @@ func TestRepository_DeletePurchase(t *testing.T) {
 		}
 	})
 }
+
+func TestRepository_Events(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.Purchase{testresources.Bar_Alice_Beer1})
+	sub, err := r.Events().Subscribe(0, nil)
+	if err != nil {
+		t.Fatalf("r.Events().Subscribe(0, nil) = %v; want nil", err)
+	}
+	defer sub.Close()
+	if err := r.CreatePurchase(ctx, testresources.Bar_Alice_Cocktail1); err != nil {
+		t.Fatalf("r.CreatePurchase(%v, %v) = %v; want nil", ctx, testresources.Bar_Alice_Cocktail1, err)
+	}
+	if err := r.UpdatePurchase(ctx, testresources.Bar_Alice_Cocktail1); err != nil {
+		t.Fatalf("r.UpdatePurchase(%v, %v) = %v; want nil", ctx, testresources.Bar_Alice_Cocktail1, err)
+	}
+	if err := r.DeletePurchase(ctx, testresources.Bar_Alice_Beer1.Name); err != nil {
+		t.Fatalf("r.DeletePurchase(%v, %q) = %v; want nil", ctx, testresources.Bar_Alice_Beer1.Name, err)
+	}
+	want := []events.Event{
+		{Sequence: 1, Type: events.Created, Name: testresources.Bar_Alice_Cocktail1.Name, Resource: testresources.Bar_Alice_Cocktail1},
+		{Sequence: 2, Type: events.Updated, Name: testresources.Bar_Alice_Cocktail1.Name, Resource: testresources.Bar_Alice_Cocktail1, Previous: testresources.Bar_Alice_Cocktail1},
+		{Sequence: 3, Type: events.Deleted, Name: testresources.Bar_Alice_Beer1.Name, Resource: testresources.Bar_Alice_Beer1},
+	}
+	var got []events.Event
+	for range want {
+		got = append(got, <-sub.Events())
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("events mismatch (-got +want):\n%s", diff)
+	}
+}
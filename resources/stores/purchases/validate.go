@@ -4,17 +4,33 @@ import (
 	"errors"
 
 	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/devices"
+	"github.com/Saser/strecku/resources/stores/pricingrules"
 	"github.com/Saser/strecku/resources/stores/products"
 	"github.com/Saser/strecku/resources/users"
 	"google.golang.org/protobuf/proto"
 )
 
 var (
-	ErrLinesEmpty              = errors.New("lines are empty")
-	ErrLineDescriptionEmpty    = errors.New("line description is empty")
-	ErrLineQuantityNonPositive = errors.New("line quantity is non-positive")
-	ErrLinePricePositive       = errors.New("line price is positive")
-	ErrLineProductWrongStore   = errors.New("line product belongs to another store")
+	ErrLinesEmpty                = errors.New("lines are empty")
+	ErrLineDescriptionEmpty      = errors.New("line description is empty")
+	ErrLineQuantityNonPositive   = errors.New("line quantity is non-positive")
+	ErrLinePricePositive         = errors.New("line price is positive")
+	ErrLinePriceNegative         = errors.New("line price is negative")
+	ErrLineProductWrongStore     = errors.New("line product belongs to another store")
+	ErrLineProductMissing        = errors.New("line product is missing")
+	ErrLineKindUnknown           = errors.New("line kind is unknown")
+	ErrLineRefundMissing         = errors.New("refund line does not refer to a purchase")
+	ErrLineRefundUnexpected      = errors.New("line refers to a refunded purchase but is not a refund")
+	ErrLineRefundWrongStore      = errors.New("refunded purchase belongs to another store")
+	ErrLineRefundSelf            = errors.New("refund line refers to its own purchase")
+	ErrLineRefundedLineNegative  = errors.New("refunded line is negative")
+	ErrLinePricingRuleWrongKind  = errors.New("line has a pricing rule but is not a charge")
+	ErrLinePricingRuleWrongStore = errors.New("line pricing rule belongs to another store")
+	ErrStateUnknown              = errors.New("state is unknown")
+	ErrVoidTimeMissing           = errors.New("void time is missing")
+	ErrVoidTimeUnexpected        = errors.New("void time is set but purchase is not voided")
+	ErrDeviceWrongStore          = errors.New("device belongs to another store")
 )
 
 func Clone(purchase *pb.Purchase) *pb.Purchase {
@@ -36,9 +52,44 @@ func Validate(purchase *pb.Purchase) error {
 			return err
 		}
 	}
+	if _, ok := pb.Purchase_State_name[int32(purchase.State)]; !ok {
+		return ErrStateUnknown
+	}
+	voided := purchase.State == pb.Purchase_VOIDED
+	switch {
+	case voided && purchase.VoidTime == nil:
+		return ErrVoidTimeMissing
+	case !voided && purchase.VoidTime != nil:
+		return ErrVoidTimeUnexpected
+	}
+	if device := purchase.Device; device != "" {
+		if err := devices.ValidateName(device); err != nil {
+			return err
+		}
+		store, _ := devices.Parent(device)
+		parent, _ := Parent(purchase.Name)
+		if parent != store {
+			return ErrDeviceWrongStore
+		}
+	}
 	return nil
 }
 
+// State returns the state of purchase, where purchases without a state are
+// active.
+func State(purchase *pb.Purchase) pb.Purchase_State {
+	if purchase.State == pb.Purchase_STATE_UNSPECIFIED {
+		return pb.Purchase_ACTIVE
+	}
+	return purchase.State
+}
+
+// Voided returns true if purchase has been voided, and therefore does not
+// count towards the balance of its user.
+func Voided(purchase *pb.Purchase) bool {
+	return State(purchase) == pb.Purchase_VOIDED
+}
+
 func ValidateLine(purchase *pb.Purchase, line *pb.Purchase_Line) error {
 	if line.Description == "" {
 		return ErrLineDescriptionEmpty
@@ -46,9 +97,22 @@ func ValidateLine(purchase *pb.Purchase, line *pb.Purchase_Line) error {
 	if line.Quantity <= 0 {
 		return ErrLineQuantityNonPositive
 	}
-	if line.PriceCents > 0 {
+	if _, ok := pb.Purchase_Line_Kind_name[int32(line.Kind)]; !ok {
+		return ErrLineKindUnknown
+	}
+	kind := LineKind(line)
+	switch {
+	case Credit(kind) && line.PriceCents < 0:
+		return ErrLinePriceNegative
+	case !Credit(kind) && line.PriceCents > 0:
 		return ErrLinePricePositive
 	}
+	if (kind == pb.Purchase_Line_DEPOSIT || kind == pb.Purchase_Line_DEPOSIT_RETURN) && line.Product == "" {
+		return ErrLineProductMissing
+	}
+	if err := validateRefund(purchase, line); err != nil {
+		return err
+	}
 	product := line.Product
 	if product != "" {
 		if err := products.ValidateName(product); err != nil {
@@ -60,5 +124,59 @@ func ValidateLine(purchase *pb.Purchase, line *pb.Purchase_Line) error {
 			return ErrLineProductWrongStore
 		}
 	}
+	if rule := line.PricingRule; rule != "" {
+		if kind != pb.Purchase_Line_CHARGE {
+			return ErrLinePricingRuleWrongKind
+		}
+		if err := pricingrules.ValidateName(rule); err != nil {
+			return err
+		}
+		store, _ := pricingrules.Parent(rule)
+		parent, _ := Parent(purchase.Name)
+		if parent != store {
+			return ErrLinePricingRuleWrongStore
+		}
+	}
+	return nil
+}
+
+func validateRefund(purchase *pb.Purchase, line *pb.Purchase_Line) error {
+	if LineKind(line) != pb.Purchase_Line_REFUND {
+		if line.RefundedPurchase != "" || line.RefundedLine != 0 {
+			return ErrLineRefundUnexpected
+		}
+		return nil
+	}
+	if line.RefundedPurchase == "" {
+		return ErrLineRefundMissing
+	}
+	if err := ValidateName(line.RefundedPurchase); err != nil {
+		return err
+	}
+	if line.RefundedPurchase == purchase.Name {
+		return ErrLineRefundSelf
+	}
+	store, _ := Parent(line.RefundedPurchase)
+	parent, _ := Parent(purchase.Name)
+	if parent != store {
+		return ErrLineRefundWrongStore
+	}
+	if line.RefundedLine < 0 {
+		return ErrLineRefundedLineNegative
+	}
 	return nil
 }
+
+// LineKind returns the kind of line, where lines without a kind are charges.
+func LineKind(line *pb.Purchase_Line) pb.Purchase_Line_Kind {
+	if line.Kind == pb.Purchase_Line_KIND_UNSPECIFIED {
+		return pb.Purchase_Line_CHARGE
+	}
+	return line.Kind
+}
+
+// Credit returns true if lines of the given kind credit the user, that is,
+// have a non-negative price.
+func Credit(kind pb.Purchase_Line_Kind) bool {
+	return kind == pb.Purchase_Line_DEPOSIT_RETURN || kind == pb.Purchase_Line_REFUND
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Saser/strecku/testresources"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestValidate(t *testing.T) {
@@ -52,6 +53,164 @@ func TestValidate(t *testing.T) {
 			modify: func(valid *pb.Purchase) { valid.Lines[0].Product = testresources.Pills.Name },
 			want:   ErrLineProductWrongStore,
 		},
+		{
+			modify: func(valid *pb.Purchase) { valid.Lines[0].Kind = pb.Purchase_Line_Kind(42) },
+			want:   ErrLineKindUnknown,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.Lines[0].Kind = pb.Purchase_Line_CHARGE },
+			want:   nil,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.Lines[0].Kind = pb.Purchase_Line_DEPOSIT },
+			want:   nil,
+		},
+		{
+			modify: func(valid *pb.Purchase) {
+				valid.Lines[0].Kind = pb.Purchase_Line_DEPOSIT
+				valid.Lines[0].Product = ""
+			},
+			want: ErrLineProductMissing,
+		},
+		{
+			modify: func(valid *pb.Purchase) {
+				valid.Lines[0].Kind = pb.Purchase_Line_DEPOSIT
+				valid.Lines[0].PriceCents = 100
+			},
+			want: ErrLinePricePositive,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.Lines[0].Kind = pb.Purchase_Line_DEPOSIT_RETURN },
+			want:   ErrLinePriceNegative,
+		},
+		{
+			modify: func(valid *pb.Purchase) {
+				valid.Lines[0].Kind = pb.Purchase_Line_DEPOSIT_RETURN
+				valid.Lines[0].PriceCents = 100
+			},
+			want: nil,
+		},
+		{
+			modify: func(valid *pb.Purchase) {
+				valid.Lines[0].Kind = pb.Purchase_Line_DEPOSIT_RETURN
+				valid.Lines[0].PriceCents = 100
+				valid.Lines[0].Product = ""
+			},
+			want: ErrLineProductMissing,
+		},
+		{
+			modify: func(valid *pb.Purchase) {
+				valid.Lines[0].Kind = pb.Purchase_Line_REFUND
+				valid.Lines[0].PriceCents = -valid.Lines[0].PriceCents
+				valid.Lines[0].RefundedPurchase = testresources.Bar_Alice_Cocktail1.Name
+			},
+			want: nil,
+		},
+		{
+			modify: func(valid *pb.Purchase) {
+				valid.Lines[0].Kind = pb.Purchase_Line_REFUND
+				valid.Lines[0].RefundedPurchase = testresources.Bar_Alice_Cocktail1.Name
+			},
+			want: ErrLinePriceNegative,
+		},
+		{
+			modify: func(valid *pb.Purchase) {
+				valid.Lines[0].Kind = pb.Purchase_Line_REFUND
+				valid.Lines[0].PriceCents = 0
+			},
+			want: ErrLineRefundMissing,
+		},
+		{
+			modify: func(valid *pb.Purchase) {
+				valid.Lines[0].Kind = pb.Purchase_Line_REFUND
+				valid.Lines[0].PriceCents = 0
+				valid.Lines[0].RefundedPurchase = "invalid"
+			},
+			want: resourcename.ErrInvalidName,
+		},
+		{
+			modify: func(valid *pb.Purchase) {
+				valid.Lines[0].Kind = pb.Purchase_Line_REFUND
+				valid.Lines[0].PriceCents = 0
+				valid.Lines[0].RefundedPurchase = valid.Name
+			},
+			want: ErrLineRefundSelf,
+		},
+		{
+			modify: func(valid *pb.Purchase) {
+				valid.Lines[0].Kind = pb.Purchase_Line_REFUND
+				valid.Lines[0].PriceCents = 0
+				valid.Lines[0].RefundedPurchase = testresources.Mall_Alice_Jeans1.Name
+			},
+			want: ErrLineRefundWrongStore,
+		},
+		{
+			modify: func(valid *pb.Purchase) {
+				valid.Lines[0].Kind = pb.Purchase_Line_REFUND
+				valid.Lines[0].PriceCents = 0
+				valid.Lines[0].RefundedPurchase = testresources.Bar_Alice_Cocktail1.Name
+				valid.Lines[0].RefundedLine = -1
+			},
+			want: ErrLineRefundedLineNegative,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.Lines[0].RefundedPurchase = testresources.Bar_Alice_Cocktail1.Name },
+			want:   ErrLineRefundUnexpected,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.Lines[0].RefundedLine = 1 },
+			want:   ErrLineRefundUnexpected,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.Lines[0].PricingRule = testresources.Bar_HappyHour.Name },
+			want:   nil,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.Lines[0].PricingRule = "invalid" },
+			want:   resourcename.ErrInvalidName,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.Lines[0].PricingRule = testresources.Mall_StaffDiscount.Name },
+			want:   ErrLinePricingRuleWrongStore,
+		},
+		{
+			modify: func(valid *pb.Purchase) {
+				valid.Lines[0].Kind = pb.Purchase_Line_DEPOSIT
+				valid.Lines[0].PricingRule = testresources.Bar_HappyHour.Name
+			},
+			want: ErrLinePricingRuleWrongKind,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.State = pb.Purchase_State(42) },
+			want:   ErrStateUnknown,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.State = pb.Purchase_VOIDED },
+			want:   ErrVoidTimeMissing,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.VoidTime = timestamppb.Now() },
+			want:   ErrVoidTimeUnexpected,
+		},
+		{
+			modify: func(valid *pb.Purchase) {
+				valid.State = pb.Purchase_VOIDED
+				valid.VoidTime = timestamppb.Now()
+			},
+			want: nil,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.Device = testresources.Bar_Kiosk.Name },
+			want:   nil,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.Device = "invalid" },
+			want:   resourcename.ErrInvalidName,
+		},
+		{
+			modify: func(valid *pb.Purchase) { valid.Device = testresources.Mall_Kiosk.Name },
+			want:   ErrDeviceWrongStore,
+		},
 	} {
 		purchase := Clone(testresources.Bar_Alice_Beer1)
 		test.modify(purchase)
@@ -60,3 +219,45 @@ func TestValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestState(t *testing.T) {
+	for _, test := range []struct {
+		state pb.Purchase_State
+		want  pb.Purchase_State
+	}{
+		{state: pb.Purchase_STATE_UNSPECIFIED, want: pb.Purchase_ACTIVE},
+		{state: pb.Purchase_ACTIVE, want: pb.Purchase_ACTIVE},
+		{state: pb.Purchase_VOIDED, want: pb.Purchase_VOIDED},
+	} {
+		purchase := &pb.Purchase{State: test.state}
+		if got := State(purchase); got != test.want {
+			t.Errorf("State(%v) = %v; want %v", purchase, got, test.want)
+		}
+		if got, want := Voided(purchase), test.want == pb.Purchase_VOIDED; got != want {
+			t.Errorf("Voided(%v) = %v; want %v", purchase, got, want)
+		}
+	}
+}
+
+func TestLineKind(t *testing.T) {
+	for _, test := range []struct {
+		kind       pb.Purchase_Line_Kind
+		want       pb.Purchase_Line_Kind
+		wantCredit bool
+	}{
+		{kind: pb.Purchase_Line_KIND_UNSPECIFIED, want: pb.Purchase_Line_CHARGE, wantCredit: false},
+		{kind: pb.Purchase_Line_CHARGE, want: pb.Purchase_Line_CHARGE, wantCredit: false},
+		{kind: pb.Purchase_Line_DEPOSIT, want: pb.Purchase_Line_DEPOSIT, wantCredit: false},
+		{kind: pb.Purchase_Line_DEPOSIT_RETURN, want: pb.Purchase_Line_DEPOSIT_RETURN, wantCredit: true},
+		{kind: pb.Purchase_Line_REFUND, want: pb.Purchase_Line_REFUND, wantCredit: true},
+	} {
+		line := &pb.Purchase_Line{Kind: test.kind}
+		got := LineKind(line)
+		if got != test.want {
+			t.Errorf("LineKind(%v) = %v; want %v", line, got, test.want)
+		}
+		if credit := Credit(got); credit != test.wantCredit {
+			t.Errorf("Credit(%v) = %v; want %v", got, credit, test.wantCredit)
+		}
+	}
+}
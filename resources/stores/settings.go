@@ -0,0 +1,38 @@
+package stores
+
+import (
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+)
+
+// DefaultPurchaseUndoWindow is the purchase undo window of stores that do not
+// have one.
+const DefaultPurchaseUndoWindow = 2 * time.Minute
+
+// Visibility returns the visibility of store, where stores without a
+// visibility are private.
+func Visibility(store *pb.Store) pb.Store_Visibility {
+	if store.Visibility == pb.Store_VISIBILITY_UNSPECIFIED {
+		return pb.Store_PRIVATE
+	}
+	return store.Visibility
+}
+
+// JoinPolicy returns the join policy of store, where stores without a join
+// policy are invite-only.
+func JoinPolicy(store *pb.Store) pb.Store_JoinPolicy {
+	if store.JoinPolicy == pb.Store_JOIN_POLICY_UNSPECIFIED {
+		return pb.Store_INVITE_ONLY
+	}
+	return store.JoinPolicy
+}
+
+// PurchaseUndoWindow returns how long after a purchase is made in store that
+// it can be undone.
+func PurchaseUndoWindow(store *pb.Store) time.Duration {
+	if store.PurchaseUndoWindow == nil {
+		return DefaultPurchaseUndoWindow
+	}
+	return store.PurchaseUndoWindow.AsDuration()
+}
@@ -0,0 +1,59 @@
+package stores
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestVisibility(t *testing.T) {
+	for _, test := range []struct {
+		visibility pb.Store_Visibility
+		want       pb.Store_Visibility
+	}{
+		{visibility: pb.Store_VISIBILITY_UNSPECIFIED, want: pb.Store_PRIVATE},
+		{visibility: pb.Store_PUBLIC, want: pb.Store_PUBLIC},
+		{visibility: pb.Store_UNLISTED, want: pb.Store_UNLISTED},
+		{visibility: pb.Store_PRIVATE, want: pb.Store_PRIVATE},
+	} {
+		store := &pb.Store{Visibility: test.visibility}
+		if got := Visibility(store); got != test.want {
+			t.Errorf("Visibility(%v) = %v; want %v", store, got, test.want)
+		}
+	}
+}
+
+func TestJoinPolicy(t *testing.T) {
+	for _, test := range []struct {
+		joinPolicy pb.Store_JoinPolicy
+		want       pb.Store_JoinPolicy
+	}{
+		{joinPolicy: pb.Store_JOIN_POLICY_UNSPECIFIED, want: pb.Store_INVITE_ONLY},
+		{joinPolicy: pb.Store_OPEN, want: pb.Store_OPEN},
+		{joinPolicy: pb.Store_APPROVAL, want: pb.Store_APPROVAL},
+		{joinPolicy: pb.Store_INVITE_ONLY, want: pb.Store_INVITE_ONLY},
+	} {
+		store := &pb.Store{JoinPolicy: test.joinPolicy}
+		if got := JoinPolicy(store); got != test.want {
+			t.Errorf("JoinPolicy(%v) = %v; want %v", store, got, test.want)
+		}
+	}
+}
+
+func TestPurchaseUndoWindow(t *testing.T) {
+	for _, test := range []struct {
+		window *durationpb.Duration
+		want   time.Duration
+	}{
+		{window: nil, want: DefaultPurchaseUndoWindow},
+		{window: durationpb.New(0), want: 0},
+		{window: durationpb.New(5 * time.Minute), want: 5 * time.Minute},
+	} {
+		store := &pb.Store{PurchaseUndoWindow: test.window}
+		if got := PurchaseUndoWindow(store); got != test.want {
+			t.Errorf("PurchaseUndoWindow(%v) = %v; want %v", store, got, test.want)
+		}
+	}
+}
@@ -6,7 +6,14 @@ import (
 	pb "github.com/Saser/strecku/api/v1"
 )
 
-var ErrDisplayNameEmpty = errors.New("display name is empty")
+var (
+	ErrDisplayNameEmpty   = errors.New("display name is empty")
+	ErrSwishNumberInvalid = errors.New("Swish number is not between 7 and 15 digits")
+	ErrVisibilityUnknown  = errors.New("visibility is unknown")
+	ErrJoinPolicyUnknown  = errors.New("join policy is unknown")
+	ErrUndoWindowInvalid  = errors.New("purchase undo window is invalid")
+	ErrUndoWindowNegative = errors.New("purchase undo window is negative")
+)
 
 func Validate(store *pb.Store) error {
 	if err := ValidateName(store.Name); err != nil {
@@ -15,5 +22,39 @@ func Validate(store *pb.Store) error {
 	if store.DisplayName == "" {
 		return ErrDisplayNameEmpty
 	}
+	if store.SwishNumber != "" {
+		if err := ValidateSwishNumber(store.SwishNumber); err != nil {
+			return err
+		}
+	}
+	if _, ok := pb.Store_Visibility_name[int32(store.Visibility)]; !ok {
+		return ErrVisibilityUnknown
+	}
+	if _, ok := pb.Store_JoinPolicy_name[int32(store.JoinPolicy)]; !ok {
+		return ErrJoinPolicyUnknown
+	}
+	if window := store.PurchaseUndoWindow; window != nil {
+		if err := window.CheckValid(); err != nil {
+			return ErrUndoWindowInvalid
+		}
+		if window.AsDuration() < 0 {
+			return ErrUndoWindowNegative
+		}
+	}
+	return nil
+}
+
+// ValidateSwishNumber returns nil if number looks like a valid Swish number,
+// which is either a 10 digit number for businesses or a phone number for
+// private individuals.
+func ValidateSwishNumber(number string) error {
+	if len(number) < 7 || len(number) > 15 {
+		return ErrSwishNumberInvalid
+	}
+	for _, r := range number {
+		if r < '0' || r > '9' {
+			return ErrSwishNumberInvalid
+		}
+	}
 	return nil
 }
@@ -2,9 +2,11 @@ package stores
 
 import (
 	"testing"
+	"time"
 
 	pb "github.com/Saser/strecku/api/v1"
 	"github.com/Saser/strecku/testresources"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestValidate(t *testing.T) {
@@ -19,9 +21,84 @@ func TestValidate(t *testing.T) {
 			},
 			want: ErrDisplayNameEmpty,
 		},
+		{
+			store: &pb.Store{
+				Name:        testresources.Bar.Name,
+				DisplayName: testresources.Bar.DisplayName,
+				SwishNumber: "123-456 78 90",
+			},
+			want: ErrSwishNumberInvalid,
+		},
+		{
+			store: &pb.Store{
+				Name:        testresources.Bar.Name,
+				DisplayName: testresources.Bar.DisplayName,
+				SwishNumber: "1231234567",
+			},
+			want: nil,
+		},
+		{
+			store: &pb.Store{
+				Name:        testresources.Bar.Name,
+				DisplayName: testresources.Bar.DisplayName,
+				Visibility:  pb.Store_Visibility(42),
+			},
+			want: ErrVisibilityUnknown,
+		},
+		{
+			store: &pb.Store{
+				Name:        testresources.Bar.Name,
+				DisplayName: testresources.Bar.DisplayName,
+				JoinPolicy:  pb.Store_JoinPolicy(42),
+			},
+			want: ErrJoinPolicyUnknown,
+		},
+		{
+			store: &pb.Store{
+				Name:               testresources.Bar.Name,
+				DisplayName:        testresources.Bar.DisplayName,
+				PurchaseUndoWindow: durationpb.New(0),
+			},
+			want: nil,
+		},
+		{
+			store: &pb.Store{
+				Name:               testresources.Bar.Name,
+				DisplayName:        testresources.Bar.DisplayName,
+				PurchaseUndoWindow: &durationpb.Duration{Seconds: 1, Nanos: -1},
+			},
+			want: ErrUndoWindowInvalid,
+		},
+		{
+			store: &pb.Store{
+				Name:               testresources.Bar.Name,
+				DisplayName:        testresources.Bar.DisplayName,
+				PurchaseUndoWindow: durationpb.New(-time.Minute),
+			},
+			want: ErrUndoWindowNegative,
+		},
 	} {
 		if got := Validate(test.store); got != test.want {
 			t.Errorf("Validate(%v) = %v; want %v", test.store, got, test.want)
 		}
 	}
 }
+
+func TestValidateSwishNumber(t *testing.T) {
+	for _, test := range []struct {
+		number string
+		want   error
+	}{
+		{number: "1231234567", want: nil},
+		{number: "46701234567", want: nil},
+		{number: "", want: ErrSwishNumberInvalid},
+		{number: "123456", want: ErrSwishNumberInvalid},
+		{number: "1234567890123456", want: ErrSwishNumberInvalid},
+		{number: "123-123 45 67", want: ErrSwishNumberInvalid},
+		{number: "+46701234567", want: ErrSwishNumberInvalid},
+	} {
+		if got := ValidateSwishNumber(test.number); got != test.want {
+			t.Errorf("ValidateSwishNumber(%q) = %v; want %v", test.number, got, test.want)
+		}
+	}
+}
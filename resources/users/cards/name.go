@@ -0,0 +1,44 @@
+package cards
+
+import (
+	"github.com/Saser/strecku/resources/users"
+	"github.com/google/uuid"
+)
+
+const CollectionID = "cards"
+
+var NameFormat = users.NameFormat.MustAppend("/" + CollectionID + "/{card}")
+
+func GenerateName(user string) string {
+	uuids, err := users.NameFormat.Parse(user)
+	if err != nil {
+		panic(err)
+	}
+	uuids["card"] = uuid.New()
+	name, err := NameFormat.Format(uuids)
+	if err != nil {
+		panic(err)
+	}
+	return name
+}
+
+func ParseName(name string) (user uuid.UUID, card uuid.UUID, err error) {
+	uuids, err := NameFormat.Parse(name)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+	return uuids["user"], uuids["card"], nil
+}
+
+func ValidateName(name string) error {
+	_, _, err := ParseName(name)
+	return err
+}
+
+func Parent(name string) (string, error) {
+	uuids, err := NameFormat.Parse(name)
+	if err != nil {
+		return "", err
+	}
+	return users.NameFormat.Format(uuids)
+}
@@ -0,0 +1,91 @@
+package cards
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/resources/users"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+func TestGenerateName(t *testing.T) {
+	user := users.GenerateName()
+	got := GenerateName(user)
+	if err := ValidateName(got); err != nil {
+		t.Errorf("ValidateName(GenerateName() = %q) = %v; want nil", got, err)
+	}
+}
+
+func TestParseName_ValidateName(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		wantUser := uuid.MustParse("6729f7fa-dc5a-41ae-b00d-5cd67d5e1e15")
+		wantCard := uuid.MustParse("90e3eaaa-4d9c-423f-b468-bb7322fb5d4f")
+		name := "users/" + wantUser.String() + "/cards/" + wantCard.String()
+		gotUser, gotCard, err := ParseName(name)
+		if err != nil {
+			t.Errorf("ParseName(%q) err = %v; want nil", name, err)
+		}
+		if !cmp.Equal(gotUser, wantUser) {
+			t.Errorf("ParseName(%q) user = %v; want %v", name, gotUser, wantUser)
+		}
+		if !cmp.Equal(gotCard, wantCard) {
+			t.Errorf("ParseName(%q) card = %v; want %v", name, gotCard, wantCard)
+		}
+		if err := ValidateName(name); err != nil {
+			t.Errorf("ValidateName(%q) = %v; want nil", name, err)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		userID := "6729f7fa-dc5a-41ae-b00d-5cd67d5e1e15"
+		cardID := "90e3eaaa-4d9c-423f-b468-bb7322fb5d4f"
+		for _, name := range []string{
+			"",
+			cardID,
+			"stores/" + userID + "/cards/" + cardID,
+			"users/" + userID + "/cards/not-a-UUID",
+			"users/not-a-UUID/cards/" + cardID,
+		} {
+			_, _, err := ParseName(name)
+			if err == nil {
+				t.Errorf("ParseName(%q) err = nil; want non-nil", name)
+			}
+			if err := ValidateName(name); err == nil {
+				t.Errorf("ValidateName(%q) = nil; want non-nil", name)
+			}
+		}
+	})
+}
+
+func TestParent(t *testing.T) {
+	userID := "6729f7fa-dc5a-41ae-b00d-5cd67d5e1e15"
+	user := fmt.Sprintf("%s/%s", users.CollectionID, userID)
+	cardID := "90e3eaaa-4d9c-423f-b468-bb7322fb5d4f"
+	for _, test := range []struct {
+		name       string
+		wantParent string
+		wantErr    error
+	}{
+		{
+			name:       user + "/cards/" + cardID,
+			wantParent: user,
+			wantErr:    nil,
+		},
+		{
+			name:       "stores/" + userID + "/cards/" + cardID,
+			wantParent: "",
+			wantErr:    resourcename.ErrInvalidName,
+		},
+	} {
+		parent, err := Parent(test.name)
+		if parent != test.wantParent {
+			t.Errorf("Parent(%q) parent = %q; want %q", test.name, parent, test.wantParent)
+		}
+		if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+			t.Errorf("Parent(%q) err = %v; want %q", test.name, err, test.wantErr)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package cards
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type NotFoundError struct {
+	Name string
+	UID  string
+}
+
+func (e *NotFoundError) Error() string {
+	var query string
+	switch {
+	case e.Name != "":
+		query = fmt.Sprintf("%q", e.Name)
+	case e.UID != "":
+		query = fmt.Sprintf("with UID %q", e.UID)
+	}
+	return fmt.Sprintf("card not found: %s", query)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	other, ok := target.(*NotFoundError)
+	if !ok {
+		return false
+	}
+	return e.Name == other.Name && e.UID == other.UID
+}
+
+type ExistsError struct {
+	Name string
+	UID  string
+}
+
+func (e *ExistsError) Error() string {
+	var query string
+	switch {
+	case e.Name != "":
+		query = fmt.Sprintf("%q", e.Name)
+	case e.UID != "":
+		query = fmt.Sprintf("with UID %q", e.UID)
+	}
+	return fmt.Sprintf("card exists: %s", query)
+}
+
+func (e *ExistsError) Is(target error) bool {
+	other, ok := target.(*ExistsError)
+	if !ok {
+		return false
+	}
+	return e.Name == other.Name && e.UID == other.UID
+}
+
+func Clone(card *pb.Card) *pb.Card {
+	return proto.Clone(card).(*pb.Card)
+}
+
+type Repository struct {
+	cards map[string]*pb.Card // name -> card
+	names map[string]string   // UID -> name
+}
+
+func NewRepository() *Repository {
+	return newRepository(make(map[string]*pb.Card), make(map[string]string))
+}
+
+func SeedRepository(t *testing.T, cards []*pb.Card) *Repository {
+	t.Helper()
+	mCards := make(map[string]*pb.Card, len(cards))
+	names := make(map[string]string, len(cards))
+	for _, card := range cards {
+		if err := Validate(card); err != nil {
+			t.Errorf("Validate(%v) = %v; want nil", card, err)
+		}
+		mCards[card.Name] = card
+		names[card.Uid] = card.Name
+	}
+	if t.Failed() {
+		t.FailNow()
+	}
+	return newRepository(mCards, names)
+}
+
+func newRepository(cards map[string]*pb.Card, names map[string]string) *Repository {
+	return &Repository{
+		cards: cards,
+		names: names,
+	}
+}
+
+func (r *Repository) LookupCard(_ context.Context, name string) (*pb.Card, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	card, ok := r.cards[name]
+	if !ok {
+		return nil, &NotFoundError{Name: name}
+	}
+	return Clone(card), nil
+}
+
+// LookupCardByUID finds the card with the given UID, which must be
+// normalized.
+func (r *Repository) LookupCardByUID(ctx context.Context, uid string) (*pb.Card, error) {
+	if err := ValidateUID(uid); err != nil {
+		return nil, err
+	}
+	name, ok := r.names[uid]
+	if !ok {
+		return nil, &NotFoundError{UID: uid}
+	}
+	return r.LookupCard(ctx, name)
+}
+
+func (r *Repository) ListCards(ctx context.Context) ([]*pb.Card, error) {
+	return r.FilterCards(ctx, func(*pb.Card) bool { return true })
+}
+
+func (r *Repository) FilterCards(_ context.Context, predicate func(*pb.Card) bool) ([]*pb.Card, error) {
+	var filtered []*pb.Card
+	for _, card := range r.cards {
+		if predicate(card) {
+			filtered = append(filtered, Clone(card))
+		}
+	}
+	return filtered, nil
+}
+
+func (r *Repository) CreateCard(_ context.Context, card *pb.Card) error {
+	if err := Validate(card); err != nil {
+		return err
+	}
+	name := card.Name
+	if _, exists := r.cards[name]; exists {
+		return &ExistsError{Name: name}
+	}
+	if _, exists := r.names[card.Uid]; exists {
+		return &ExistsError{UID: card.Uid}
+	}
+	r.cards[name] = Clone(card)
+	r.names[card.Uid] = name
+	return nil
+}
+
+func (r *Repository) DeleteCard(_ context.Context, name string) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	card, ok := r.cards[name]
+	if !ok {
+		return &NotFoundError{Name: name}
+	}
+	delete(r.cards, name)
+	delete(r.names, card.Uid)
+	return nil
+}
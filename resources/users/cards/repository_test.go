@@ -0,0 +1,377 @@
+package cards
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func cardLess(p1, p2 *pb.Card) bool {
+	return p1.Name < p2.Name
+}
+
+func TestNotFoundError_Error(t *testing.T) {
+	err := &NotFoundError{Name: testresources.Alice_Card.Name}
+	want := fmt.Sprintf("card not found: %q", testresources.Alice_Card.Name)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestNotFoundError_Is(t *testing.T) {
+	for _, test := range []struct {
+		err    *NotFoundError
+		target error
+		want   bool
+	}{
+		{
+			err:    &NotFoundError{Name: testresources.Alice_Card.Name},
+			target: &NotFoundError{Name: testresources.Alice_Card.Name},
+			want:   true,
+		},
+		{
+			err:    &NotFoundError{Name: testresources.Alice_Card.Name},
+			target: &NotFoundError{Name: testresources.Bob_Card.Name},
+			want:   false,
+		},
+		{
+			err:    &NotFoundError{Name: testresources.Alice_Card.Name},
+			target: fmt.Errorf("card not found: %q", testresources.Alice_Card.Name),
+			want:   false,
+		},
+	} {
+		if got := test.err.Is(test.target); got != test.want {
+			t.Errorf("test.err.Is(%v) = %v; want %v", test.target, got, test.want)
+		}
+	}
+}
+
+func TestExistsError_Error(t *testing.T) {
+	err := &ExistsError{Name: testresources.Alice_Card.Name}
+	want := fmt.Sprintf("card exists: %q", testresources.Alice_Card.Name)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestExistsError_Is(t *testing.T) {
+	for _, test := range []struct {
+		err    *ExistsError
+		target error
+		want   bool
+	}{
+		{
+			err:    &ExistsError{Name: testresources.Alice_Card.Name},
+			target: &ExistsError{Name: testresources.Alice_Card.Name},
+			want:   true,
+		},
+		{
+			err:    &ExistsError{Name: testresources.Alice_Card.Name},
+			target: &ExistsError{Name: testresources.Bob_Card.Name},
+			want:   false,
+		},
+		{
+			err:    &ExistsError{Name: testresources.Alice_Card.Name},
+			target: fmt.Errorf("card exists: %q", testresources.Alice_Card.Name),
+			want:   false,
+		},
+	} {
+		if got := test.err.Is(test.target); got != test.want {
+			t.Errorf("test.err.Is(%v) = %v; want %v", test.target, got, test.want)
+		}
+	}
+}
+
+func TestNotFoundError_Error_UID(t *testing.T) {
+	err := &NotFoundError{UID: testresources.Alice_Card.Uid}
+	want := fmt.Sprintf("card not found: with UID %q", testresources.Alice_Card.Uid)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestExistsError_Error_UID(t *testing.T) {
+	err := &ExistsError{UID: testresources.Alice_Card.Uid}
+	want := fmt.Sprintf("card exists: with UID %q", testresources.Alice_Card.Uid)
+	if got := err.Error(); !cmp.Equal(got, want) {
+		t.Errorf("err.Error() = %q; want %q", got, want)
+	}
+}
+
+func TestNewRepository(t *testing.T) {
+	NewRepository()
+}
+
+func TestRepository_LookupCard(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.Card{testresources.Alice_Card})
+	for _, test := range []struct {
+		desc     string
+		name     string
+		wantCard *pb.Card
+		wantErr  error
+	}{
+		{
+			desc:     "OK",
+			name:     testresources.Alice_Card.Name,
+			wantCard: testresources.Alice_Card,
+			wantErr:  nil,
+		},
+		{
+			desc:     "EmptyName",
+			name:     "",
+			wantCard: nil,
+			wantErr:  resourcename.ErrInvalidName,
+		},
+		{
+			desc:     "NotFound",
+			name:     testresources.Bob_Card.Name,
+			wantCard: nil,
+			wantErr:  &NotFoundError{Name: testresources.Bob_Card.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			card, err := r.LookupCard(ctx, test.name)
+			if diff := cmp.Diff(card, test.wantCard, protocmp.Transform()); diff != "" {
+				t.Errorf("r.LookupCard(%v, %q) card != test.wantCard (-got +want)\n%s", ctx, test.name, diff)
+			}
+			if got, want := err, test.wantErr; !cmp.Equal(got, want, cmpopts.EquateErrors()) {
+				t.Errorf("r.LookupCard(%v, %q) err = %v; want %v", ctx, test.name, got, want)
+			}
+		})
+	}
+}
+
+func TestRepository_LookupCardByUID(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.Card{testresources.Alice_Card})
+	for _, test := range []struct {
+		desc     string
+		uid      string
+		wantCard *pb.Card
+		wantErr  error
+	}{
+		{
+			desc:     "OK",
+			uid:      testresources.Alice_Card.Uid,
+			wantCard: testresources.Alice_Card,
+			wantErr:  nil,
+		},
+		{
+			desc:     "EmptyUID",
+			uid:      "",
+			wantCard: nil,
+			wantErr:  ErrUIDEmpty,
+		},
+		{
+			desc:     "NotNormalized",
+			uid:      "04:a3:b2:c1:d2:e3:80",
+			wantCard: nil,
+			wantErr:  ErrUIDNotNormalized,
+		},
+		{
+			desc:     "NotFound",
+			uid:      testresources.Bob_Card.Uid,
+			wantCard: nil,
+			wantErr:  &NotFoundError{UID: testresources.Bob_Card.Uid},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			card, err := r.LookupCardByUID(ctx, test.uid)
+			if diff := cmp.Diff(card, test.wantCard, protocmp.Transform()); diff != "" {
+				t.Errorf("r.LookupCardByUID(%v, %q) card != test.wantCard (-got +want)\n%s", ctx, test.uid, diff)
+			}
+			if got, want := err, test.wantErr; !cmp.Equal(got, want, cmpopts.EquateErrors()) {
+				t.Errorf("r.LookupCardByUID(%v, %q) err = %v; want %v", ctx, test.uid, got, want)
+			}
+		})
+	}
+}
+
+func TestRepository_ListCards(t *testing.T) {
+	ctx := context.Background()
+	want := []*pb.Card{
+		testresources.Alice_Card,
+		testresources.Bob_Card,
+		testresources.Alice_Keyring,
+	}
+	r := SeedRepository(t, want)
+	cards, err := r.ListCards(ctx)
+	if diff := cmp.Diff(
+		cards, want, protocmp.Transform(),
+		cmpopts.SortSlices(cardLess),
+	); diff != "" {
+		t.Errorf("r.ListCards(%v) cards != want (-got +want)\n%s", ctx, diff)
+	}
+	if err != nil {
+		t.Errorf("r.ListCards(%v) err = %v; want nil", ctx, err)
+	}
+}
+
+func TestRepository_FilterCards(t *testing.T) {
+	ctx := context.Background()
+	r := SeedRepository(t, []*pb.Card{
+		testresources.Alice_Card,
+		testresources.Bob_Card,
+		testresources.Alice_Keyring,
+	})
+	for _, test := range []struct {
+		name      string
+		predicate func(*pb.Card) bool
+		want      []*pb.Card
+	}{
+		{
+			name:      "NoneMatching",
+			predicate: func(*pb.Card) bool { return false },
+			want:      nil,
+		},
+		{
+			name: "OneMatching",
+			predicate: func(card *pb.Card) bool {
+				return card.Name == testresources.Alice_Card.Name
+			},
+			want: []*pb.Card{
+				testresources.Alice_Card,
+			},
+		},
+		{
+			name: "MultipleMatching",
+			predicate: func(card *pb.Card) bool {
+				return card.Name == testresources.Alice_Card.Name || card.Name == testresources.Bob_Card.Name
+			},
+			want: []*pb.Card{
+				testresources.Alice_Card,
+				testresources.Bob_Card,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			cards, err := r.FilterCards(ctx, test.predicate)
+			if diff := cmp.Diff(
+				cards, test.want, protocmp.Transform(),
+				cmpopts.EquateEmpty(),
+				cmpopts.SortSlices(cardLess),
+			); diff != "" {
+				t.Errorf("r.FilterCards(%v, test.predicate) cards != test.want (-got +want)\n%s", ctx, diff)
+			}
+			if got, want := err, error(nil); !cmp.Equal(got, want) {
+				t.Errorf("r.FilterCards(%v, test.predicate) err = %v; want %v", ctx, got, want)
+			}
+		})
+	}
+}
+
+func TestRepository_CreateCard(t *testing.T) {
+	ctx := context.Background()
+	duplicateName := Clone(testresources.Bob_Card)
+	duplicateName.Name = testresources.Alice_Card.Name
+	duplicateUID := Clone(testresources.Bob_Card)
+	duplicateUID.Uid = testresources.Alice_Card.Uid
+	invalid := Clone(testresources.Bob_Card)
+	invalid.Uid = ""
+	for _, test := range []struct {
+		desc string
+		card *pb.Card
+		want error
+	}{
+		{
+			desc: "OneCardOK",
+			card: testresources.Bob_Card,
+			want: nil,
+		},
+		{
+			desc: "DuplicateName",
+			card: duplicateName,
+			want: &ExistsError{Name: testresources.Alice_Card.Name},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			r := SeedRepository(t, []*pb.Card{testresources.Alice_Card})
+			if got := r.CreateCard(ctx, test.card); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+				t.Errorf("r.CreateCard(%v, %v) = %v; want %v", ctx, test.card, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRepository_DeleteCard(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("OK", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.Card{
+			testresources.Alice_Card,
+			testresources.Bob_Card,
+		})
+		if err := r.DeleteCard(ctx, testresources.Alice_Card.Name); err != nil {
+			t.Errorf("r.DeleteCard(%v, %q) = %v; want nil", ctx, testresources.Alice_Card.Name, err)
+		}
+		for _, test := range []struct {
+			desc     string
+			name     string
+			wantCard *pb.Card
+			wantErr  error
+		}{
+			{
+				desc:     "LookupDeleted",
+				name:     testresources.Alice_Card.Name,
+				wantCard: nil,
+				wantErr:  &NotFoundError{Name: testresources.Alice_Card.Name},
+			},
+			{
+				desc:     "LookupExisting",
+				name:     testresources.Bob_Card.Name,
+				wantCard: testresources.Bob_Card,
+				wantErr:  nil,
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				card, err := r.LookupCard(ctx, test.name)
+				if diff := cmp.Diff(card, test.wantCard, protocmp.Transform()); diff != "" {
+					t.Errorf("r.LookupCard(%v, %q) card != test.wantCard (-got +want)\n%s", ctx, test.name, diff)
+				}
+				if !cmp.Equal(err, test.wantErr, cmpopts.EquateErrors()) {
+					t.Errorf("r.LookupCard(%v, %q) err = %v; want %v", ctx, test.name, err, test.wantErr)
+				}
+			})
+		}
+		// The UID of the deleted card can be registered again.
+		reregistered := Clone(testresources.Bob_Card)
+		reregistered.Name = GenerateName(testresources.Bob.Name)
+		reregistered.Uid = testresources.Alice_Card.Uid
+		if err := r.CreateCard(ctx, reregistered); err != nil {
+			t.Errorf("r.CreateCard(%v, %v) = %v; want nil", ctx, reregistered, err)
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		r := SeedRepository(t, []*pb.Card{testresources.Alice_Card})
+		for _, test := range []struct {
+			desc string
+			name string
+			want error
+		}{
+			{
+				desc: "EmptyName",
+				name: "",
+				want: resourcename.ErrInvalidName,
+			},
+			{
+				desc: "NotFound",
+				name: testresources.Bob_Card.Name,
+				want: &NotFoundError{Name: testresources.Bob_Card.Name},
+			},
+		} {
+			t.Run(test.desc, func(t *testing.T) {
+				if got := r.DeleteCard(ctx, test.name); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+					t.Errorf("r.DeleteCard(%v, %q) = %v; want %v", ctx, test.name, got, test.want)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package cards
+
+import (
+	"errors"
+	"strings"
+
+	pb "github.com/Saser/strecku/api/v1"
+)
+
+var (
+	ErrUIDEmpty          = errors.New("UID is empty")
+	ErrUIDNotNormalized  = errors.New("UID is not normalized")
+	ErrUIDNotHex         = errors.New("UID must only contain hexadecimal digits")
+	ErrCreateTimeMissing = errors.New("create time is missing")
+)
+
+// NormalizeUID removes the separators that card readers commonly put between
+// the bytes of a UID, and makes the letters uppercase. The result is not
+// guaranteed to be a valid UID.
+func NormalizeUID(uid string) string {
+	uid = strings.NewReplacer(":", "", "-", "", " ", "").Replace(uid)
+	return strings.ToUpper(uid)
+}
+
+func ValidateUID(uid string) error {
+	if uid == "" {
+		return ErrUIDEmpty
+	}
+	if uid != NormalizeUID(uid) {
+		return ErrUIDNotNormalized
+	}
+	for _, r := range uid {
+		if !(r >= '0' && r <= '9' || r >= 'A' && r <= 'F') {
+			return ErrUIDNotHex
+		}
+	}
+	return nil
+}
+
+func Validate(card *pb.Card) error {
+	if err := ValidateName(card.Name); err != nil {
+		return err
+	}
+	if err := ValidateUID(card.Uid); err != nil {
+		return err
+	}
+	if card.CreateTime == nil {
+		return ErrCreateTimeMissing
+	}
+	return nil
+}
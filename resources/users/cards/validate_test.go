@@ -0,0 +1,92 @@
+package cards
+
+import (
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resourcename"
+	"github.com/Saser/strecku/testresources"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestNormalizeUID(t *testing.T) {
+	for _, test := range []struct {
+		uid  string
+		want string
+	}{
+		{uid: "04A3B2C1D2E380", want: "04A3B2C1D2E380"},
+		{uid: "04:a3:b2:c1:d2:e3:80", want: "04A3B2C1D2E380"},
+		{uid: "04-A3-B2-C1-D2-E3-80", want: "04A3B2C1D2E380"},
+		{uid: "04 a3 b2 c1 d2 e3 80", want: "04A3B2C1D2E380"},
+		{uid: "", want: ""},
+	} {
+		if got := NormalizeUID(test.uid); got != test.want {
+			t.Errorf("NormalizeUID(%q) = %q; want %q", test.uid, got, test.want)
+		}
+	}
+}
+
+func TestValidateUID(t *testing.T) {
+	for _, test := range []struct {
+		uid  string
+		want error
+	}{
+		{uid: "04A3B2C1D2E380", want: nil},
+		{uid: "", want: ErrUIDEmpty},
+		{uid: "04:A3:B2:C1", want: ErrUIDNotNormalized},
+		{uid: "04a3b2c1", want: ErrUIDNotNormalized},
+		{uid: "04G3B2C1", want: ErrUIDNotHex},
+	} {
+		if got := ValidateUID(test.uid); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+			t.Errorf("ValidateUID(%q) = %v; want %v", test.uid, got, test.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		desc   string
+		modify func(card *pb.Card)
+		want   error
+	}{
+		{
+			desc:   "OK",
+			modify: func(*pb.Card) {},
+			want:   nil,
+		},
+		{
+			desc:   "OK_EmptyDisplayName",
+			modify: func(card *pb.Card) { card.DisplayName = "" },
+			want:   nil,
+		},
+		{
+			desc:   "InvalidName",
+			modify: func(card *pb.Card) { card.Name = testresources.Alice.Name },
+			want:   resourcename.ErrInvalidName,
+		},
+		{
+			desc:   "EmptyUID",
+			modify: func(card *pb.Card) { card.Uid = "" },
+			want:   ErrUIDEmpty,
+		},
+		{
+			desc:   "UIDNotNormalized",
+			modify: func(card *pb.Card) { card.Uid = "04:A3:B2:C1" },
+			want:   ErrUIDNotNormalized,
+		},
+		{
+			desc:   "MissingCreateTime",
+			modify: func(card *pb.Card) { card.CreateTime = nil },
+			want:   ErrCreateTimeMissing,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			card := Clone(testresources.Alice_Card)
+			test.modify(card)
+			if got := Validate(card); !cmp.Equal(got, test.want, cmpopts.EquateErrors()) {
+				t.Errorf("Validate(%v) = %v; want %v", card, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package testresources
+
+import (
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The secrets of the API keys below. The API keys themselves are stored
+// without their secrets.
+const (
+	Bar_Kiosk_ApiKeySecret  = "Tq3JbU0fT1n0sWm5Zr8vXy2aBc4dEf6gHi8jKl0mNo2"
+	Mall_Kiosk_ApiKeySecret = "Pq1rSt3uVw5xYz7aBc9dEf1gHi3jKl5mNo7pQr9sTu1"
+)
+
+var (
+	Bar_Kiosk_ApiKey = &pb.ApiKey{
+		Name:       Bar_Kiosk.Name + "/apikeys/1c3e5a7b-9d2f-4e6a-8b0c-2d4f6a8c0e1b",
+		CreateTime: timestamppb.New(time.Date(2020, time.December, 5, 12, 30, 0, 0, time.UTC)),
+	}
+	Mall_Kiosk_ApiKey = &pb.ApiKey{
+		Name:       Mall_Kiosk.Name + "/apikeys/7f9b1d3e-5a2c-4f8e-9a1b-3c5e7f9a1b2d",
+		CreateTime: timestamppb.New(time.Date(2020, time.December, 7, 12, 30, 0, 0, time.UTC)),
+		RotateTime: timestamppb.New(time.Date(2020, time.December, 20, 12, 0, 0, 0, time.UTC)),
+	}
+)
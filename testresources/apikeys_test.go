@@ -0,0 +1,19 @@
+package testresources
+
+import (
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/devices/apikeys"
+)
+
+func TestApiKeysValid(t *testing.T) {
+	for _, apiKey := range []*pb.ApiKey{
+		Bar_Kiosk_ApiKey,
+		Mall_Kiosk_ApiKey,
+	} {
+		if err := apikeys.Validate(apiKey); err != nil {
+			t.Errorf("apikeys.Validate(%v) = %v; want nil", apiKey, err)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package testresources
+
+import (
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	Alice_Card = &pb.Card{
+		Name:        Alice.Name + "/cards/3b8f0d6e-7c1a-4f2e-9d5b-6a4c8e2f1b07",
+		Uid:         "04A3B2C1D2E380",
+		DisplayName: "Student card",
+		CreateTime:  timestamppb.New(time.Date(2020, time.December, 5, 12, 0, 0, 0, time.UTC)),
+	}
+	Alice_Keyring = &pb.Card{
+		Name:        Alice.Name + "/cards/c2e4a6b8-1d3f-4a5c-8e7b-9f0a1b2c3d4e",
+		Uid:         "5F2E9A11",
+		DisplayName: "Keyring tag",
+		CreateTime:  timestamppb.New(time.Date(2020, time.December, 6, 12, 0, 0, 0, time.UTC)),
+	}
+	Bob_Card = &pb.Card{
+		Name:       Bob.Name + "/cards/8d1c3e5a-2b4f-4c6e-a0d9-7e6f5a4b3c2d",
+		Uid:        "04F1E2D3C4B5A6",
+		CreateTime: timestamppb.New(time.Date(2020, time.December, 7, 12, 0, 0, 0, time.UTC)),
+	}
+)
@@ -0,0 +1,20 @@
+package testresources
+
+import (
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/users/cards"
+)
+
+func TestCardsValid(t *testing.T) {
+	for _, card := range []*pb.Card{
+		Alice_Card,
+		Alice_Keyring,
+		Bob_Card,
+	} {
+		if err := cards.Validate(card); err != nil {
+			t.Errorf("cards.Validate(%v) = %v; want nil", card, err)
+		}
+	}
+}
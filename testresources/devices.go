@@ -0,0 +1,42 @@
+package testresources
+
+import (
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	Bar_Kiosk = &pb.Device{
+		Name:        Bar.Name + "/devices/3b6f1d2a-7c4e-4f8a-9b0c-1d2e3f4a5b6c",
+		DisplayName: "Kiosk by the fridge",
+		Scopes: []string{
+			"users.read",
+			"memberships.read",
+			"products.read",
+			"purchases.create",
+			"cards.identify",
+		},
+		CreateTime: timestamppb.New(time.Date(2020, time.December, 5, 12, 0, 0, 0, time.UTC)),
+	}
+	Bar_Register = &pb.Device{
+		Name:        Bar.Name + "/devices/8e2a4c6b-1f3d-4a5e-8c7b-9d0e1f2a3b4c",
+		DisplayName: "Register",
+		Scopes: []string{
+			"purchases.read",
+			"payments.read",
+			"payments.create",
+		},
+		CreateTime: timestamppb.New(time.Date(2020, time.December, 6, 12, 0, 0, 0, time.UTC)),
+	}
+	Mall_Kiosk = &pb.Device{
+		Name:        Mall.Name + "/devices/5d7f9a1b-3c2e-4b6d-a8f0-2e4c6a8b0d1f",
+		DisplayName: "Kiosk by the entrance",
+		Scopes: []string{
+			"products.read",
+			"purchases.create",
+		},
+		CreateTime: timestamppb.New(time.Date(2020, time.December, 7, 12, 0, 0, 0, time.UTC)),
+	}
+)
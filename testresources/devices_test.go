@@ -0,0 +1,20 @@
+package testresources
+
+import (
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/devices"
+)
+
+func TestDevicesValid(t *testing.T) {
+	for _, device := range []*pb.Device{
+		Bar_Kiosk,
+		Bar_Register,
+		Mall_Kiosk,
+	} {
+		if err := devices.Validate(device); err != nil {
+			t.Errorf("devices.Validate(%v) = %v; want nil", device, err)
+		}
+	}
+}
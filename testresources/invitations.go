@@ -0,0 +1,38 @@
+package testresources
+
+import (
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	Bar_Carol_Invitation = &pb.Invitation{
+		Name:          Bar.Name + "/invitations/3c1f4bc7-6d55-4d8e-9b0b-3a0a4a7e21a5",
+		EmailAddress:  Carol.EmailAddress,
+		Administrator: false,
+		Discount:      true,
+		CreateTime:    timestamppb.New(time.Date(2020, time.December, 1, 12, 0, 0, 0, time.UTC)),
+		ExpireTime:    timestamppb.New(time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		State:         pb.Invitation_PENDING,
+	}
+	Mall_Bob_Invitation = &pb.Invitation{
+		Name:          Mall.Name + "/invitations/a0f0e7a5-53d4-4f11-a9a8-02b8c6c3f8de",
+		EmailAddress:  Bob.EmailAddress,
+		Administrator: true,
+		Discount:      false,
+		CreateTime:    timestamppb.New(time.Date(2020, time.December, 2, 12, 0, 0, 0, time.UTC)),
+		ExpireTime:    timestamppb.New(time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		State:         pb.Invitation_PENDING,
+	}
+	Bar_Expired_Invitation = &pb.Invitation{
+		Name:          Bar.Name + "/invitations/5d2b8a3e-0c8e-4d8a-8f3e-6b9f7c1d2e40",
+		EmailAddress:  "expired@example.com",
+		Administrator: false,
+		Discount:      false,
+		CreateTime:    timestamppb.New(time.Date(2020, time.December, 1, 12, 0, 0, 0, time.UTC)),
+		ExpireTime:    timestamppb.New(time.Date(2020, time.December, 15, 12, 0, 0, 0, time.UTC)),
+		State:         pb.Invitation_PENDING,
+	}
+)
@@ -0,0 +1,20 @@
+package testresources
+
+import (
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/invitations"
+)
+
+func TestInvitationsValid(t *testing.T) {
+	for _, invitation := range []*pb.Invitation{
+		Bar_Carol_Invitation,
+		Mall_Bob_Invitation,
+		Bar_Expired_Invitation,
+	} {
+		if err := invitations.Validate(invitation); err != nil {
+			t.Errorf("invitations.Validate(%v) = %v; want nil", invitation, err)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package testresources
+
+import (
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	Bar_Carol_JoinRequest = &pb.JoinRequest{
+		Name:       Bar.Name + "/joinrequests/0f5c2d8e-8a7b-4e52-b1c4-9d3e6f7a8b21",
+		User:       Carol.Name,
+		Message:    "Hi! I just moved in.",
+		CreateTime: timestamppb.New(time.Date(2020, time.December, 3, 12, 0, 0, 0, time.UTC)),
+		State:      pb.JoinRequest_PENDING,
+	}
+	Mall_Bob_JoinRequest = &pb.JoinRequest{
+		Name:       Mall.Name + "/joinrequests/6a8e1b3c-2d4f-4c6a-8e0b-1f2a3b4c5d6e",
+		User:       Bob.Name,
+		CreateTime: timestamppb.New(time.Date(2020, time.December, 4, 12, 0, 0, 0, time.UTC)),
+		State:      pb.JoinRequest_PENDING,
+	}
+	Bar_Bob_JoinRequest = &pb.JoinRequest{
+		Name:       Bar.Name + "/joinrequests/c7d9e2f4-5b6a-4d8c-9e1f-2a3b4c5d6e7f",
+		User:       Bob.Name,
+		CreateTime: timestamppb.New(time.Date(2020, time.November, 1, 12, 0, 0, 0, time.UTC)),
+		State:      pb.JoinRequest_APPROVED,
+		Membership: Bar_Bob.Name,
+	}
+)
@@ -0,0 +1,20 @@
+package testresources
+
+import (
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/joinrequests"
+)
+
+func TestJoinRequestsValid(t *testing.T) {
+	for _, joinRequest := range []*pb.JoinRequest{
+		Bar_Carol_JoinRequest,
+		Mall_Bob_JoinRequest,
+		Bar_Bob_JoinRequest,
+	} {
+		if err := joinrequests.Validate(joinRequest); err != nil {
+			t.Errorf("joinrequests.Validate(%v) = %v; want nil", joinRequest, err)
+		}
+	}
+}
@@ -1,6 +1,11 @@
 package testresources
 
-import pb "github.com/Saser/strecku/api/v1"
+import (
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
 
 var (
 	Bar_Alice_Payment = &pb.Payment{
@@ -8,23 +13,27 @@ var (
 		User:        Alice.Name,
 		Description: "Alice's payment",
 		AmountCents: 10000,
+		CreateTime:  timestamppb.New(time.Date(2020, time.December, 31, 10, 0, 0, 0, time.UTC)),
 	}
 	Bar_Bob_Payment = &pb.Payment{
 		Name:        Bar.Name + "/payments/605b61bd-9906-4427-a71e-730cc4b60c58",
 		User:        Bob.Name,
 		Description: "Bob's payment",
 		AmountCents: 20000,
+		CreateTime:  timestamppb.New(time.Date(2021, time.January, 2, 9, 0, 0, 0, time.UTC)),
 	}
 	Bar_Carol_Payment = &pb.Payment{
 		Name:        Bar.Name + "/payments/12490c0b-c72d-47c7-bf48-17787c18e173",
 		User:        Carol.Name,
 		Description: "Carol's payment",
 		AmountCents: 20000,
+		CreateTime:  timestamppb.New(time.Date(2021, time.January, 3, 9, 0, 0, 0, time.UTC)),
 	}
 	Mall_Alice_Payment = &pb.Payment{
 		Name:        Mall.Name + "/payments/bf2ba5da-100b-4bf5-a6c1-9689cc324287",
 		User:        Alice.Name,
 		Description: "Alice's payment",
 		AmountCents: 50000,
+		CreateTime:  timestamppb.New(time.Date(2020, time.December, 20, 16, 0, 0, 0, time.UTC)),
 	}
 )
@@ -0,0 +1,49 @@
+package testresources
+
+import (
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// Bar_HappyHour makes beer cheaper on Friday evenings.
+	Bar_HappyHour = &pb.PricingRule{
+		Name:             Bar.Name + "/pricingrules/0b7f3c2e-5a41-4d8e-9c6b-2e1f7a8d4c50",
+		DisplayName:      "Happy hour",
+		Products:         []string{Beer.Name},
+		TimeZone:         "Europe/Stockholm",
+		Weekdays:         []pb.PricingRule_Weekday{pb.PricingRule_FRIDAY},
+		DailyStartMinute: 16 * 60,
+		DailyEndMinute:   19 * 60,
+		Effect:           &pb.PricingRule_FixedPriceCents{FixedPriceCents: -3000},
+	}
+	// Bar_SecondCocktailHalfPrice takes half off every second cocktail in a
+	// line.
+	Bar_SecondCocktailHalfPrice = &pb.PricingRule{
+		Name:         Bar.Name + "/pricingrules/6e2d9a14-8f3b-4c7a-b5e0-91d4c2f8a736",
+		DisplayName:  "Second cocktail half price",
+		Products:     []string{Cocktail.Name},
+		UnitInterval: 2,
+		Effect:       &pb.PricingRule_PercentOff{PercentOff: 50},
+	}
+	// Mall_StaffDiscount takes a fifth off everything for administrators.
+	Mall_StaffDiscount = &pb.PricingRule{
+		Name:               Mall.Name + "/pricingrules/a3c8e5f1-2b7d-4e96-8a0c-5f1b3d7e9c24",
+		DisplayName:        "Staff discount",
+		AdministratorsOnly: true,
+		Effect:             &pb.PricingRule_PercentOff{PercentOff: 20},
+	}
+	// Mall_JeansSale makes jeans cheaper in January 2021, when buying at least
+	// two.
+	Mall_JeansSale = &pb.PricingRule{
+		Name:        Mall.Name + "/pricingrules/d91f4b6a-3e2c-4a58-b7d1-0c6e8f2a5b93",
+		DisplayName: "January jeans sale",
+		Products:    []string{Jeans.Name},
+		StartTime:   timestamppb.New(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		EndTime:     timestamppb.New(time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC)),
+		MinQuantity: 2,
+		Effect:      &pb.PricingRule_PercentOff{PercentOff: 30},
+	}
+)
@@ -0,0 +1,21 @@
+package testresources
+
+import (
+	"testing"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"github.com/Saser/strecku/resources/stores/pricingrules"
+)
+
+func TestPricingRulesValid(t *testing.T) {
+	for _, rule := range []*pb.PricingRule{
+		Bar_HappyHour,
+		Bar_SecondCocktailHalfPrice,
+		Mall_StaffDiscount,
+		Mall_JeansSale,
+	} {
+		if err := pricingrules.Validate(rule); err != nil {
+			t.Errorf("pricingrules.Validate(%v) = %v; want nil", rule, err)
+		}
+	}
+}
@@ -15,6 +15,13 @@ var (
 		FullPriceCents:     -10000,
 		DiscountPriceCents: -7500,
 	}
+	Soda = &pb.Product{
+		Name:               Bar.Name + "/products/c2d4e6f8-1a3b-4c5d-8e7f-9a0b1c2d3e4f",
+		DisplayName:        "Soda",
+		FullPriceCents:     -1500,
+		DiscountPriceCents: -1200,
+		DepositCents:       -100,
+	}
 	Jeans = &pb.Product{
 		Name:               Mall.Name + "/products/032b6523-9503-4ac4-95c5-622f723d91f4",
 		DisplayName:        "Jeans",
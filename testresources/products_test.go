@@ -11,6 +11,7 @@ func TestProductsValid(t *testing.T) {
 	for _, product := range []*pb.Product{
 		Beer,
 		Cocktail,
+		Soda,
 		Jeans,
 		Shirt,
 		Pills,
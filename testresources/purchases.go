@@ -1,6 +1,11 @@
 package testresources
 
-import pb "github.com/Saser/strecku/api/v1"
+import (
+	"time"
+
+	pb "github.com/Saser/strecku/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
 
 var (
 	Bar_Alice_Beer1 = &pb.Purchase{
@@ -14,6 +19,7 @@ var (
 				Product:     Beer.Name,
 			},
 		},
+		CreateTime: timestamppb.New(time.Date(2020, time.December, 1, 18, 0, 0, 0, time.UTC)),
 	}
 	Bar_Alice_Cocktail1 = &pb.Purchase{
 		Name: Bar.Name + "/purchases/926eeccc-0d6d-4bab-a5da-19e79995aeb1",
@@ -26,6 +32,7 @@ var (
 				Product:     Cocktail.Name,
 			},
 		},
+		CreateTime: timestamppb.New(time.Date(2020, time.December, 5, 21, 30, 0, 0, time.UTC)),
 	}
 	Bar_Alice_Beer2_Cocktail2 = &pb.Purchase{
 		Name: Bar.Name + "/purchases/ecfb87bd-9ba4-40e4-a62c-75a14227b037",
@@ -44,6 +51,25 @@ var (
 				Product:     Cocktail.Name,
 			},
 		},
+		CreateTime: timestamppb.New(time.Date(2021, time.January, 8, 20, 15, 0, 0, time.UTC)),
+	}
+	// Bar_Bob_Beer1_Voided was made by mistake at the kiosk, and undone a
+	// minute later.
+	Bar_Bob_Beer1_Voided = &pb.Purchase{
+		Name: Bar.Name + "/purchases/3b0e7f52-8c1d-4a6e-b2f9-5d7c91e04a28",
+		User: Bob.Name,
+		Lines: []*pb.Purchase_Line{
+			{
+				Description: Beer.DisplayName,
+				Quantity:    1,
+				PriceCents:  Beer.FullPriceCents,
+				Product:     Beer.Name,
+			},
+		},
+		CreateTime: timestamppb.New(time.Date(2020, time.December, 3, 19, 0, 0, 0, time.UTC)),
+		State:      pb.Purchase_VOIDED,
+		VoidTime:   timestamppb.New(time.Date(2020, time.December, 3, 19, 1, 0, 0, time.UTC)),
+		Device:     Bar_Kiosk.Name,
 	}
 	Mall_Alice_Jeans1 = &pb.Purchase{
 		Name: Mall.Name + "/purchases/f4037d30-6ecc-4fe6-9b90-0caba0594335",
@@ -56,5 +82,6 @@ var (
 				Product:     Jeans.Name,
 			},
 		},
+		CreateTime: timestamppb.New(time.Date(2020, time.December, 10, 12, 0, 0, 0, time.UTC)),
 	}
 )
@@ -12,6 +12,7 @@ func TestPurchasesValid(t *testing.T) {
 		Bar_Alice_Beer1,
 		Bar_Alice_Cocktail1,
 		Bar_Alice_Beer2_Cocktail2,
+		Bar_Bob_Beer1_Voided,
 		Mall_Alice_Jeans1,
 	} {
 		if err := purchases.Validate(purchase); err != nil {
@@ -6,10 +6,16 @@ var (
 	Bar = &pb.Store{
 		Name:        "stores/24a63d85-517b-4f37-9191-7d4d2deee0a7",
 		DisplayName: "Bar",
+		SwishNumber: "1231234567",
+		Visibility:  pb.Store_PUBLIC,
+		JoinPolicy:  pb.Store_APPROVAL,
 	}
 	Mall = &pb.Store{
-		Name:        "stores/5b5afbea-76de-4c4a-bafd-a56ff56122f1",
-		DisplayName: "Mall",
+		Name:            "stores/5b5afbea-76de-4c4a-bafd-a56ff56122f1",
+		DisplayName:     "Mall",
+		Visibility:      pb.Store_UNLISTED,
+		JoinPolicy:      pb.Store_OPEN,
+		DefaultDiscount: true,
 	}
 	Pharmacy = &pb.Store{
 		Name:        "stores/70dc1e5f-3c29-49d4-9757-0d483f1e79be",
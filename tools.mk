@@ -58,6 +58,15 @@ $(protoc-gen-go-grpc): go.mod go.sum
 		-o='$@' \
 		google.golang.org/grpc/cmd/protoc-gen-go-grpc
 
+# protoc-gen-grpc-gateway: protoc plugin to generate a REST/JSON gateway for
+# protobuf services.
+protoc-gen-grpc-gateway := $(tools)/protoc-gen-grpc-gateway
+$(protoc-gen-grpc-gateway): go.mod go.sum
+	go \
+		build \
+		-o='$@' \
+		github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway
+
 # migrate: CLI for the Go-based migration tool `migrate`.
 migrate := $(tools)/migrate
 $(migrate): go.mod go.sum